@@ -123,16 +123,27 @@ func CheckL7ILBFrontendConfig(c *IngressChecker) (string, string, string) {
 	return L7ILBFrontendConfigCheck, report.Passed, fmt.Sprintf("Ingress %s/%s for L7 internal load balancing does not have a frontendConfig annotation", c.ingress.Namespace, c.ingress.Name)
 }
 
-// CheckRuleHostOverwrite checks whether hosts of ingress rules are unique.
+// CheckRuleHostOverwrite checks whether a host and path of ingress rules are
+// routed to different service backends. Rules with identical hosts are merged,
+// so only a path claimed by different backends overwrites another rule.
 func CheckRuleHostOverwrite(c *IngressChecker) (string, string, string) {
-	hostSet := make(map[string]struct{})
+	backends := make(map[string]networkingv1.IngressServiceBackend)
 	for _, rule := range c.ingress.Spec.Rules {
-		if _, ok := hostSet[rule.Host]; ok {
-			return RuleHostOverwriteCheck, report.Failed, fmt.Sprintf("Ingress rules have identical host: %s", rule.Host)
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service == nil {
+				continue
+			}
+			key := rule.Host + path.Path
+			if backend, ok := backends[key]; ok && backend != *path.Backend.Service {
+				return RuleHostOverwriteCheck, report.Failed, fmt.Sprintf("Ingress rules route host %q and path %q to different backends: service %s and service %s", rule.Host, path.Path, serviceBackendString(backend), serviceBackendString(*path.Backend.Service))
+			}
+			backends[key] = *path.Backend.Service
 		}
-		hostSet[rule.Host] = struct{}{}
 	}
-	return RuleHostOverwriteCheck, report.Passed, "Ingress rule hosts are unique"
+	return RuleHostOverwriteCheck, report.Passed, "Ingress rule hosts and paths do not overwrite each other"
 }
 
 // CheckServiceExistence checks whether a service exists.
@@ -187,8 +198,8 @@ func CheckAppProtocolAnnotation(c *ServiceChecker) (string, string, string) {
 		return AppProtocolAnnotationCheck, report.Failed, fmt.Sprintf("AppProtocol annotation is in invalid format in service %s/%s", c.namespace, c.name)
 	}
 	for _, protocol := range portToProtocols {
-		if protocol != annotations.ProtocolHTTP && protocol != annotations.ProtocolHTTPS && protocol != annotations.ProtocolHTTP2 {
-			return AppProtocolAnnotationCheck, report.Failed, fmt.Sprintf("Invalid port application protocol in service %s/%s: %v, must be one of [`HTTP`,`HTTPS`,`HTTP2`]", c.namespace, c.name, protocol)
+		if protocol != annotations.ProtocolHTTP && protocol != annotations.ProtocolHTTPS && protocol != annotations.ProtocolHTTP2 && protocol != annotations.ProtocolGRPC {
+			return AppProtocolAnnotationCheck, report.Failed, fmt.Sprintf("Invalid port application protocol in service %s/%s: %v, must be one of [`HTTP`,`HTTPS`,`HTTP2`,`GRPC`]", c.namespace, c.name, protocol)
 		}
 	}
 	return AppProtocolAnnotationCheck, report.Passed, fmt.Sprintf("AppProtocol annotation is valid in service %s/%s", c.namespace, c.name)
@@ -313,3 +324,11 @@ func getNegAnnotation(svc *corev1.Service) (string, bool) {
 	}
 	return val, true
 }
+
+// serviceBackendString formats a service backend as name:port.
+func serviceBackendString(backend networkingv1.IngressServiceBackend) string {
+	if backend.Port.Name != "" {
+		return fmt.Sprintf("%s:%s", backend.Name, backend.Port.Name)
+	}
+	return fmt.Sprintf("%s:%d", backend.Name, backend.Port.Number)
+}
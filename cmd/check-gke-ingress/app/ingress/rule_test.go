@@ -393,25 +393,53 @@ func TestCheckRuleHostOverwrite(t *testing.T) {
 			expect: report.Passed,
 		},
 		{
-			desc: "Rules with identical host",
+			desc: "Rules with identical host and different paths",
 			rules: []networkingv1.IngressRule{
-				{
-					Host: "foo.bar.com",
-				},
-				{
-					Host: "foo.bar.com",
-				},
+				ingressRule("foo.bar.com", "/foo", "svc-1", 80),
+				ingressRule("foo.bar.com", "/bar", "svc-2", 80),
+			},
+			expect: report.Passed,
+		},
+		{
+			desc: "Rules with identical host and path routed to the same backend",
+			rules: []networkingv1.IngressRule{
+				ingressRule("foo.bar.com", "/foo", "svc-1", 80),
+				ingressRule("foo.bar.com", "/foo", "svc-1", 80),
+			},
+			expect: report.Passed,
+		},
+		{
+			desc: "Rules with identical host and path routed to different services",
+			rules: []networkingv1.IngressRule{
+				ingressRule("foo.bar.com", "/foo", "svc-1", 80),
+				ingressRule("foo.bar.com", "/foo", "svc-2", 80),
+			},
+			expect: report.Failed,
+		},
+		{
+			desc: "Rules with identical host and path routed to different ports",
+			rules: []networkingv1.IngressRule{
+				ingressRule("foo.bar.com", "/foo", "svc-1", 80),
+				ingressRule("foo.bar.com", "/foo", "svc-1", 443),
 			},
 			expect: report.Failed,
 		},
 		{
-			desc: "Rules with unique hosts",
+			desc: "Rules with unique hosts and identical paths",
+			rules: []networkingv1.IngressRule{
+				ingressRule("foo.bar.com", "/foo", "svc-1", 80),
+				ingressRule("abc.xyz.com", "/foo", "svc-2", 80),
+			},
+			expect: report.Passed,
+		},
+		{
+			desc: "Rules without http",
 			rules: []networkingv1.IngressRule{
 				{
 					Host: "foo.bar.com",
 				},
 				{
-					Host: "abc.xyz.com",
+					Host: "foo.bar.com",
 				},
 			},
 			expect: report.Passed,
@@ -431,6 +459,28 @@ func TestCheckRuleHostOverwrite(t *testing.T) {
 	}
 }
 
+// ingressRule returns an ingress rule routing a host and path to a service port.
+func ingressRule(host, path, service string, port int32) networkingv1.IngressRule {
+	return networkingv1.IngressRule{
+		Host: host,
+		IngressRuleValue: networkingv1.IngressRuleValue{
+			HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{
+					{
+						Path: path,
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: service,
+								Port: networkingv1.ServiceBackendPort{Number: port},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func TestCheckAppProtocolAnnotation(t *testing.T) {
 	for _, tc := range []struct {
 		desc   string
@@ -464,6 +514,19 @@ func TestCheckAppProtocolAnnotation(t *testing.T) {
 			},
 			expect: report.Passed,
 		},
+		{
+			desc: "service with GRPC AppProtocol annotation",
+			svc: corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "svc-1",
+					Namespace: "test",
+					Annotations: map[string]string{
+						annotations.GoogleServiceApplicationProtocolKey: `{"port1": "GRPC"}`,
+					},
+				},
+			},
+			expect: report.Passed,
+		},
 		{
 			desc: "service with invalid AppProtocol annotation format",
 			svc: corev1.Service{
@@ -786,7 +849,7 @@ func TestCheckFunctions(t *testing.T) {
 						Checks: []*report.Check{
 							{Name: "IngressRuleCheck", Result: "FAILED"},
 							{Name: "L7ILBFrontendConfigCheck", Result: "SKIPPED"},
-							{Name: "RuleHostOverwriteCheck", Result: "FAILED"},
+							{Name: "RuleHostOverwriteCheck", Result: "PASSED"},
 							{Name: "FrontendConfigExistenceCheck", Result: "PASSED"},
 							{Name: "ServiceExistenceCheck", Result: "PASSED"},
 							{Name: "BackendConfigAnnotationCheck", Result: "PASSED"},
@@ -812,7 +875,7 @@ func TestCheckFunctions(t *testing.T) {
 						Checks: []*report.Check{
 							{Name: "IngressRuleCheck", Result: "FAILED"},
 							{Name: "L7ILBFrontendConfigCheck", Result: "SKIPPED"},
-							{Name: "RuleHostOverwriteCheck", Result: "FAILED"},
+							{Name: "RuleHostOverwriteCheck", Result: "PASSED"},
 							{Name: "FrontendConfigExistenceCheck", Result: "PASSED"},
 							{Name: "ServiceExistenceCheck", Result: "PASSED"},
 							{Name: "BackendConfigAnnotationCheck", Result: "PASSED"},
@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
 	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfig "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/e2e"
 	"k8s.io/ingress-gce/pkg/e2e/adapter"
 	"k8s.io/ingress-gce/pkg/fuzz"
@@ -32,9 +33,14 @@ import (
 func TestAppProtocol(t *testing.T) {
 	t.Parallel()
 
+	grpcType := string(annotations.ProtocolGRPC)
+
 	for _, tc := range []struct {
 		desc          string
 		annotationVal string
+		// wantHealthCheck is checked against the health check of the
+		// backend service if set.
+		wantHealthCheck *backendconfig.HealthCheckConfig
 	}{
 		{
 			desc:          "https",
@@ -44,6 +50,13 @@ func TestAppProtocol(t *testing.T) {
 			desc:          "http2",
 			annotationVal: `{"https-port":"HTTP2"}`,
 		},
+		{
+			// The echo server serves gRPC health checks in cleartext
+			// HTTP/2 on its HTTPS port.
+			desc:            "grpc",
+			annotationVal:   `{"https-port":"GRPC"}`,
+			wantHealthCheck: &backendconfig.HealthCheckConfig{Type: &grpcType},
+		},
 	} {
 		tc := tc // Capture tc as we are running this in parallel.
 		Framework.RunWithSandbox(tc.desc, t, func(t *testing.T, s *e2e.Sandbox) {
@@ -84,6 +97,12 @@ func TestAppProtocol(t *testing.T) {
 				t.Fatalf("Error getting GCP resources for LB with IP = %q: %v", vip, err)
 			}
 
+			if tc.wantHealthCheck != nil {
+				if err := verifyHealthCheck(t, gclb, tc.wantHealthCheck); err != nil {
+					t.Error(err)
+				}
+			}
+
 			// Wait for GCLB resources to be deleted.
 			if err := crud.Delete(s.Namespace, ing.Name); err != nil {
 				t.Errorf("Delete(%q) = %v, want nil", ing.Name, err)
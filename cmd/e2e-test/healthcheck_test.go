@@ -311,10 +311,14 @@ func verifyHealthCheck(t *testing.T, gclb *fuzz.GCLB, want *backendconfig.Health
 			// Pull out the field that are in common among the different
 			// healthchecks per protocol.
 			common := struct {
-				port        int64
-				requestPath string
+				port            int64
+				requestPath     string
+				grpcServiceName string
 			}{}
 			switch {
+			case hc.GA.GrpcHealthCheck != nil:
+				common.port = hc.GA.GrpcHealthCheck.Port
+				common.grpcServiceName = hc.GA.GrpcHealthCheck.GrpcServiceName
 			case hc.GA.Http2HealthCheck != nil:
 				common.port = hc.GA.Http2HealthCheck.Port
 				common.requestPath = hc.GA.Http2HealthCheck.RequestPath
@@ -338,7 +342,7 @@ func verifyHealthCheck(t *testing.T, gclb *fuzz.GCLB, want *backendconfig.Health
 			if want.UnhealthyThreshold != nil && hc.GA.UnhealthyThreshold != *want.UnhealthyThreshold {
 				return fmt.Errorf("HealthCheck %v unhealthThreshold = %d, want %d", rID.Key, hc.GA.UnhealthyThreshold, *want.UnhealthyThreshold)
 			}
-			if want.Type != nil {
+			if want.Type != nil && hc.GA.Type != *want.Type {
 				return fmt.Errorf("HealthCheck %v type = %s, want %s", rID.Key, hc.GA.Type, *want.Type)
 			}
 			if want.Port != nil && common.port != *want.Port {
@@ -347,6 +351,9 @@ func verifyHealthCheck(t *testing.T, gclb *fuzz.GCLB, want *backendconfig.Health
 			if want.RequestPath != nil && common.requestPath != *want.RequestPath {
 				return fmt.Errorf("HealthCheck %v requestPath = %q, want %q", rID.Key, common.requestPath, *want.RequestPath)
 			}
+			if want.GrpcServiceName != nil && common.grpcServiceName != *want.GrpcServiceName {
+				return fmt.Errorf("HealthCheck %v grpcServiceName = %q, want %q", rID.Key, common.grpcServiceName, *want.GrpcServiceName)
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// grpcHealthCheckPath is the path of the Check method of the gRPC health
+	// checking protocol.
+	grpcHealthCheckPath = "/grpc.health.v1.Health/Check"
+	// tlsHandshakeRecordType is the first byte of a TLS connection.
+	tlsHandshakeRecordType = 0x16
+	// sniffTimeout bounds the time to wait for the first byte of a
+	// connection.
+	sniffTimeout = 10 * time.Second
+)
+
+// grpcServingResponse is the serialized grpc.health.v1.HealthCheckResponse
+// with status SERVING.
+var grpcServingResponse = []byte{0x08, 0x01}
+
+// grpcHealthCheck implements the Check method of the gRPC health checking
+// protocol. It always reports the server as SERVING.
+func grpcHealthCheck(w http.ResponseWriter, r *http.Request) {
+	if r.ProtoMajor != 2 || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		http.Error(w, "expected a gRPC request", http.StatusUnsupportedMediaType)
+		return
+	}
+	// The request is a HealthCheckRequest, only the service name, which is
+	// ignored.
+	if _, err := io.Copy(io.Discard, r.Body); err != nil {
+		klog.Errorf("Error reading gRPC request: %v, UserAgent: %v, RemoteAddr: %v", err, r.UserAgent(), r.RemoteAddr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/grpc")
+	w.Header().Set("Trailer", "Grpc-Status")
+	w.WriteHeader(http.StatusOK)
+
+	// Messages are prefixed by a compression flag and their length.
+	msg := make([]byte, 5+len(grpcServingResponse))
+	binary.BigEndian.PutUint32(msg[1:5], uint32(len(grpcServingResponse)))
+	copy(msg[5:], grpcServingResponse)
+	if _, err := w.Write(msg); err != nil {
+		klog.Errorf("Error writing gRPC response: %v, UserAgent: %v, RemoteAddr: %v", err, r.UserAgent(), r.RemoteAddr)
+		return
+	}
+	w.Header().Set("Grpc-Status", "0")
+	klog.V(3).Infof("grpc healthcheck: %v, %v, %v", time.Now(), r.UserAgent(), r.RemoteAddr)
+}
+
+// h2cListener is the listener of the HTTPS server. Connections which do not
+// start with a TLS handshake are served as cleartext HTTP/2 with prior
+// knowledge (h2c), which is what gRPC health checks use. Other connections are
+// returned by Accept.
+type h2cListener struct {
+	net.Listener
+	h2c     *http2.Server
+	handler http.Handler
+
+	conns chan net.Conn
+	errs  chan error
+}
+
+func newH2CListener(l net.Listener, handler http.Handler) *h2cListener {
+	hl := &h2cListener{
+		Listener: l,
+		h2c:      &http2.Server{IdleTimeout: serverIdleTimeout},
+		handler:  handler,
+		conns:    make(chan net.Conn),
+		errs:     make(chan error, 1),
+	}
+	go hl.serve()
+	return hl
+}
+
+func (l *h2cListener) serve() {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			l.errs <- err
+			return
+		}
+		go l.sniff(conn)
+	}
+}
+
+func (l *h2cListener) sniff(conn net.Conn) {
+	sc := &sniffedConn{Conn: conn, r: bufio.NewReader(conn)}
+	conn.SetReadDeadline(time.Now().Add(sniffTimeout))
+	b, err := sc.r.Peek(1)
+	conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		conn.Close()
+		return
+	}
+	if b[0] == tlsHandshakeRecordType {
+		l.conns <- sc
+		return
+	}
+	l.h2c.ServeConn(sc, &http2.ServeConnOpts{Handler: l.handler})
+}
+
+// Accept implements net.Listener.
+func (l *h2cListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case err := <-l.errs:
+		return nil, err
+	}
+}
+
+// sniffedConn is a connection whose first bytes have been read ahead.
+type sniffedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// Read implements net.Conn.
+func (c *sniffedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
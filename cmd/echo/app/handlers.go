@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -52,6 +53,7 @@ type ResponseBody struct {
 // RunHTTPServer runs HTTP and HTTPS goroutines and blocks.
 func RunHTTPServer(ctx context.Context) {
 	http.HandleFunc("/healthcheck", healthCheck)
+	http.HandleFunc(grpcHealthCheckPath, grpcHealthCheck)
 	http.HandleFunc("/", echo)
 
 	go func() {
@@ -61,7 +63,12 @@ func RunHTTPServer(ctx context.Context) {
 
 		server := &http.Server{Addr: fmt.Sprintf(":%d", F.HTTPSPort), IdleTimeout: serverIdleTimeout}
 		cert, key := createCert()
-		err := server.ListenAndServeTLS(cert, key)
+		l, err := net.Listen("tcp", server.Addr)
+		if err != nil {
+			klog.Fatal(err)
+		}
+		// gRPC health checks use cleartext HTTP/2 on the HTTPS port.
+		err = server.ServeTLS(newH2CListener(l, http.DefaultServeMux), cert, key)
 		if err != nil {
 			klog.Fatal(err)
 		}
@@ -26,11 +26,14 @@ import (
 
 	flag "github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/ingress-gce/pkg/cacheinvalidation"
 	"k8s.io/ingress-gce/pkg/frontendconfig"
+	"k8s.io/ingress-gce/pkg/gateway"
 	"k8s.io/ingress-gce/pkg/ingparams"
 	"k8s.io/ingress-gce/pkg/instancegroups"
 	"k8s.io/ingress-gce/pkg/l4lb"
 	"k8s.io/ingress-gce/pkg/psc"
+	"k8s.io/ingress-gce/pkg/routeconfig"
 	"k8s.io/ingress-gce/pkg/serviceattachment"
 	"k8s.io/ingress-gce/pkg/servicemetrics"
 	"k8s.io/ingress-gce/pkg/svcneg"
@@ -46,8 +49,11 @@ import (
 	firewallcrclient "k8s.io/cloud-provider-gcp/crd/client/gcpfirewall/clientset/versioned"
 	networkclient "k8s.io/cloud-provider-gcp/crd/client/network/clientset/versioned"
 	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned"
+	cacheinvalidationclient "k8s.io/ingress-gce/pkg/cacheinvalidation/client/clientset/versioned"
 	frontendconfigclient "k8s.io/ingress-gce/pkg/frontendconfig/client/clientset/versioned"
+	gatewayclient "k8s.io/ingress-gce/pkg/gateway/client/clientset/versioned"
 	ingparamsclient "k8s.io/ingress-gce/pkg/ingparams/client/clientset/versioned"
+	routeconfigclient "k8s.io/ingress-gce/pkg/routeconfig/client/clientset/versioned"
 	serviceattachmentclient "k8s.io/ingress-gce/pkg/serviceattachment/client/clientset/versioned"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
 
@@ -139,6 +145,32 @@ func main() {
 		}
 	}
 
+	var routeConfigClient routeconfigclient.Interface
+	if flags.F.EnableRouteConfig {
+		routeConfigCRDMeta := routeconfig.CRDMeta()
+		if _, err := crdHandler.EnsureCRD(routeConfigCRDMeta, true); err != nil {
+			klog.Fatalf("Failed to ensure RouteConfig CRD: %v", err)
+		}
+
+		routeConfigClient, err = routeconfigclient.NewForConfig(kubeConfig)
+		if err != nil {
+			klog.Fatalf("Failed to create RouteConfig client: %v", err)
+		}
+	}
+
+	var cacheInvalidationClient cacheinvalidationclient.Interface
+	if flags.F.EnableCacheInvalidation {
+		cacheInvalidationCRDMeta := cacheinvalidation.CRDMeta()
+		if _, err := crdHandler.EnsureCRD(cacheInvalidationCRDMeta, true); err != nil {
+			klog.Fatalf("Failed to ensure CacheInvalidation CRD: %v", err)
+		}
+
+		cacheInvalidationClient, err = cacheinvalidationclient.NewForConfig(kubeConfig)
+		if err != nil {
+			klog.Fatalf("Failed to create CacheInvalidation client: %v", err)
+		}
+	}
+
 	var firewallCRClient firewallcrclient.Interface
 	if flags.F.EnableFirewallCR {
 		firewallCRClient, err = firewallcrclient.NewForConfig(kubeConfig)
@@ -170,6 +202,16 @@ func main() {
 		}
 	}
 
+	var gatewayClient gatewayclient.Interface
+	if flags.F.EnableGatewayAPI {
+		// The Gateway API CRDs are owned by the Gateway API project and are
+		// not installed by the controller.
+		gatewayClient, err = gatewayclient.NewForConfig(kubeConfig)
+		if err != nil {
+			klog.Fatalf("Failed to create Gateway client: %v", err)
+		}
+	}
+
 	var networkClient networkclient.Interface
 	if flags.F.EnableMultiNetworking {
 		networkClient, err = networkclient.NewForConfig(kubeConfig)
@@ -216,6 +258,7 @@ func main() {
 		DefaultBackendSvcPort:         defaultBackendServicePort,
 		HealthCheckPath:               flags.F.HealthCheckPath,
 		FrontendConfigEnabled:         flags.F.EnableFrontendConfig,
+		RouteConfigEnabled:            flags.F.EnableRouteConfig,
 		EnableASMConfigMap:            flags.F.EnableASMConfigMapBasedConfig,
 		ASMConfigMapNamespace:         flags.F.ASMConfigMapBasedConfigNamespace,
 		ASMConfigMapName:              flags.F.ASMConfigMapBasedConfigCMName,
@@ -226,7 +269,7 @@ func main() {
 		EnableMultinetworking:         flags.F.EnableMultiNetworking,
 		EnableIngressRegionalExternal: flags.F.EnableIngressRegionalExternal,
 	}
-	ctx := ingctx.NewControllerContext(kubeConfig, kubeClient, backendConfigClient, frontendConfigClient, firewallCRClient, svcNegClient, ingParamsClient, svcAttachmentClient, networkClient, cloud, namer, kubeSystemUID, ctxConfig)
+	ctx := ingctx.NewControllerContext(kubeConfig, kubeClient, backendConfigClient, frontendConfigClient, routeConfigClient, firewallCRClient, svcNegClient, ingParamsClient, svcAttachmentClient, gatewayClient, cacheInvalidationClient, networkClient, cloud, namer, kubeSystemUID, ctxConfig)
 	go app.RunHTTPServer(ctx.HealthCheck)
 
 	if !flags.F.LeaderElection.LeaderElect {
@@ -321,6 +364,19 @@ func runControllers(ctx *ingctx.ControllerContext) {
 		klog.V(0).Infof("PSC Controller started")
 	}
 
+	if flags.F.EnableGatewayAPI {
+		gatewayController := gateway.NewController(ctx)
+		lbc.RegisterBackendSource(gatewayController.ServicePorts)
+		go gatewayController.Run(stopCh)
+		klog.V(0).Infof("Gateway controller started")
+	}
+
+	if flags.F.EnableCacheInvalidation {
+		cacheInvalidationController := cacheinvalidation.NewController(ctx)
+		go cacheInvalidationController.Run(stopCh)
+		klog.V(0).Infof("CacheInvalidation controller started")
+	}
+
 	if flags.F.EnableServiceMetrics {
 		metricsController := servicemetrics.NewController(ctx, flags.F.MetricsExportInterval, stopCh)
 		go metricsController.Run()
@@ -364,6 +420,7 @@ func runControllers(ctx *ingctx.ControllerContext) {
 		ctx.SvcNegInformer,
 		ctx.NetworkInformer,
 		ctx.GKENetworkParamsInformer,
+		ctx.RouteConfigInformer,
 		ctx.HasSynced,
 		ctx.ControllerMetrics,
 		ctx.L4Namer,
@@ -94,6 +94,48 @@ ${OPENAPI_PKG}/openapi-gen \
   --output-package k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1 \
   --go-header-file ${SCRIPT_ROOT}/boilerplate.go.txt
 
+echo "Performing code generation for RouteConfig CRD"
+${CODEGEN_PKG}/generate-groups.sh \
+  "deepcopy,client,informer,lister" \
+  k8s.io/ingress-gce/pkg/routeconfig/client k8s.io/ingress-gce/pkg/apis \
+  "routeconfig:v1beta1" \
+  --go-header-file ${SCRIPT_ROOT}/boilerplate.go.txt
+
+echo "Generating openapi for RouteConfig v1beta1"
+${OPENAPI_PKG}/openapi-gen \
+  --output-file-base zz_generated.openapi \
+  --input-dirs k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1 \
+  --output-package k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1 \
+  --go-header-file ${SCRIPT_ROOT}/boilerplate.go.txt
+
+echo "Performing code generation for CacheInvalidation CRD"
+${CODEGEN_PKG}/generate-groups.sh \
+  "deepcopy,client,informer,lister" \
+  k8s.io/ingress-gce/pkg/cacheinvalidation/client k8s.io/ingress-gce/pkg/apis \
+  "cacheinvalidation:v1beta1" \
+  --go-header-file ${SCRIPT_ROOT}/boilerplate.go.txt
+
+echo "Generating openapi for CacheInvalidation v1beta1"
+${OPENAPI_PKG}/openapi-gen \
+  --output-file-base zz_generated.openapi \
+  --input-dirs k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1 \
+  --output-package k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1 \
+  --go-header-file ${SCRIPT_ROOT}/boilerplate.go.txt
+
+echo "Performing code generation for Gateway API"
+${CODEGEN_PKG}/generate-groups.sh \
+  "deepcopy,client,informer,lister" \
+  k8s.io/ingress-gce/pkg/gateway/client k8s.io/ingress-gce/pkg/apis \
+  "gateway:v1beta1" \
+  --go-header-file ${SCRIPT_ROOT}/boilerplate.go.txt
+
+echo "Generating openapi for Gateway API v1beta1"
+${OPENAPI_PKG}/openapi-gen \
+  --output-file-base zz_generated.openapi \
+  --input-dirs k8s.io/ingress-gce/pkg/apis/gateway/v1beta1 \
+  --output-package k8s.io/ingress-gce/pkg/apis/gateway/v1beta1 \
+  --go-header-file ${SCRIPT_ROOT}/boilerplate.go.txt
+
 echo "Performing code generation for ServiceNetworkEndpointGroup CRD"
 ${CODEGEN_PKG}/generate-groups.sh \
   "deepcopy,client,informer,lister" \
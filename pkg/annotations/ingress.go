@@ -17,10 +17,14 @@ limitations under the License.
 package annotations
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	v1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
@@ -87,6 +91,36 @@ const (
 	//     networking.gke.io/v1beta1.FrontendConfig: 'my-frontendconfig'
 	FrontendConfigKey = "networking.gke.io/v1beta1.FrontendConfig"
 
+	// RouteConfigKey is the annotation key used by controller to specify
+	// the RouteConfig resource which should be associated with the Ingress.
+	// The value of the annotation is the name of the RouteConfig resource.
+	// Examples:
+	// - annotations:
+	//     networking.gke.io/v1beta1.RouteConfig: 'my-routeconfig'
+	RouteConfigKey = "networking.gke.io/v1beta1.RouteConfig"
+
+	// RequestMirrorKey is the annotation key used to mirror the requests sent
+	// to backend Services of the Ingress, including its default backend and
+	// the backends of its RouteConfig, to shadow Services. The responses of
+	// the shadow Services are ignored. The value of the annotation is a JSON
+	// object mapping the name of a backend Service to its shadow Service.
+	// Request mirroring is only supported by the gce-internal and
+	// gce-regional-external Ingress classes.
+	// Examples:
+	// - annotations:
+	//     networking.gke.io/request-mirror: '{"web": {"name": "web-shadow", "port": {"number": 80}}}'
+	RequestMirrorKey = "networking.gke.io/request-mirror"
+
+	// SharedLBGroupKey is the annotation key used to merge Ingresses into a
+	// single load balancer. Ingresses of a namespace with the same value share
+	// the URL map, the target proxies, the forwarding rules and the IP of the
+	// group. The settings of the load balancer, such as the static IP and the
+	// FrontendConfig, are taken from the oldest Ingress of the group.
+	// Examples:
+	// - annotations:
+	//     networking.gke.io/shared-lb-group: 'frontends'
+	SharedLBGroupKey = "networking.gke.io/shared-lb-group"
+
 	// UrlMapKey is the annotation key used by controller to record GCP URL map.
 	UrlMapKey = StatusPrefix + "/url-map"
 	// UrlMapKey is the annotation key used by controller to record GCP URL map used for Https Redirects only.
@@ -105,8 +139,14 @@ const (
 	TargetHttpsProxyKey = StatusPrefix + "/https-target-proxy"
 	// SSLCertKey is the annotation key used by controller to record GCP ssl cert.
 	SSLCertKey = StatusPrefix + "/ssl-cert"
+	// SSLCertRotationKey is the annotation key used by controller to record
+	// the progress of a rotation of GCP ssl certs.
+	SSLCertRotationKey = StatusPrefix + "/ssl-cert-rotation"
 	// StaticIPKey is the annotation key used by controller to record GCP static ip.
 	StaticIPKey = StatusPrefix + "/static-ip"
+	// SharedLBGroupStatusKey is the annotation key used by controller to
+	// record the shared load balancer group an Ingress was last synced into.
+	SharedLBGroupStatusKey = StatusPrefix + "/shared-lb-group"
 )
 
 // Ingress represents ingress annotations.
@@ -206,3 +246,50 @@ func (ing *Ingress) FrontendConfig() string {
 	}
 	return val
 }
+
+func (ing *Ingress) RouteConfig() string {
+	val, ok := ing.v[RouteConfigKey]
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// RequestMirrors returns the shadow Service of each mirrored backend Service,
+// keyed by the name of the backend Service.
+func (ing *Ingress) RequestMirrors() (map[string]v1.IngressServiceBackend, error) {
+	val, ok := ing.v[RequestMirrorKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var mirrors map[string]v1.IngressServiceBackend
+	if err := json.Unmarshal([]byte(val), &mirrors); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation %s: %v", RequestMirrorKey, err)
+	}
+	for name, mirror := range mirrors {
+		if mirror.Name == "" {
+			return nil, fmt.Errorf("invalid annotation %s: shadow service of %q has no name", RequestMirrorKey, name)
+		}
+		if mirror.Name == name {
+			return nil, fmt.Errorf("invalid annotation %s: service %q cannot be its own shadow service", RequestMirrorKey, name)
+		}
+		if (mirror.Port.Name == "") == (mirror.Port.Number == 0) {
+			return nil, fmt.Errorf("invalid annotation %s: exactly one of port name and number must be set for shadow service %q", RequestMirrorKey, mirror.Name)
+		}
+	}
+	return mirrors, nil
+}
+
+// SharedLBGroup returns the name of the shared load balancer group of the
+// Ingress, or an empty string if the Ingress has its own load balancer.
+func (ing *Ingress) SharedLBGroup() (string, error) {
+	val, ok := ing.v[SharedLBGroupKey]
+	if !ok || val == "" {
+		return "", nil
+	}
+	if msgs := validation.IsDNS1123Label(val); len(msgs) > 0 {
+		return "", fmt.Errorf("invalid annotation %s: %s", SharedLBGroupKey, strings.Join(msgs, ", "))
+	}
+	return val, nil
+}
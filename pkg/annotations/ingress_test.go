@@ -17,6 +17,7 @@ limitations under the License.
 package annotations
 
 import (
+	"reflect"
 	"testing"
 
 	v1 "k8s.io/api/networking/v1"
@@ -92,3 +93,96 @@ func TestIngress(t *testing.T) {
 		}
 	}
 }
+
+func TestRequestMirrors(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		val     string
+		want    map[string]v1.IngressServiceBackend
+		wantErr bool
+	}{
+		{
+			desc: "no annotation",
+		},
+		{
+			desc: "port number and port name",
+			val:  `{"web": {"name": "web-shadow", "port": {"number": 80}}, "api": {"name": "api-shadow", "port": {"name": "http"}}}`,
+			want: map[string]v1.IngressServiceBackend{
+				"web": {Name: "web-shadow", Port: v1.ServiceBackendPort{Number: 80}},
+				"api": {Name: "api-shadow", Port: v1.ServiceBackendPort{Name: "http"}},
+			},
+		},
+		{
+			desc:    "malformed json",
+			val:     `{"web": "web-shadow"}`,
+			wantErr: true,
+		},
+		{
+			desc:    "missing shadow service name",
+			val:     `{"web": {"port": {"number": 80}}}`,
+			wantErr: true,
+		},
+		{
+			desc:    "missing shadow service port",
+			val:     `{"web": {"name": "web-shadow"}}`,
+			wantErr: true,
+		},
+		{
+			desc:    "service mirrors to itself",
+			val:     `{"web": {"name": "web", "port": {"number": 80}}}`,
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ing := &v1.Ingress{}
+			if tc.val != "" {
+				ing.Annotations = map[string]string{RequestMirrorKey: tc.val}
+			}
+			got, err := FromIngress(ing).RequestMirrors()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RequestMirrors() = _, %v, wantErr = %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("RequestMirrors() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSharedLBGroup(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		annotations map[string]string
+		want        string
+		wantErr     bool
+	}{
+		{
+			desc: "no annotation",
+		},
+		{
+			desc:        "empty annotation",
+			annotations: map[string]string{SharedLBGroupKey: ""},
+		},
+		{
+			desc:        "valid group",
+			annotations: map[string]string{SharedLBGroupKey: "frontends"},
+			want:        "frontends",
+		},
+		{
+			desc:        "invalid group",
+			annotations: map[string]string{SharedLBGroupKey: "Front.Ends"},
+			wantErr:     true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ing := &v1.Ingress{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			got, err := FromIngress(ing).SharedLBGroup()
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("SharedLBGroup() = %v, want error %t", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("SharedLBGroup() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
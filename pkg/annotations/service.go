@@ -75,6 +75,9 @@ const (
 	ProtocolHTTPS AppProtocol = "HTTPS"
 	// ProtocolHTTP2 protocol for a service
 	ProtocolHTTP2 AppProtocol = "HTTP2"
+	// ProtocolGRPC protocol for a service. gRPC is served over HTTP/2 with
+	// TLS and health checked with the gRPC health checking protocol.
+	ProtocolGRPC AppProtocol = "GRPC"
 
 	IPv6Suffix = "-ipv6"
 	// ServiceStatusPrefix is the prefix used in annotations used to record
@@ -226,6 +229,16 @@ func ParseNegStatus(annotation string) (NegStatus, error) {
 // AppProtocol describes the service protocol.
 type AppProtocol string
 
+// BackendServiceProtocol returns the protocol of the backend service for the
+// app protocol. The GRPC protocol of backend services is only supported by
+// Traffic Director, so gRPC uses HTTP2 backend services.
+func (p AppProtocol) BackendServiceProtocol() string {
+	if p == ProtocolGRPC {
+		return string(ProtocolHTTP2)
+	}
+	return string(p)
+}
+
 // Service represents Service annotations.
 type Service struct {
 	v map[string]string
@@ -333,7 +346,7 @@ func (svc *Service) ApplicationProtocols() (map[string]AppProtocol, error) {
 	for _, proto := range portToProtos {
 		switch proto {
 		case ProtocolHTTP, ProtocolHTTPS:
-		case ProtocolHTTP2:
+		case ProtocolHTTP2, ProtocolGRPC:
 		default:
 			return nil, fmt.Errorf("invalid port application protocol: %v", proto)
 		}
@@ -281,6 +281,16 @@ func TestService(t *testing.T) {
 			},
 			appProtocols: map[string]AppProtocol{"443": "HTTP2"},
 		},
+		{
+			svc: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ServiceApplicationProtocolKey: `{"443": "GRPC"}`,
+					},
+				},
+			},
+			appProtocols: map[string]AppProtocol{"443": "GRPC"},
+		},
 		{
 			svc: &v1.Service{
 				ObjectMeta: metav1.ObjectMeta{
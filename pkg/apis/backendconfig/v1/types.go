@@ -21,7 +21,6 @@ import (
 )
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // +k8s:openapi-gen=true
@@ -36,9 +35,13 @@ type BackendConfig struct {
 // BackendConfigSpec is the spec for a BackendConfig resource
 // +k8s:openapi-gen=true
 type BackendConfigSpec struct {
-	Iap                   *IAPConfig                   `json:"iap,omitempty"`
-	Cdn                   *CDNConfig                   `json:"cdn,omitempty"`
-	SecurityPolicy        *SecurityPolicyConfig        `json:"securityPolicy,omitempty"`
+	Iap            *IAPConfig            `json:"iap,omitempty"`
+	Cdn            *CDNConfig            `json:"cdn,omitempty"`
+	SecurityPolicy *SecurityPolicyConfig `json:"securityPolicy,omitempty"`
+	// EdgeSecurityPolicy is the Cloud Armor edge security policy of a
+	// CDN-enabled backend. It is reconciled independently of the
+	// SecurityPolicy.
+	EdgeSecurityPolicy    *SecurityPolicyConfig        `json:"edgeSecurityPolicy,omitempty"`
 	TimeoutSec            *int64                       `json:"timeoutSec,omitempty"`
 	ConnectionDraining    *ConnectionDrainingConfig    `json:"connectionDraining,omitempty"`
 	SessionAffinity       *SessionAffinityConfig       `json:"sessionAffinity,omitempty"`
@@ -47,12 +50,89 @@ type BackendConfigSpec struct {
 	HealthCheck           *HealthCheckConfig           `json:"healthCheck,omitempty"`
 	// Logging specifies the configuration for access logs.
 	Logging *LogConfig `json:"logging,omitempty"`
+	// RetryPolicy specifies how failed requests to this backend are retried.
+	// Only supported by the gce-internal and gce-regional-external Ingress
+	// classes.
+	RetryPolicy *RetryPolicyConfig `json:"retryPolicy,omitempty"`
+	// FaultInjectionPolicy specifies faults injected into requests to this
+	// backend, for testing the resilience of clients. Only supported by the
+	// gce-internal and gce-regional-external Ingress classes.
+	FaultInjectionPolicy *FaultInjectionPolicyConfig `json:"faultInjectionPolicy,omitempty"`
+	// RouteTimeout is the timeout for requests routed to this backend, from
+	// the time the request is fully processed until the response is fully
+	// processed, including all retries. Only supported by the gce-internal
+	// and gce-regional-external Ingress classes.
+	RouteTimeout *DurationConfig `json:"routeTimeout,omitempty"`
+	// CircuitBreakers specifies limits on the traffic sent to the endpoints
+	// of this backend. Only supported by the gce-internal and
+	// gce-regional-external Ingress classes.
+	CircuitBreakers *CircuitBreakersConfig `json:"circuitBreakers,omitempty"`
+	// OutlierDetection specifies how unhealthy endpoints of this backend are
+	// detected and ejected from the load balancing pool. Only supported by
+	// the gce-internal and gce-regional-external Ingress classes.
+	OutlierDetection *OutlierDetectionConfig `json:"outlierDetection,omitempty"`
+	// Capacity specifies the balancing mode and the capacity of the NEGs or
+	// instance groups of this backend.
+	Capacity *CapacityConfig `json:"capacity,omitempty"`
 }
 
 // BackendConfigStatus is the status for a BackendConfig resource
+// +k8s:openapi-gen=true
 type BackendConfigStatus struct {
+	// Conditions describe the current conditions of the BackendConfig.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// BackendServices are the names of the backend services which use the
+	// BackendConfig.
+	BackendServices []string `json:"backendServices,omitempty"`
+	// Ingresses are the namespaced names of the Ingresses which use the
+	// BackendConfig.
+	Ingresses []string `json:"ingresses,omitempty"`
+	// RetiringSignedUrlKeys are the signed URL keys removed from the spec
+	// which are kept on backend services until their grace period expires.
+	RetiringSignedUrlKeys []RetiringSignedUrlKey `json:"retiringSignedUrlKeys,omitempty"`
+}
+
+// RetiringSignedUrlKey is a signed URL key of a backend service that is
+// deleted after the rotation grace period.
+// +k8s:openapi-gen=true
+type RetiringSignedUrlKey struct {
+	// BackendService is the name of the backend service.
+	BackendService string `json:"backendService"`
+	// KeyName is the name of the key.
+	KeyName string `json:"keyName"`
+	// DeletionTime is the time after which the key is deleted.
+	DeletionTime metav1.Time `json:"deletionTime"`
 }
 
+// BackendConfigConditionType is the type of a condition of a BackendConfig.
+type BackendConfigConditionType string
+
+// BackendConfigConditionReason is the reason of a condition of a
+// BackendConfig.
+type BackendConfigConditionReason string
+
+const (
+	// BackendConfigConditionAccepted is true if the BackendConfig is valid for
+	// all service ports which use it.
+	BackendConfigConditionAccepted BackendConfigConditionType = "Accepted"
+	// BackendConfigConditionProgrammed is true if the backend services which
+	// use the BackendConfig are configured.
+	BackendConfigConditionProgrammed BackendConfigConditionType = "Programmed"
+	// BackendConfigConditionSecurityPolicyAttached is true if the security
+	// policies of the BackendConfig exist and are attached to its backend
+	// services.
+	BackendConfigConditionSecurityPolicyAttached BackendConfigConditionType = "SecurityPolicyAttached"
+
+	BackendConfigReasonAccepted               BackendConfigConditionReason = "Accepted"
+	BackendConfigReasonInvalid                BackendConfigConditionReason = "Invalid"
+	BackendConfigReasonProgrammed             BackendConfigConditionReason = "Programmed"
+	BackendConfigReasonSyncFailed             BackendConfigConditionReason = "SyncFailed"
+	BackendConfigReasonNotInUse               BackendConfigConditionReason = "NotInUse"
+	BackendConfigReasonSecurityPolicyAttached BackendConfigConditionReason = "Attached"
+	BackendConfigReasonSecurityPolicyNotFound BackendConfigConditionReason = "PolicyNotFound"
+	BackendConfigReasonSecurityPolicyFailed   BackendConfigConditionReason = "AttachFailed"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // BackendConfigList is a list of BackendConfig resources
@@ -64,10 +144,15 @@ type BackendConfigList struct {
 }
 
 // IAPConfig contains configuration for IAP-enabled backends.
+// Access settings such as the allowed domains and the re-authentication
+// policy are settings of the IAP API, not of the backend service, and are not
+// managed by the controller.
 // +k8s:openapi-gen=true
 type IAPConfig struct {
-	Enabled                bool                    `json:"enabled"`
-	OAuthClientCredentials *OAuthClientCredentials `json:"oauthclientCredentials"`
+	Enabled bool `json:"enabled"`
+	// OAuthClientCredentials of a custom OAuth client. If not specified, IAP
+	// uses a Google-managed OAuth client.
+	OAuthClientCredentials *OAuthClientCredentials `json:"oauthclientCredentials,omitempty"`
 }
 
 // OAuthClientCredentials contains credentials for a single IAP-enabled backend.
@@ -97,6 +182,11 @@ type CDNConfig struct {
 	ServeWhileStale             *int64                        `json:"serveWhileStale,omitempty"`
 	SignedUrlCacheMaxAgeSec     *int64                        `json:"signedUrlCacheMaxAgeSec,omitempty"`
 	SignedUrlKeys               []*SignedUrlKey               `json:"signedUrlKeys,omitempty"`
+	// SignedUrlKeyRotationGracePeriodSec is the time in seconds a key
+	// removed from SignedUrlKeys is kept on the backend services, so that
+	// URLs signed with it remain valid while the new key is rolled out. If
+	// unset, removed keys are deleted immediately.
+	SignedUrlKeyRotationGracePeriodSec *int64 `json:"signedUrlKeyRotationGracePeriodSec,omitempty"`
 }
 
 // BypassCacheOnRequestHeader contains configuration for how requests containing specific request
@@ -190,6 +280,41 @@ type ConnectionDrainingConfig struct {
 type SessionAffinityConfig struct {
 	AffinityType         string `json:"affinityType,omitempty"`
 	AffinityCookieTtlSec *int64 `json:"affinityCookieTtlSec,omitempty"`
+	// LocalityLbPolicy is the load balancing algorithm used within a zone,
+	// one of ROUND_ROBIN, LEAST_REQUEST, RING_HASH or MAGLEV. Session
+	// affinity other than NONE only takes effect with RING_HASH or MAGLEV.
+	// Only supported by the gce-internal and gce-regional-external Ingress
+	// classes.
+	LocalityLbPolicy string `json:"localityLbPolicy,omitempty"`
+	// ConsistentHash specifies the hash key of the RING_HASH and MAGLEV
+	// locality load balancing policies. Only supported by the gce-internal
+	// and gce-regional-external Ingress classes.
+	ConsistentHash *ConsistentHashConfig `json:"consistentHash,omitempty"`
+}
+
+// ConsistentHashConfig contains configuration for consistent hash load
+// balancing.
+// +k8s:openapi-gen=true
+type ConsistentHashConfig struct {
+	// HttpHeaderName is the header hashed with the HEADER_FIELD affinity
+	// type.
+	HttpHeaderName string `json:"httpHeaderName,omitempty"`
+	// HttpCookie is the cookie hashed with the HTTP_COOKIE affinity type.
+	// The cookie is generated if the request does not have it.
+	HttpCookie *ConsistentHashHttpCookieConfig `json:"httpCookie,omitempty"`
+	// MinimumRingSize is the minimum number of virtual nodes of the hash
+	// ring, defaults to 1024.
+	MinimumRingSize int64 `json:"minimumRingSize,omitempty"`
+}
+
+// ConsistentHashHttpCookieConfig specifies the cookie hashed for consistent
+// hash load balancing.
+// +k8s:openapi-gen=true
+type ConsistentHashHttpCookieConfig struct {
+	Name string `json:"name"`
+	Path string `json:"path,omitempty"`
+	// Ttl is the lifetime of the cookie.
+	Ttl *DurationConfig `json:"ttl,omitempty"`
 }
 
 // CustomRequestHeadersConfig contains configuration for custom request headers
@@ -229,6 +354,158 @@ type HealthCheckConfig struct {
 	// RequestPath is a health check parameter. See
 	// https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.
 	RequestPath *string `json:"requestPath,omitempty"`
+	// Host is the value of the Host header sent in HTTP, HTTPS and HTTP2
+	// health check requests. See
+	// https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.
+	Host *string `json:"host,omitempty"`
+	// Response is the string expected at the beginning of the response body
+	// of HTTP, HTTPS and HTTP2 health checks. See
+	// https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.
+	Response *string `json:"response,omitempty"`
+	// ProxyHeader is the type of proxy header prepended to HTTP, HTTPS and
+	// HTTP2 health check requests, either NONE or PROXY_V1. See
+	// https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.
+	ProxyHeader *string `json:"proxyHeader,omitempty"`
+	// GrpcServiceName is the gRPC service name checked by GRPC health checks.
+	// See https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.
+	GrpcServiceName *string `json:"grpcServiceName,omitempty"`
+}
+
+// RetryPolicyConfig contains configuration for retrying failed requests.
+// +k8s:openapi-gen=true
+type RetryPolicyConfig struct {
+	// RetryConditions specifies the conditions under which a request is
+	// retried, e.g. 5xx, gateway-error or connect-failure.
+	RetryConditions []string `json:"retryConditions,omitempty"`
+	// NumRetries is the number of retries allowed, defaults to 1.
+	NumRetries *int64 `json:"numRetries,omitempty"`
+	// PerTryTimeout is the timeout of each try, defaults to the route timeout.
+	PerTryTimeout *DurationConfig `json:"perTryTimeout,omitempty"`
+}
+
+// FaultInjectionPolicyConfig contains configuration for injecting delays
+// and aborts into requests.
+// +k8s:openapi-gen=true
+type FaultInjectionPolicyConfig struct {
+	Delay *FaultDelayConfig `json:"delay,omitempty"`
+	Abort *FaultAbortConfig `json:"abort,omitempty"`
+}
+
+// FaultDelayConfig specifies a delay injected into a percentage of requests.
+// +k8s:openapi-gen=true
+type FaultDelayConfig struct {
+	FixedDelay DurationConfig `json:"fixedDelay"`
+	// The value of the field must be in [0, 100].
+	Percentage float64 `json:"percentage"`
+}
+
+// FaultAbortConfig specifies the status code returned for a percentage of
+// requests instead of sending them to the backend.
+// +k8s:openapi-gen=true
+type FaultAbortConfig struct {
+	HttpStatus int64 `json:"httpStatus"`
+	// The value of the field must be in [0, 100].
+	Percentage float64 `json:"percentage"`
+}
+
+// DurationConfig is a span of time.
+// +k8s:openapi-gen=true
+type DurationConfig struct {
+	Seconds int64 `json:"seconds,omitempty"`
+	// Nanos must be in [0, 999999999].
+	Nanos int64 `json:"nanos,omitempty"`
+}
+
+// CircuitBreakersConfig contains configuration for the circuit breakers of a
+// backend. A limit of 0 means that the GCE default is used.
+// +k8s:openapi-gen=true
+type CircuitBreakersConfig struct {
+	// MaxConnections is the maximum number of connections to the backend.
+	MaxConnections int64 `json:"maxConnections,omitempty"`
+	// MaxPendingRequests is the maximum number of requests waiting for a
+	// connection to the backend.
+	MaxPendingRequests int64 `json:"maxPendingRequests,omitempty"`
+	// MaxRequests is the maximum number of parallel requests to the backend.
+	MaxRequests int64 `json:"maxRequests,omitempty"`
+	// MaxRequestsPerConnection is the maximum number of requests over a
+	// single connection to the backend.
+	MaxRequestsPerConnection int64 `json:"maxRequestsPerConnection,omitempty"`
+	// MaxRetries is the maximum number of parallel retries to the backend.
+	MaxRetries int64 `json:"maxRetries,omitempty"`
+}
+
+// OutlierDetectionConfig contains configuration for the ejection of unhealthy
+// endpoints. A field left unset or set to 0 means that the GCE default is
+// used. See
+// https://cloud.google.com/compute/docs/reference/rest/v1/backendServices.
+// +k8s:openapi-gen=true
+type OutlierDetectionConfig struct {
+	// BaseEjectionTime is the base time an endpoint is ejected for.
+	BaseEjectionTime *DurationConfig `json:"baseEjectionTime,omitempty"`
+	// ConsecutiveErrors is the number of errors before an endpoint is
+	// ejected.
+	ConsecutiveErrors int64 `json:"consecutiveErrors,omitempty"`
+	// ConsecutiveGatewayFailure is the number of consecutive gateway
+	// failures before an endpoint is ejected.
+	ConsecutiveGatewayFailure int64 `json:"consecutiveGatewayFailure,omitempty"`
+	// The value of the field must be in [0, 100].
+	EnforcingConsecutiveErrors int64 `json:"enforcingConsecutiveErrors,omitempty"`
+	// The value of the field must be in [0, 100].
+	EnforcingConsecutiveGatewayFailure int64 `json:"enforcingConsecutiveGatewayFailure,omitempty"`
+	// The value of the field must be in [0, 100].
+	EnforcingSuccessRate int64 `json:"enforcingSuccessRate,omitempty"`
+	// Interval is the time between ejection analysis sweeps.
+	Interval *DurationConfig `json:"interval,omitempty"`
+	// The value of the field must be in [0, 100].
+	MaxEjectionPercent int64 `json:"maxEjectionPercent,omitempty"`
+	// SuccessRateMinimumHosts is the number of endpoints that must have
+	// enough request volume to detect success rate outliers.
+	SuccessRateMinimumHosts int64 `json:"successRateMinimumHosts,omitempty"`
+	// SuccessRateRequestVolume is the minimum number of requests in an
+	// interval to include an endpoint in success rate outlier detection.
+	SuccessRateRequestVolume int64 `json:"successRateRequestVolume,omitempty"`
+	// SuccessRateStdevFactor determines the ejection threshold for success
+	// rate outliers, multiplied by a thousand. For example, a factor of 1.9
+	// is set as 1900.
+	SuccessRateStdevFactor int64 `json:"successRateStdevFactor,omitempty"`
+}
+
+// CapacityConfig contains configuration for the balancing mode and the
+// capacity of the backends of a backend service. Settings which are not
+// specified keep the values set by the controller.
+// +k8s:openapi-gen=true
+type CapacityConfig struct {
+	// BalancingMode is one of RATE or UTILIZATION, defaults to RATE.
+	// UTILIZATION is only supported by instance groups. HTTP(S) load
+	// balancers do not support the CONNECTION balancing mode.
+	BalancingMode string `json:"balancingMode,omitempty"`
+	// MaxRatePerEndpoint is the maximum requests per second of an endpoint
+	// or instance. Only used with the RATE and UTILIZATION balancing modes.
+	MaxRatePerEndpoint *float64 `json:"maxRatePerEndpoint,omitempty"`
+	// MaxConnectionsPerEndpoint is the maximum number of connections to an
+	// instance. Only used with the UTILIZATION balancing mode.
+	MaxConnectionsPerEndpoint *int64 `json:"maxConnectionsPerEndpoint,omitempty"`
+	// CapacityScaler scales the capacity of the backends. The value of the
+	// field must be in [0, 1], 0 stops traffic to the backends.
+	CapacityScaler *float64 `json:"capacityScaler,omitempty"`
+	// MaxUtilization is the target CPU utilization of the instances. The
+	// value of the field must be in [0, 1]. Only used with the UTILIZATION
+	// balancing mode.
+	MaxUtilization *float64 `json:"maxUtilization,omitempty"`
+	// Zones overrides the capacity settings of the backends in specific
+	// zones, e.g. to drain a zone during maintenance.
+	Zones []ZoneCapacityConfig `json:"zones,omitempty"`
+}
+
+// ZoneCapacityConfig contains the capacity settings of the backends in a
+// zone. Settings which are not specified are taken from the CapacityConfig.
+// +k8s:openapi-gen=true
+type ZoneCapacityConfig struct {
+	Zone                      string   `json:"zone"`
+	MaxRatePerEndpoint        *float64 `json:"maxRatePerEndpoint,omitempty"`
+	MaxConnectionsPerEndpoint *int64   `json:"maxConnectionsPerEndpoint,omitempty"`
+	CapacityScaler            *float64 `json:"capacityScaler,omitempty"`
+	MaxUtilization            *float64 `json:"maxUtilization,omitempty"`
 }
 
 // LogConfig contains configuration for logging.
@@ -22,6 +22,7 @@ limitations under the License.
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -31,7 +32,7 @@ func (in *BackendConfig) DeepCopyInto(out *BackendConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -104,6 +105,11 @@ func (in *BackendConfigSpec) DeepCopyInto(out *BackendConfigSpec) {
 		*out = new(SecurityPolicyConfig)
 		**out = **in
 	}
+	if in.EdgeSecurityPolicy != nil {
+		in, out := &in.EdgeSecurityPolicy, &out.EdgeSecurityPolicy
+		*out = new(SecurityPolicyConfig)
+		**out = **in
+	}
 	if in.TimeoutSec != nil {
 		in, out := &in.TimeoutSec, &out.TimeoutSec
 		*out = new(int64)
@@ -139,6 +145,36 @@ func (in *BackendConfigSpec) DeepCopyInto(out *BackendConfigSpec) {
 		*out = new(LogConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FaultInjectionPolicy != nil {
+		in, out := &in.FaultInjectionPolicy, &out.FaultInjectionPolicy
+		*out = new(FaultInjectionPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouteTimeout != nil {
+		in, out := &in.RouteTimeout, &out.RouteTimeout
+		*out = new(DurationConfig)
+		**out = **in
+	}
+	if in.CircuitBreakers != nil {
+		in, out := &in.CircuitBreakers, &out.CircuitBreakers
+		*out = new(CircuitBreakersConfig)
+		**out = **in
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(CapacityConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -155,6 +191,30 @@ func (in *BackendConfigSpec) DeepCopy() *BackendConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendConfigStatus) DeepCopyInto(out *BackendConfigStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackendServices != nil {
+		in, out := &in.BackendServices, &out.BackendServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ingresses != nil {
+		in, out := &in.Ingresses, &out.Ingresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetiringSignedUrlKeys != nil {
+		in, out := &in.RetiringSignedUrlKeys, &out.RetiringSignedUrlKeys
+		*out = make([]RetiringSignedUrlKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -265,6 +325,11 @@ func (in *CDNConfig) DeepCopyInto(out *CDNConfig) {
 			}
 		}
 	}
+	if in.SignedUrlKeyRotationGracePeriodSec != nil {
+		in, out := &in.SignedUrlKeyRotationGracePeriodSec, &out.SignedUrlKeyRotationGracePeriodSec
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -304,6 +369,65 @@ func (in *CacheKeyPolicy) DeepCopy() *CacheKeyPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityConfig) DeepCopyInto(out *CapacityConfig) {
+	*out = *in
+	if in.MaxRatePerEndpoint != nil {
+		in, out := &in.MaxRatePerEndpoint, &out.MaxRatePerEndpoint
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxConnectionsPerEndpoint != nil {
+		in, out := &in.MaxConnectionsPerEndpoint, &out.MaxConnectionsPerEndpoint
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CapacityScaler != nil {
+		in, out := &in.CapacityScaler, &out.CapacityScaler
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxUtilization != nil {
+		in, out := &in.MaxUtilization, &out.MaxUtilization
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ZoneCapacityConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityConfig.
+func (in *CapacityConfig) DeepCopy() *CapacityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakersConfig) DeepCopyInto(out *CircuitBreakersConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakersConfig.
+func (in *CircuitBreakersConfig) DeepCopy() *CircuitBreakersConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakersConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionDrainingConfig) DeepCopyInto(out *ConnectionDrainingConfig) {
 	*out = *in
@@ -320,6 +444,48 @@ func (in *ConnectionDrainingConfig) DeepCopy() *ConnectionDrainingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsistentHashConfig) DeepCopyInto(out *ConsistentHashConfig) {
+	*out = *in
+	if in.HttpCookie != nil {
+		in, out := &in.HttpCookie, &out.HttpCookie
+		*out = new(ConsistentHashHttpCookieConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsistentHashConfig.
+func (in *ConsistentHashConfig) DeepCopy() *ConsistentHashConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsistentHashConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsistentHashHttpCookieConfig) DeepCopyInto(out *ConsistentHashHttpCookieConfig) {
+	*out = *in
+	if in.Ttl != nil {
+		in, out := &in.Ttl, &out.Ttl
+		*out = new(DurationConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsistentHashHttpCookieConfig.
+func (in *ConsistentHashHttpCookieConfig) DeepCopy() *ConsistentHashHttpCookieConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsistentHashHttpCookieConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomRequestHeadersConfig) DeepCopyInto(out *CustomRequestHeadersConfig) {
 	*out = *in
@@ -362,6 +528,81 @@ func (in *CustomResponseHeadersConfig) DeepCopy() *CustomResponseHeadersConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationConfig) DeepCopyInto(out *DurationConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationConfig.
+func (in *DurationConfig) DeepCopy() *DurationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultAbortConfig) DeepCopyInto(out *FaultAbortConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbortConfig.
+func (in *FaultAbortConfig) DeepCopy() *FaultAbortConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultAbortConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultDelayConfig) DeepCopyInto(out *FaultDelayConfig) {
+	*out = *in
+	out.FixedDelay = in.FixedDelay
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelayConfig.
+func (in *FaultDelayConfig) DeepCopy() *FaultDelayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultDelayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionPolicyConfig) DeepCopyInto(out *FaultInjectionPolicyConfig) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelayConfig)
+		**out = **in
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbortConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionPolicyConfig.
+func (in *FaultInjectionPolicyConfig) DeepCopy() *FaultInjectionPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 	*out = *in
@@ -400,6 +641,26 @@ func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyHeader != nil {
+		in, out := &in.ProxyHeader, &out.ProxyHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.GrpcServiceName != nil {
+		in, out := &in.GrpcServiceName, &out.GrpcServiceName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -487,6 +748,80 @@ func (in *OAuthClientCredentials) DeepCopy() *OAuthClientCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetectionConfig) DeepCopyInto(out *OutlierDetectionConfig) {
+	*out = *in
+	if in.BaseEjectionTime != nil {
+		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
+		*out = new(DurationConfig)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(DurationConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetectionConfig.
+func (in *OutlierDetectionConfig) DeepCopy() *OutlierDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetiringSignedUrlKey) DeepCopyInto(out *RetiringSignedUrlKey) {
+	*out = *in
+	in.DeletionTime.DeepCopyInto(&out.DeletionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetiringSignedUrlKey.
+func (in *RetiringSignedUrlKey) DeepCopy() *RetiringSignedUrlKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RetiringSignedUrlKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicyConfig) DeepCopyInto(out *RetryPolicyConfig) {
+	*out = *in
+	if in.RetryConditions != nil {
+		in, out := &in.RetryConditions, &out.RetryConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(DurationConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicyConfig.
+func (in *RetryPolicyConfig) DeepCopy() *RetryPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicyConfig) DeepCopyInto(out *SecurityPolicyConfig) {
 	*out = *in
@@ -511,6 +846,11 @@ func (in *SessionAffinityConfig) DeepCopyInto(out *SessionAffinityConfig) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ConsistentHash != nil {
+		in, out := &in.ConsistentHash, &out.ConsistentHash
+		*out = new(ConsistentHashConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -539,3 +879,39 @@ func (in *SignedUrlKey) DeepCopy() *SignedUrlKey {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneCapacityConfig) DeepCopyInto(out *ZoneCapacityConfig) {
+	*out = *in
+	if in.MaxRatePerEndpoint != nil {
+		in, out := &in.MaxRatePerEndpoint, &out.MaxRatePerEndpoint
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxConnectionsPerEndpoint != nil {
+		in, out := &in.MaxConnectionsPerEndpoint, &out.MaxConnectionsPerEndpoint
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CapacityScaler != nil {
+		in, out := &in.CapacityScaler, &out.CapacityScaler
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxUtilization != nil {
+		in, out := &in.MaxUtilization, &out.MaxUtilization
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneCapacityConfig.
+func (in *ZoneCapacityConfig) DeepCopy() *ZoneCapacityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneCapacityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,22 +30,35 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.BackendConfig":               schema_pkg_apis_backendconfig_v1_BackendConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.BackendConfigSpec":           schema_pkg_apis_backendconfig_v1_BackendConfigSpec(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.BypassCacheOnRequestHeader":  schema_pkg_apis_backendconfig_v1_BypassCacheOnRequestHeader(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CDNConfig":                   schema_pkg_apis_backendconfig_v1_CDNConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CacheKeyPolicy":              schema_pkg_apis_backendconfig_v1_CacheKeyPolicy(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConnectionDrainingConfig":    schema_pkg_apis_backendconfig_v1_ConnectionDrainingConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CustomRequestHeadersConfig":  schema_pkg_apis_backendconfig_v1_CustomRequestHeadersConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CustomResponseHeadersConfig": schema_pkg_apis_backendconfig_v1_CustomResponseHeadersConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.HealthCheckConfig":           schema_pkg_apis_backendconfig_v1_HealthCheckConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.IAPConfig":                   schema_pkg_apis_backendconfig_v1_IAPConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.LogConfig":                   schema_pkg_apis_backendconfig_v1_LogConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.NegativeCachingPolicy":       schema_pkg_apis_backendconfig_v1_NegativeCachingPolicy(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.OAuthClientCredentials":      schema_pkg_apis_backendconfig_v1_OAuthClientCredentials(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SecurityPolicyConfig":        schema_pkg_apis_backendconfig_v1_SecurityPolicyConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SessionAffinityConfig":       schema_pkg_apis_backendconfig_v1_SessionAffinityConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SignedUrlKey":                schema_pkg_apis_backendconfig_v1_SignedUrlKey(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.BackendConfig":                  schema_pkg_apis_backendconfig_v1_BackendConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.BackendConfigSpec":              schema_pkg_apis_backendconfig_v1_BackendConfigSpec(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.BackendConfigStatus":            schema_pkg_apis_backendconfig_v1_BackendConfigStatus(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.BypassCacheOnRequestHeader":     schema_pkg_apis_backendconfig_v1_BypassCacheOnRequestHeader(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CDNConfig":                      schema_pkg_apis_backendconfig_v1_CDNConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CacheKeyPolicy":                 schema_pkg_apis_backendconfig_v1_CacheKeyPolicy(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CapacityConfig":                 schema_pkg_apis_backendconfig_v1_CapacityConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CircuitBreakersConfig":          schema_pkg_apis_backendconfig_v1_CircuitBreakersConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConnectionDrainingConfig":       schema_pkg_apis_backendconfig_v1_ConnectionDrainingConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConsistentHashConfig":           schema_pkg_apis_backendconfig_v1_ConsistentHashConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConsistentHashHttpCookieConfig": schema_pkg_apis_backendconfig_v1_ConsistentHashHttpCookieConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CustomRequestHeadersConfig":     schema_pkg_apis_backendconfig_v1_CustomRequestHeadersConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CustomResponseHeadersConfig":    schema_pkg_apis_backendconfig_v1_CustomResponseHeadersConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig":                 schema_pkg_apis_backendconfig_v1_DurationConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultAbortConfig":               schema_pkg_apis_backendconfig_v1_FaultAbortConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultDelayConfig":               schema_pkg_apis_backendconfig_v1_FaultDelayConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultInjectionPolicyConfig":     schema_pkg_apis_backendconfig_v1_FaultInjectionPolicyConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.HealthCheckConfig":              schema_pkg_apis_backendconfig_v1_HealthCheckConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.IAPConfig":                      schema_pkg_apis_backendconfig_v1_IAPConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.LogConfig":                      schema_pkg_apis_backendconfig_v1_LogConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.NegativeCachingPolicy":          schema_pkg_apis_backendconfig_v1_NegativeCachingPolicy(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.OAuthClientCredentials":         schema_pkg_apis_backendconfig_v1_OAuthClientCredentials(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.OutlierDetectionConfig":         schema_pkg_apis_backendconfig_v1_OutlierDetectionConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.RetiringSignedUrlKey":           schema_pkg_apis_backendconfig_v1_RetiringSignedUrlKey(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.RetryPolicyConfig":              schema_pkg_apis_backendconfig_v1_RetryPolicyConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SecurityPolicyConfig":           schema_pkg_apis_backendconfig_v1_SecurityPolicyConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SessionAffinityConfig":          schema_pkg_apis_backendconfig_v1_SessionAffinityConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SignedUrlKey":                   schema_pkg_apis_backendconfig_v1_SignedUrlKey(ref),
+		"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ZoneCapacityConfig":             schema_pkg_apis_backendconfig_v1_ZoneCapacityConfig(ref),
 	}
 }
 
@@ -117,6 +130,12 @@ func schema_pkg_apis_backendconfig_v1_BackendConfigSpec(ref common.ReferenceCall
 							Ref: ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SecurityPolicyConfig"),
 						},
 					},
+					"edgeSecurityPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EdgeSecurityPolicy is the Cloud Armor edge security policy of a CDN-enabled backend. It is reconciled independently of the SecurityPolicy.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SecurityPolicyConfig"),
+						},
+					},
 					"timeoutSec": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
@@ -154,11 +173,120 @@ func schema_pkg_apis_backendconfig_v1_BackendConfigSpec(ref common.ReferenceCall
 							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.LogConfig"),
 						},
 					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy specifies how failed requests to this backend are retried. Only supported by the gce-internal and gce-regional-external Ingress classes.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.RetryPolicyConfig"),
+						},
+					},
+					"faultInjectionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FaultInjectionPolicy specifies faults injected into requests to this backend, for testing the resilience of clients. Only supported by the gce-internal and gce-regional-external Ingress classes.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultInjectionPolicyConfig"),
+						},
+					},
+					"routeTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RouteTimeout is the timeout for requests routed to this backend, from the time the request is fully processed until the response is fully processed, including all retries. Only supported by the gce-internal and gce-regional-external Ingress classes.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"),
+						},
+					},
+					"circuitBreakers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CircuitBreakers specifies limits on the traffic sent to the endpoints of this backend. Only supported by the gce-internal and gce-regional-external Ingress classes.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CircuitBreakersConfig"),
+						},
+					},
+					"outlierDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutlierDetection specifies how unhealthy endpoints of this backend are detected and ejected from the load balancing pool. Only supported by the gce-internal and gce-regional-external Ingress classes.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.OutlierDetectionConfig"),
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity specifies the balancing mode and the capacity of the NEGs or instance groups of this backend.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CapacityConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CDNConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConnectionDrainingConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CustomRequestHeadersConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CustomResponseHeadersConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.HealthCheckConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.IAPConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.LogConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SecurityPolicyConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SessionAffinityConfig"},
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CDNConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CapacityConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CircuitBreakersConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConnectionDrainingConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CustomRequestHeadersConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.CustomResponseHeadersConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultInjectionPolicyConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.HealthCheckConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.IAPConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.LogConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.OutlierDetectionConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.RetryPolicyConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SecurityPolicyConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.SessionAffinityConfig"},
+	}
+}
+
+func schema_pkg_apis_backendconfig_v1_BackendConfigStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackendConfigStatus is the status for a BackendConfig resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions describe the current conditions of the BackendConfig.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"backendServices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackendServices are the names of the backend services which use the BackendConfig.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ingresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingresses are the namespaced names of the Ingresses which use the BackendConfig.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"retiringSignedUrlKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetiringSignedUrlKeys are the signed URL keys removed from the spec which are kept on backend services until their grace period expires.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.RetiringSignedUrlKey"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.RetiringSignedUrlKey"},
 	}
 }
 
@@ -285,6 +413,13 @@ func schema_pkg_apis_backendconfig_v1_CDNConfig(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"signedUrlKeyRotationGracePeriodSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignedUrlKeyRotationGracePeriodSec is the time in seconds a key removed from SignedUrlKeys is kept on the backend services, so that URLs signed with it remain valid while the new key is rolled out. If unset, removed keys are deleted immediately.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"enabled"},
 			},
@@ -358,6 +493,118 @@ func schema_pkg_apis_backendconfig_v1_CacheKeyPolicy(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_backendconfig_v1_CapacityConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CapacityConfig contains configuration for the balancing mode and the capacity of the backends of a backend service. Settings which are not specified keep the values set by the controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"balancingMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BalancingMode is one of RATE or UTILIZATION, defaults to RATE. UTILIZATION is only supported by instance groups. HTTP(S) load balancers do not support the CONNECTION balancing mode.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxRatePerEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRatePerEndpoint is the maximum requests per second of an endpoint or instance. Only used with the RATE and UTILIZATION balancing modes.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"maxConnectionsPerEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnectionsPerEndpoint is the maximum number of connections to an instance. Only used with the UTILIZATION balancing mode.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"capacityScaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapacityScaler scales the capacity of the backends. The value of the field must be in [0, 1], 0 stops traffic to the backends.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"maxUtilization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUtilization is the target CPU utilization of the instances. The value of the field must be in [0, 1]. Only used with the UTILIZATION balancing mode.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones overrides the capacity settings of the backends in specific zones, e.g. to drain a zone during maintenance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ZoneCapacityConfig"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ZoneCapacityConfig"},
+	}
+}
+
+func schema_pkg_apis_backendconfig_v1_CircuitBreakersConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CircuitBreakersConfig contains configuration for the circuit breakers of a backend. A limit of 0 means that the GCE default is used.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnections is the maximum number of connections to the backend.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxPendingRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPendingRequests is the maximum number of requests waiting for a connection to the backend.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRequests is the maximum number of parallel requests to the backend.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxRequestsPerConnection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRequestsPerConnection is the maximum number of requests over a single connection to the backend.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the maximum number of parallel retries to the backend.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_backendconfig_v1_ConnectionDrainingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -378,6 +625,76 @@ func schema_pkg_apis_backendconfig_v1_ConnectionDrainingConfig(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_backendconfig_v1_ConsistentHashConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsistentHashConfig contains configuration for consistent hash load balancing.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"httpHeaderName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HttpHeaderName is the header hashed with the HEADER_FIELD affinity type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"httpCookie": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HttpCookie is the cookie hashed with the HTTP_COOKIE affinity type. The cookie is generated if the request does not have it.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConsistentHashHttpCookieConfig"),
+						},
+					},
+					"minimumRingSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinimumRingSize is the minimum number of virtual nodes of the hash ring, defaults to 1024.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConsistentHashHttpCookieConfig"},
+	}
+}
+
+func schema_pkg_apis_backendconfig_v1_ConsistentHashHttpCookieConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConsistentHashHttpCookieConfig specifies the cookie hashed for consistent hash load balancing.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ttl is the lifetime of the cookie.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"},
+	}
+}
+
 func schema_pkg_apis_backendconfig_v1_CustomRequestHeadersConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -432,6 +749,116 @@ func schema_pkg_apis_backendconfig_v1_CustomResponseHeadersConfig(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_backendconfig_v1_DurationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DurationConfig is a span of time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"seconds": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"nanos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nanos must be in [0, 999999999].",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_backendconfig_v1_FaultAbortConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FaultAbortConfig specifies the status code returned for a percentage of requests instead of sending them to the backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"httpStatus": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"percentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The value of the field must be in [0, 100].",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+				Required: []string{"httpStatus", "percentage"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_backendconfig_v1_FaultDelayConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FaultDelayConfig specifies a delay injected into a percentage of requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fixedDelay": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"),
+						},
+					},
+					"percentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The value of the field must be in [0, 100].",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+				Required: []string{"fixedDelay", "percentage"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"},
+	}
+}
+
+func schema_pkg_apis_backendconfig_v1_FaultInjectionPolicyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FaultInjectionPolicyConfig contains configuration for injecting delays and aborts into requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"delay": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultDelayConfig"),
+						},
+					},
+					"abort": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultAbortConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultAbortConfig", "k8s.io/ingress-gce/pkg/apis/backendconfig/v1.FaultDelayConfig"},
+	}
+}
+
 func schema_pkg_apis_backendconfig_v1_HealthCheckConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -488,6 +915,34 @@ func schema_pkg_apis_backendconfig_v1_HealthCheckConfig(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host is the value of the Host header sent in HTTP, HTTPS and HTTP2 health check requests. See https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Response is the string expected at the beginning of the response body of HTTP, HTTPS and HTTP2 health checks. See https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"proxyHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProxyHeader is the type of proxy header prepended to HTTP, HTTPS and HTTP2 health check requests, either NONE or PROXY_V1. See https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"grpcServiceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GrpcServiceName is the gRPC service name checked by GRPC health checks. See https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -498,7 +953,7 @@ func schema_pkg_apis_backendconfig_v1_IAPConfig(ref common.ReferenceCallback) co
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IAPConfig contains configuration for IAP-enabled backends.",
+				Description: "IAPConfig contains configuration for IAP-enabled backends. Access settings such as the allowed domains and the re-authentication policy are settings of the IAP API, not of the backend service, and are not managed by the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enabled": {
@@ -510,11 +965,12 @@ func schema_pkg_apis_backendconfig_v1_IAPConfig(ref common.ReferenceCallback) co
 					},
 					"oauthclientCredentials": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.OAuthClientCredentials"),
+							Description: "OAuthClientCredentials of a custom OAuth client. If not specified, IAP uses a Google-managed OAuth client.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.OAuthClientCredentials"),
 						},
 					},
 				},
-				Required: []string{"enabled", "oauthclientCredentials"},
+				Required: []string{"enabled"},
 			},
 		},
 		Dependencies: []string{
@@ -612,6 +1068,178 @@ func schema_pkg_apis_backendconfig_v1_OAuthClientCredentials(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_backendconfig_v1_OutlierDetectionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OutlierDetectionConfig contains configuration for the ejection of unhealthy endpoints. A field left unset or set to 0 means that the GCE default is used. See https://cloud.google.com/compute/docs/reference/rest/v1/backendServices.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"baseEjectionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BaseEjectionTime is the base time an endpoint is ejected for.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"),
+						},
+					},
+					"consecutiveErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsecutiveErrors is the number of errors before an endpoint is ejected.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"consecutiveGatewayFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsecutiveGatewayFailure is the number of consecutive gateway failures before an endpoint is ejected.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"enforcingConsecutiveErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The value of the field must be in [0, 100].",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"enforcingConsecutiveGatewayFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The value of the field must be in [0, 100].",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"enforcingSuccessRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The value of the field must be in [0, 100].",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the time between ejection analysis sweeps.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"),
+						},
+					},
+					"maxEjectionPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The value of the field must be in [0, 100].",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successRateMinimumHosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessRateMinimumHosts is the number of endpoints that must have enough request volume to detect success rate outliers.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successRateRequestVolume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessRateRequestVolume is the minimum number of requests in an interval to include an endpoint in success rate outlier detection.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"successRateStdevFactor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessRateStdevFactor determines the ejection threshold for success rate outliers, multiplied by a thousand. For example, a factor of 1.9 is set as 1900.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"},
+	}
+}
+
+func schema_pkg_apis_backendconfig_v1_RetiringSignedUrlKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetiringSignedUrlKey is a signed URL key of a backend service that is deleted after the rotation grace period.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backendService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackendService is the name of the backend service.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyName is the name of the key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionTime is the time after which the key is deleted.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"backendService", "keyName", "deletionTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_backendconfig_v1_RetryPolicyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryPolicyConfig contains configuration for retrying failed requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retryConditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryConditions specifies the conditions under which a request is retried, e.g. 5xx, gateway-error or connect-failure.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"numRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumRetries is the number of retries allowed, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"perTryTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerTryTimeout is the timeout of each try, defaults to the route timeout.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.DurationConfig"},
+	}
+}
+
 func schema_pkg_apis_backendconfig_v1_SecurityPolicyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -653,9 +1281,24 @@ func schema_pkg_apis_backendconfig_v1_SessionAffinityConfig(ref common.Reference
 							Format: "int64",
 						},
 					},
+					"localityLbPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalityLbPolicy is the load balancing algorithm used within a zone, one of ROUND_ROBIN, LEAST_REQUEST, RING_HASH or MAGLEV. Session affinity other than NONE only takes effect with RING_HASH or MAGLEV. Only supported by the gce-internal and gce-regional-external Ingress classes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"consistentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsistentHash specifies the hash key of the RING_HASH and MAGLEV locality load balancing policies. Only supported by the gce-internal and gce-regional-external Ingress classes.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConsistentHashConfig"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/backendconfig/v1.ConsistentHashConfig"},
 	}
 }
 
@@ -692,3 +1335,48 @@ func schema_pkg_apis_backendconfig_v1_SignedUrlKey(ref common.ReferenceCallback)
 		},
 	}
 }
+
+func schema_pkg_apis_backendconfig_v1_ZoneCapacityConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ZoneCapacityConfig contains the capacity settings of the backends in a zone. Settings which are not specified are taken from the CapacityConfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"maxRatePerEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+					"maxConnectionsPerEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"capacityScaler": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+					"maxUtilization": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+				},
+				Required: []string{"zone"},
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//
+// +k8s:openapi-gen=true
+type CacheInvalidation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CacheInvalidationSpec   `json:"spec,omitempty"`
+	Status            CacheInvalidationStatus `json:"status,omitempty"`
+}
+
+// CacheInvalidationSpec is the spec for a CacheInvalidation resource. The
+// cached content is invalidated once, a change of the spec invalidates it
+// again.
+// +k8s:openapi-gen=true
+type CacheInvalidationSpec struct {
+	// Ingress is the name of the Ingress, in the namespace of the
+	// CacheInvalidation, whose cached content is invalidated.
+	Ingress string `json:"ingress"`
+	// Host restricts the invalidation to the given host. If empty, the
+	// content of all hosts is invalidated.
+	// +optional
+	Host string `json:"host,omitempty"`
+	// Paths to invalidate. A path ending with "*" invalidates every path with
+	// the given prefix, e.g. "/static/*".
+	Paths []string `json:"paths"`
+}
+
+// CacheInvalidationStatus is the status for a CacheInvalidation resource
+// +k8s:openapi-gen=true
+type CacheInvalidationStatus struct {
+	// UrlMap is the name of the URL map whose cache is invalidated.
+	// +optional
+	UrlMap string `json:"urlMap,omitempty"`
+	// Operations are the GCE operations invalidating the paths.
+	// +optional
+	Operations []CacheInvalidationOperation `json:"operations,omitempty"`
+	// CompletionTime is the time all invalidations completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Conditions describe the state of the invalidation.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// CacheInvalidationOperation is the GCE operation invalidating a path.
+// +k8s:openapi-gen=true
+type CacheInvalidationOperation struct {
+	// Path is the invalidated path.
+	Path string `json:"path"`
+	// Name is the name of the GCE operation.
+	Name string `json:"name"`
+	// Done is true once the operation completed.
+	Done bool `json:"done,omitempty"`
+}
+
+// CacheInvalidationConditionType is a type of condition of a CacheInvalidation.
+type CacheInvalidationConditionType string
+
+// CacheInvalidationConditionReason is a reason of a condition of a CacheInvalidation.
+type CacheInvalidationConditionReason string
+
+const (
+	// CacheInvalidationConditionComplete is true once the cached content was
+	// invalidated for all paths.
+	CacheInvalidationConditionComplete CacheInvalidationConditionType = "Complete"
+
+	CacheInvalidationReasonInvalidated          CacheInvalidationConditionReason = "Invalidated"
+	CacheInvalidationReasonInProgress           CacheInvalidationConditionReason = "InProgress"
+	CacheInvalidationReasonInvalid              CacheInvalidationConditionReason = "Invalid"
+	CacheInvalidationReasonIngressNotFound      CacheInvalidationConditionReason = "IngressNotFound"
+	CacheInvalidationReasonLoadBalancerNotFound CacheInvalidationConditionReason = "LoadBalancerNotFound"
+	CacheInvalidationReasonInvalidationFailed   CacheInvalidationConditionReason = "InvalidationFailed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CacheInvalidationList is a list of CacheInvalidation resources
+type CacheInvalidationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CacheInvalidation `json:"items"`
+}
@@ -37,6 +37,74 @@ type FrontendConfig struct {
 type FrontendConfigSpec struct {
 	SslPolicy       *string              `json:"sslPolicy,omitempty"`
 	RedirectToHttps *HttpsRedirectConfig `json:"redirectToHttps,omitempty"`
+	// DefaultCustomErrorResponsePolicy replaces the error responses of all
+	// backends of the load balancer with custom error content, unless a
+	// policy of the RouteConfig of the Ingress applies.
+	// It requires a global external Application Load Balancer, which is not
+	// provisioned for Ingresses, so it is rejected for now.
+	DefaultCustomErrorResponsePolicy *CustomErrorResponsePolicy `json:"defaultCustomErrorResponsePolicy,omitempty"`
+	// CertificateMap is the name or the URL of a Certificate Manager
+	// certificate map the HTTPS target proxy serves certificates from. The
+	// TLS secrets and pre-shared certificates of the Ingress are not used
+	// while it is set.
+	// It is only supported by global external load balancers.
+	CertificateMap *string `json:"certificateMap,omitempty"`
+	// ManagedCertificates requests a Google-managed certificate for the
+	// listed domains. It is served together with the TLS secrets and
+	// pre-shared certificates of the Ingress.
+	// It is only supported by global external load balancers.
+	ManagedCertificates *ManagedCertificatesConfig `json:"managedCertificates,omitempty"`
+	// ServerTlsPolicy is the name or the URL of a Network Security
+	// ServerTlsPolicy the HTTPS target proxy authenticates clients with. Its
+	// mTLS policy references the TrustConfig that validates client
+	// certificates. The result of the validation can be forwarded to the
+	// backends with the mTLS variables of the custom request headers of the
+	// BackendConfig, such as {client_cert_present}.
+	// An empty string removes the server TLS policy.
+	// It is only supported by global external load balancers.
+	ServerTlsPolicy *string `json:"serverTlsPolicy,omitempty"`
+	// QuicOverride controls whether the load balancer negotiates HTTP/3
+	// (QUIC) with clients. Options are ENABLE, DISABLE, or NONE to let
+	// Google manage whether QUIC is used. Removing it resets the load
+	// balancer to NONE.
+	// It is only supported by global external load balancers.
+	QuicOverride *string `json:"quicOverride,omitempty"`
+}
+
+// ManagedCertificatesConfig describes a Google-managed certificate.
+// +k8s:openapi-gen=true
+type ManagedCertificatesConfig struct {
+	// Domains the certificate is provisioned for. At most 100 domains are
+	// supported, wildcard domains are not.
+	Domains []string `json:"domains"`
+}
+
+// CustomErrorResponsePolicy replaces error responses of backends with custom
+// error content served from a BackendBucket or a BackendService.
+// +k8s:openapi-gen=true
+type CustomErrorResponsePolicy struct {
+	// ErrorService is the name of the BackendBucket or BackendService that
+	// contains the custom error content.
+	ErrorService string `json:"errorService"`
+	// ErrorServiceKind is the kind of ErrorService, BackendBucket or
+	// BackendService. Defaults to BackendBucket.
+	ErrorServiceKind string `json:"errorServiceKind,omitempty"`
+	// ErrorResponseRules map response codes to custom error content.
+	ErrorResponseRules []CustomErrorResponseRule `json:"errorResponseRules"`
+}
+
+// CustomErrorResponseRule maps response codes to custom error content.
+// +k8s:openapi-gen=true
+type CustomErrorResponseRule struct {
+	// MatchResponseCodes are response codes between 400 and 599, or the
+	// response code classes 4xx and 5xx. A specific code takes precedence
+	// over its class.
+	MatchResponseCodes []string `json:"matchResponseCodes"`
+	// Path of the error content in the error service, such as /errors/404.html.
+	Path string `json:"path"`
+	// OverrideResponseCode is the response code returned with the error
+	// content. If not set, the response code of the backend is returned.
+	OverrideResponseCode int32 `json:"overrideResponseCode,omitempty"`
 }
 
 // HttpsRedirectConfig representing the configuration of Https redirects
@@ -25,6 +25,50 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomErrorResponsePolicy) DeepCopyInto(out *CustomErrorResponsePolicy) {
+	*out = *in
+	if in.ErrorResponseRules != nil {
+		in, out := &in.ErrorResponseRules, &out.ErrorResponseRules
+		*out = make([]CustomErrorResponseRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponsePolicy.
+func (in *CustomErrorResponsePolicy) DeepCopy() *CustomErrorResponsePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomErrorResponsePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomErrorResponseRule) DeepCopyInto(out *CustomErrorResponseRule) {
+	*out = *in
+	if in.MatchResponseCodes != nil {
+		in, out := &in.MatchResponseCodes, &out.MatchResponseCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponseRule.
+func (in *CustomErrorResponseRule) DeepCopy() *CustomErrorResponseRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomErrorResponseRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FrontendConfig) DeepCopyInto(out *FrontendConfig) {
 	*out = *in
@@ -99,6 +143,31 @@ func (in *FrontendConfigSpec) DeepCopyInto(out *FrontendConfigSpec) {
 		*out = new(HttpsRedirectConfig)
 		**out = **in
 	}
+	if in.DefaultCustomErrorResponsePolicy != nil {
+		in, out := &in.DefaultCustomErrorResponsePolicy, &out.DefaultCustomErrorResponsePolicy
+		*out = new(CustomErrorResponsePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateMap != nil {
+		in, out := &in.CertificateMap, &out.CertificateMap
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedCertificates != nil {
+		in, out := &in.ManagedCertificates, &out.ManagedCertificates
+		*out = new(ManagedCertificatesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerTlsPolicy != nil {
+		in, out := &in.ServerTlsPolicy, &out.ServerTlsPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.QuicOverride != nil {
+		in, out := &in.QuicOverride, &out.QuicOverride
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -143,3 +212,24 @@ func (in *HttpsRedirectConfig) DeepCopy() *HttpsRedirectConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedCertificatesConfig) DeepCopyInto(out *ManagedCertificatesConfig) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedCertificatesConfig.
+func (in *ManagedCertificatesConfig) DeepCopy() *ManagedCertificatesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedCertificatesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,9 +30,101 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.FrontendConfig":      schema_pkg_apis_frontendconfig_v1beta1_FrontendConfig(ref),
-		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.FrontendConfigSpec":  schema_pkg_apis_frontendconfig_v1beta1_FrontendConfigSpec(ref),
-		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.HttpsRedirectConfig": schema_pkg_apis_frontendconfig_v1beta1_HttpsRedirectConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.CustomErrorResponsePolicy": schema_pkg_apis_frontendconfig_v1beta1_CustomErrorResponsePolicy(ref),
+		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.CustomErrorResponseRule":   schema_pkg_apis_frontendconfig_v1beta1_CustomErrorResponseRule(ref),
+		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.FrontendConfig":            schema_pkg_apis_frontendconfig_v1beta1_FrontendConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.FrontendConfigSpec":        schema_pkg_apis_frontendconfig_v1beta1_FrontendConfigSpec(ref),
+		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.HttpsRedirectConfig":       schema_pkg_apis_frontendconfig_v1beta1_HttpsRedirectConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.ManagedCertificatesConfig": schema_pkg_apis_frontendconfig_v1beta1_ManagedCertificatesConfig(ref),
+	}
+}
+
+func schema_pkg_apis_frontendconfig_v1beta1_CustomErrorResponsePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CustomErrorResponsePolicy replaces error responses of backends with custom error content served from a BackendBucket or a BackendService.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"errorService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorService is the name of the BackendBucket or BackendService that contains the custom error content.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorServiceKind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorServiceKind is the kind of ErrorService, BackendBucket or BackendService. Defaults to BackendBucket.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorResponseRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorResponseRules map response codes to custom error content.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.CustomErrorResponseRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"errorService", "errorResponseRules"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.CustomErrorResponseRule"},
+	}
+}
+
+func schema_pkg_apis_frontendconfig_v1beta1_CustomErrorResponseRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CustomErrorResponseRule maps response codes to custom error content.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"matchResponseCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchResponseCodes are response codes between 400 and 599, or the response code classes 4xx and 5xx. A specific code takes precedence over its class.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the error content in the error service, such as /errors/404.html.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overrideResponseCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OverrideResponseCode is the response code returned with the error content. If not set, the response code of the backend is returned.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"matchResponseCodes", "path"},
+			},
+		},
 	}
 }
 
@@ -100,11 +192,44 @@ func schema_pkg_apis_frontendconfig_v1beta1_FrontendConfigSpec(ref common.Refere
 							Ref: ref("k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.HttpsRedirectConfig"),
 						},
 					},
+					"defaultCustomErrorResponsePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultCustomErrorResponsePolicy replaces the error responses of all backends of the load balancer with custom error content, unless a policy of the RouteConfig of the Ingress applies. It requires a global external Application Load Balancer, which is not provisioned for Ingresses, so it is rejected for now.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.CustomErrorResponsePolicy"),
+						},
+					},
+					"certificateMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateMap is the name or the URL of a Certificate Manager certificate map the HTTPS target proxy serves certificates from. The TLS secrets and pre-shared certificates of the Ingress are not used while it is set. It is only supported by global external load balancers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"managedCertificates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedCertificates requests a Google-managed certificate for the listed domains. It is served together with the TLS secrets and pre-shared certificates of the Ingress. It is only supported by global external load balancers.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.ManagedCertificatesConfig"),
+						},
+					},
+					"serverTlsPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerTlsPolicy is the name or the URL of a Network Security ServerTlsPolicy the HTTPS target proxy authenticates clients with. Its mTLS policy references the TrustConfig that validates client certificates. The result of the validation can be forwarded to the backends with the mTLS variables of the custom request headers of the BackendConfig, such as {client_cert_present}. An empty string removes the server TLS policy. It is only supported by global external load balancers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"quicOverride": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuicOverride controls whether the load balancer negotiates HTTP/3 (QUIC) with clients. Options are ENABLE, DISABLE, or NONE to let Google manage whether QUIC is used. Removing it resets the load balancer to NONE. It is only supported by global external load balancers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.HttpsRedirectConfig"},
+			"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.CustomErrorResponsePolicy", "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.HttpsRedirectConfig", "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.ManagedCertificatesConfig"},
 	}
 }
 
@@ -135,3 +260,32 @@ func schema_pkg_apis_frontendconfig_v1beta1_HttpsRedirectConfig(ref common.Refer
 		},
 	}
 }
+
+func schema_pkg_apis_frontendconfig_v1beta1_ManagedCertificatesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManagedCertificatesConfig describes a Google-managed certificate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"domains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domains the certificate is provisioned for. At most 100 domains are supported, wildcard domains are not.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"domains"},
+			},
+		},
+	}
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The types in this package are the subset of the Gateway API
+// (gateway.networking.k8s.io/v1beta1) implemented by the GCE controller.
+// Field names and JSON tags follow the upstream API so that objects created
+// from the upstream CRDs can be read without conversion.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//
+// Gateway represents a load balancer that binds Listeners to an IP address.
+// +k8s:openapi-gen=true
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GatewaySpec   `json:"spec,omitempty"`
+	Status            GatewayStatus `json:"status,omitempty"`
+}
+
+// GatewaySpec is the spec for a Gateway resource
+// +k8s:openapi-gen=true
+type GatewaySpec struct {
+	// GatewayClassName is the name of the GatewayClass the Gateway belongs to.
+	GatewayClassName string `json:"gatewayClassName"`
+	// Listeners are the logical endpoints of the Gateway.
+	Listeners []Listener `json:"listeners"`
+	// Addresses requests the addresses of the Gateway.
+	Addresses []GatewayAddress `json:"addresses,omitempty"`
+}
+
+// ProtocolType is the application protocol of a Listener.
+type ProtocolType string
+
+const (
+	// HTTPProtocolType accepts cleartext HTTP traffic.
+	HTTPProtocolType ProtocolType = "HTTP"
+	// HTTPSProtocolType accepts HTTP traffic over TLS.
+	HTTPSProtocolType ProtocolType = "HTTPS"
+)
+
+// Listener is a port and protocol on which the Gateway accepts traffic.
+// +k8s:openapi-gen=true
+type Listener struct {
+	// Name is the name of the Listener, unique within the Gateway.
+	Name string `json:"name"`
+	// Hostname restricts the Routes attached to the Listener to the ones
+	// serving the hostname. It may be prefixed with a wildcard label.
+	Hostname *string `json:"hostname,omitempty"`
+	// Port is the network port.
+	Port int32 `json:"port"`
+	// Protocol is the application protocol of the Listener.
+	Protocol ProtocolType `json:"protocol"`
+	// TLS is the TLS configuration of an HTTPS Listener.
+	TLS *GatewayTLSConfig `json:"tls,omitempty"`
+}
+
+// GatewayTLSConfig configures TLS termination of a Listener.
+// +k8s:openapi-gen=true
+type GatewayTLSConfig struct {
+	// CertificateRefs are the Secrets holding the certificates and private
+	// keys of the Listener.
+	CertificateRefs []SecretObjectReference `json:"certificateRefs,omitempty"`
+	// Options are implementation specific TLS settings.
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// SecretObjectReference references a Secret.
+// +k8s:openapi-gen=true
+type SecretObjectReference struct {
+	// Group of the referent, only the core group is supported.
+	Group *string `json:"group,omitempty"`
+	// Kind of the referent, only Secret is supported.
+	Kind *string `json:"kind,omitempty"`
+	// Name of the referent.
+	Name string `json:"name"`
+	// Namespace of the referent. Defaults to the namespace of the Gateway.
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// AddressType is the type of a GatewayAddress.
+type AddressType string
+
+const (
+	// IPAddressType is an IP address.
+	IPAddressType AddressType = "IPAddress"
+	// NamedAddressType is the name of a reserved static IP address.
+	NamedAddressType AddressType = "NamedAddress"
+)
+
+// GatewayAddress is an address of a Gateway.
+// +k8s:openapi-gen=true
+type GatewayAddress struct {
+	// Type of the address. Defaults to IPAddress.
+	Type *AddressType `json:"type,omitempty"`
+	// Value of the address.
+	Value string `json:"value"`
+}
+
+// GatewayStatus is the status for a Gateway resource
+// +k8s:openapi-gen=true
+type GatewayStatus struct {
+	// Addresses are the addresses assigned to the Gateway.
+	Addresses []GatewayAddress `json:"addresses,omitempty"`
+	// Conditions describe the current conditions of the Gateway.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// GatewayConditionType is the type of a condition of a Gateway.
+type GatewayConditionType string
+
+// GatewayConditionReason is the reason of a condition of a Gateway.
+type GatewayConditionReason string
+
+const (
+	// GatewayConditionAccepted is true if the Gateway is valid.
+	GatewayConditionAccepted GatewayConditionType = "Accepted"
+	// GatewayConditionProgrammed is true if the load balancer of the Gateway
+	// is configured.
+	GatewayConditionProgrammed GatewayConditionType = "Programmed"
+
+	GatewayReasonAccepted           GatewayConditionReason = "Accepted"
+	GatewayReasonListenersNotValid  GatewayConditionReason = "ListenersNotValid"
+	GatewayReasonUnsupportedAddress GatewayConditionReason = "UnsupportedAddress"
+	GatewayReasonProgrammed         GatewayConditionReason = "Programmed"
+	GatewayReasonPending            GatewayConditionReason = "Pending"
+	GatewayReasonInvalid            GatewayConditionReason = "Invalid"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GatewayList is a list of Gateway resources
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Gateway `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//
+// HTTPRoute routes HTTP requests received by the Listeners of Gateways to
+// Services.
+// +k8s:openapi-gen=true
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              HTTPRouteSpec   `json:"spec,omitempty"`
+	Status            HTTPRouteStatus `json:"status,omitempty"`
+}
+
+// HTTPRouteSpec is the spec for a HTTPRoute resource
+// +k8s:openapi-gen=true
+type HTTPRouteSpec struct {
+	// ParentRefs are the Gateways the route attaches to.
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+	// Hostnames are the hostnames the route serves. If empty, the route
+	// serves the hostnames of the Listeners it is attached to.
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Rules are the matches and the backends of the route.
+	Rules []HTTPRouteRule `json:"rules,omitempty"`
+}
+
+// ParentReference references a Gateway, or one of its Listeners.
+// +k8s:openapi-gen=true
+type ParentReference struct {
+	// Group of the referent. Defaults to gateway.networking.k8s.io.
+	Group *string `json:"group,omitempty"`
+	// Kind of the referent. Defaults to Gateway.
+	Kind *string `json:"kind,omitempty"`
+	// Namespace of the referent. Defaults to the namespace of the route.
+	Namespace *string `json:"namespace,omitempty"`
+	// Name of the referent.
+	Name string `json:"name"`
+	// SectionName is the name of the Listener to attach to. If unset, the
+	// route attaches to all Listeners of the Gateway.
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+// HTTPRouteRule sends the requests satisfying any of its matches to its
+// backends.
+// +k8s:openapi-gen=true
+type HTTPRouteRule struct {
+	// Matches are the conditions a request has to satisfy. If empty, the
+	// rule matches all requests.
+	Matches []HTTPRouteMatch `json:"matches,omitempty"`
+	// BackendRefs are the backends the matching requests are sent to.
+	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
+}
+
+// HTTPRouteMatch contains the criteria a request has to satisfy. All
+// criteria specified within one HTTPRouteMatch have to match.
+// +k8s:openapi-gen=true
+type HTTPRouteMatch struct {
+	// Path matches the request path. Defaults to a "/" prefix match.
+	Path *HTTPPathMatch `json:"path,omitempty"`
+	// Headers matches request headers.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	// QueryParams matches query parameters.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+}
+
+// PathMatchType is how a path is matched.
+type PathMatchType string
+
+const (
+	// PathMatchExact matches the exact path.
+	PathMatchExact PathMatchType = "Exact"
+	// PathMatchPathPrefix matches a prefix of the path, split by "/".
+	PathMatchPathPrefix PathMatchType = "PathPrefix"
+	// PathMatchRegularExpression matches the path against a regular expression.
+	PathMatchRegularExpression PathMatchType = "RegularExpression"
+)
+
+// HTTPPathMatch matches the request path.
+// +k8s:openapi-gen=true
+type HTTPPathMatch struct {
+	// Type defaults to PathPrefix.
+	Type *PathMatchType `json:"type,omitempty"`
+	// Value defaults to "/".
+	Value *string `json:"value,omitempty"`
+}
+
+// HeaderMatchType is how a header or a query parameter is matched.
+type HeaderMatchType string
+
+const (
+	// HeaderMatchExact matches the exact value.
+	HeaderMatchExact HeaderMatchType = "Exact"
+	// HeaderMatchRegularExpression matches the value against a regular expression.
+	HeaderMatchRegularExpression HeaderMatchType = "RegularExpression"
+)
+
+// HTTPHeaderMatch matches a request header.
+// +k8s:openapi-gen=true
+type HTTPHeaderMatch struct {
+	// Type defaults to Exact.
+	Type  *HeaderMatchType `json:"type,omitempty"`
+	Name  string           `json:"name"`
+	Value string           `json:"value"`
+}
+
+// HTTPQueryParamMatch matches a query parameter.
+// +k8s:openapi-gen=true
+type HTTPQueryParamMatch struct {
+	// Type defaults to Exact.
+	Type  *HeaderMatchType `json:"type,omitempty"`
+	Name  string           `json:"name"`
+	Value string           `json:"value"`
+}
+
+// HTTPBackendRef references a Service port that receives a share of the
+// requests of a rule.
+// +k8s:openapi-gen=true
+type HTTPBackendRef struct {
+	// Group of the referent, only the core group is supported.
+	Group *string `json:"group,omitempty"`
+	// Kind of the referent, only Service is supported.
+	Kind *string `json:"kind,omitempty"`
+	// Name of the referent.
+	Name string `json:"name"`
+	// Namespace of the referent. Defaults to the namespace of the route.
+	Namespace *string `json:"namespace,omitempty"`
+	// Port is the Service port number.
+	Port *int32 `json:"port,omitempty"`
+	// Weight is the share of the requests sent to the backend, relative to
+	// the other backends of the rule. Defaults to 1.
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// HTTPRouteStatus is the status for a HTTPRoute resource
+// +k8s:openapi-gen=true
+type HTTPRouteStatus struct {
+	// Parents is the status of the route for each Gateway it attaches to.
+	Parents []RouteParentStatus `json:"parents,omitempty"`
+}
+
+// RouteParentStatus is the status of a route for one of its parents.
+// +k8s:openapi-gen=true
+type RouteParentStatus struct {
+	// ParentRef is the Gateway the status applies to.
+	ParentRef ParentReference `json:"parentRef"`
+	// ControllerName is the name of the controller that wrote the status.
+	ControllerName string `json:"controllerName"`
+	// Conditions describe the status of the route for the Gateway.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// RouteConditionType is the type of a condition of a route.
+type RouteConditionType string
+
+// RouteConditionReason is the reason of a condition of a route.
+type RouteConditionReason string
+
+const (
+	// RouteConditionAccepted is true if the route is attached to the Gateway.
+	RouteConditionAccepted RouteConditionType = "Accepted"
+	// RouteConditionResolvedRefs is true if all backendRefs of the route
+	// are resolved.
+	RouteConditionResolvedRefs RouteConditionType = "ResolvedRefs"
+
+	RouteReasonAccepted                   RouteConditionReason = "Accepted"
+	RouteReasonNotAllowedByListeners      RouteConditionReason = "NotAllowedByListeners"
+	RouteReasonNoMatchingListenerHostname RouteConditionReason = "NoMatchingListenerHostname"
+	RouteReasonNoMatchingParent           RouteConditionReason = "NoMatchingParent"
+	RouteReasonUnsupportedValue           RouteConditionReason = "UnsupportedValue"
+	RouteReasonResolvedRefs               RouteConditionReason = "ResolvedRefs"
+	RouteReasonRefNotPermitted            RouteConditionReason = "RefNotPermitted"
+	RouteReasonInvalidKind                RouteConditionReason = "InvalidKind"
+	RouteReasonBackendNotFound            RouteConditionReason = "BackendNotFound"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HTTPRouteList is a list of HTTPRoute resources
+type HTTPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []HTTPRoute `json:"items"`
+}
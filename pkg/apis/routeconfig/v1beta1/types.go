@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+//
+// +k8s:openapi-gen=true
+type RouteConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RouteConfigSpec   `json:"spec,omitempty"`
+	Status            RouteConfigStatus `json:"status,omitempty"`
+}
+
+// RouteConfigSpec is the spec for a RouteConfig resource
+// +k8s:openapi-gen=true
+type RouteConfigSpec struct {
+	// Rules are evaluated in order, the first rule that matches a request
+	// wins. Rules take precedence over the paths of the Ingress.
+	Rules []RouteRule `json:"rules,omitempty"`
+	// HeaderActions adds and removes request and response headers for all
+	// requests to a host or to a path of the Ingress.
+	HeaderActions []HeaderActionRule `json:"headerActions,omitempty"`
+	// CustomErrorResponsePolicies replaces the error responses of the backends
+	// of a host or of a path of the Ingress with custom error content.
+	// They require a global external Application Load Balancer, which is not
+	// provisioned for Ingresses, so they are rejected for now.
+	CustomErrorResponsePolicies []CustomErrorResponsePolicyRule `json:"customErrorResponsePolicies,omitempty"`
+	// UrlRewrites rewrites the URL of requests to paths of the Ingress
+	// before they are sent to the backend.
+	UrlRewrites []UrlRewriteRule `json:"urlRewrites,omitempty"`
+	// DefaultUrlRewrite rewrites the URL of requests sent to the default
+	// backend of the Ingress.
+	DefaultUrlRewrite *UrlRewrite `json:"defaultUrlRewrite,omitempty"`
+}
+
+// HeaderActionRule applies a HeaderAction to a host or to a path of the Ingress.
+// +k8s:openapi-gen=true
+type HeaderActionRule struct {
+	// Host the header action applies to. If empty, it applies to requests
+	// that do not match any host of the Ingress.
+	Host string `json:"host,omitempty"`
+	// Path is a path of the Ingress rule for the host, as written in the
+	// Ingress. If empty, the header action applies to every request to the host.
+	Path string `json:"path,omitempty"`
+	// HeaderAction specifies the headers to add and remove.
+	HeaderAction HeaderAction `json:"headerAction"`
+}
+
+// HeaderAction specifies the request and response headers to add and remove.
+// +k8s:openapi-gen=true
+type HeaderAction struct {
+	RequestHeadersToAdd     []HeaderOption `json:"requestHeadersToAdd,omitempty"`
+	RequestHeadersToRemove  []string       `json:"requestHeadersToRemove,omitempty"`
+	ResponseHeadersToAdd    []HeaderOption `json:"responseHeadersToAdd,omitempty"`
+	ResponseHeadersToRemove []string       `json:"responseHeadersToRemove,omitempty"`
+}
+
+// HeaderOption is a header to add.
+// +k8s:openapi-gen=true
+type HeaderOption struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	// Replace replaces existing values of the header instead of appending
+	// another value.
+	Replace bool `json:"replace,omitempty"`
+}
+
+// CustomErrorResponsePolicyRule applies a CustomErrorResponsePolicy to a host
+// or to a path of the Ingress.
+// +k8s:openapi-gen=true
+type CustomErrorResponsePolicyRule struct {
+	// Host the policy applies to. If empty, it applies to requests that do not
+	// match any host of the Ingress.
+	Host string `json:"host,omitempty"`
+	// Path is a path of the Ingress rule for the host, as written in the
+	// Ingress. If empty, the policy applies to every request to the host.
+	Path string `json:"path,omitempty"`
+	// Policy specifies the error responses to replace.
+	Policy CustomErrorResponsePolicy `json:"policy"`
+}
+
+// Kinds of the ErrorService of a CustomErrorResponsePolicy.
+const (
+	// ErrorServiceKindBackendBucket serves custom error content from a
+	// BackendBucket.
+	ErrorServiceKindBackendBucket = "BackendBucket"
+	// ErrorServiceKindBackendService serves custom error content from a
+	// BackendService.
+	ErrorServiceKindBackendService = "BackendService"
+)
+
+// CustomErrorResponsePolicy replaces error responses of backends with custom
+// error content served from a BackendBucket or a BackendService.
+// +k8s:openapi-gen=true
+type CustomErrorResponsePolicy struct {
+	// ErrorService is the name of the BackendBucket or BackendService that
+	// contains the custom error content. If empty, the error service of the
+	// FrontendConfig of the Ingress is used.
+	ErrorService string `json:"errorService,omitempty"`
+	// ErrorServiceKind is the kind of ErrorService, BackendBucket or
+	// BackendService. Defaults to BackendBucket.
+	ErrorServiceKind string `json:"errorServiceKind,omitempty"`
+	// ErrorResponseRules map response codes to custom error content.
+	ErrorResponseRules []CustomErrorResponseRule `json:"errorResponseRules"`
+}
+
+// CustomErrorResponseRule maps response codes to custom error content.
+// +k8s:openapi-gen=true
+type CustomErrorResponseRule struct {
+	// MatchResponseCodes are response codes between 400 and 599, or the
+	// response code classes 4xx and 5xx. A specific code takes precedence
+	// over its class.
+	MatchResponseCodes []string `json:"matchResponseCodes"`
+	// Path of the error content in the error service, such as /errors/404.html.
+	Path string `json:"path"`
+	// OverrideResponseCode is the response code returned with the error
+	// content. If not set, the response code of the backend is returned.
+	OverrideResponseCode int32 `json:"overrideResponseCode,omitempty"`
+}
+
+// UrlRewriteRule applies a UrlRewrite to a path of the Ingress.
+// +k8s:openapi-gen=true
+type UrlRewriteRule struct {
+	// Host of the Ingress rule. If empty, the rewrite applies to the path
+	// of the Ingress rule without a host.
+	Host string `json:"host,omitempty"`
+	// Path is a path of the Ingress rule for the host, as written in the
+	// Ingress.
+	Path string `json:"path"`
+	// UrlRewrite specifies how the URL is rewritten.
+	UrlRewrite UrlRewrite `json:"urlRewrite"`
+}
+
+// UrlRewrite specifies how the URL of a request is rewritten before it is
+// sent to the backend.
+// +k8s:openapi-gen=true
+type UrlRewrite struct {
+	// PathPrefixRewrite replaces the part of the request path that matched.
+	// For example, with a path of /app1/* and a PathPrefixRewrite of /,
+	// a request for /app1/foo is sent as /foo.
+	PathPrefixRewrite string `json:"pathPrefixRewrite,omitempty"`
+	// HostRewrite replaces the Host header of the request.
+	HostRewrite string `json:"hostRewrite,omitempty"`
+}
+
+// RouteRule routes requests for a host that satisfy any of its matches
+// to a single backend.
+// +k8s:openapi-gen=true
+type RouteRule struct {
+	// Host the rule applies to. If empty, the rule applies to requests that
+	// do not match any host of the Ingress.
+	Host string `json:"host,omitempty"`
+	// Matches is the list of match conditions, a request is routed to the
+	// backend if any of them matches.
+	Matches []RouteMatch `json:"matches"`
+	// Backend is the Service the matching requests are sent to.
+	// Only one of Backend and WeightedBackends can be set.
+	Backend ServiceBackend `json:"backend,omitempty"`
+	// WeightedBackends splits the matching requests between several
+	// Services in proportion to their weights. The retry policy, fault
+	// injection policy and route timeout of their BackendConfigs, and
+	// their shadow Services, must be the same. Weighted backends are only
+	// supported by the gce-internal and gce-regional-external Ingress
+	// classes.
+	WeightedBackends []WeightedServiceBackend `json:"weightedBackends,omitempty"`
+	// HeaderAction specifies the headers to add and remove for requests
+	// matching the rule.
+	HeaderAction *HeaderAction `json:"headerAction,omitempty"`
+	// UrlRewrite specifies how the URL of requests matching the rule is
+	// rewritten. The matched part of the path is the PrefixMatch or
+	// FullPathMatch of the match.
+	UrlRewrite *UrlRewrite `json:"urlRewrite,omitempty"`
+}
+
+// RouteMatch contains the criteria a request has to satisfy. All criteria
+// specified within one RouteMatch have to match.
+// +k8s:openapi-gen=true
+type RouteMatch struct {
+	// PrefixMatch requires the request path to start with the given value.
+	PrefixMatch string `json:"prefixMatch,omitempty"`
+	// FullPathMatch requires the request path to be exactly the given value.
+	// Only one of PrefixMatch and FullPathMatch can be set.
+	FullPathMatch string `json:"fullPathMatch,omitempty"`
+	// IgnoreCase makes PrefixMatch and FullPathMatch case insensitive.
+	IgnoreCase bool `json:"ignoreCase,omitempty"`
+	// Headers is the list of header matches that all have to match.
+	Headers []HeaderMatch `json:"headers,omitempty"`
+	// QueryParameters is the list of query parameter matches that all
+	// have to match.
+	QueryParameters []QueryParameterMatch `json:"queryParameters,omitempty"`
+}
+
+// HeaderMatch matches a request header. Only one of ExactMatch,
+// PrefixMatch, SuffixMatch, RegexMatch and PresentMatch can be set.
+// +k8s:openapi-gen=true
+type HeaderMatch struct {
+	Name         string `json:"name"`
+	ExactMatch   string `json:"exactMatch,omitempty"`
+	PrefixMatch  string `json:"prefixMatch,omitempty"`
+	SuffixMatch  string `json:"suffixMatch,omitempty"`
+	RegexMatch   string `json:"regexMatch,omitempty"`
+	PresentMatch bool   `json:"presentMatch,omitempty"`
+	// InvertMatch negates the result of the match.
+	InvertMatch bool `json:"invertMatch,omitempty"`
+}
+
+// QueryParameterMatch matches a query parameter. Only one of ExactMatch,
+// RegexMatch and PresentMatch can be set.
+// +k8s:openapi-gen=true
+type QueryParameterMatch struct {
+	Name         string `json:"name"`
+	ExactMatch   string `json:"exactMatch,omitempty"`
+	RegexMatch   string `json:"regexMatch,omitempty"`
+	PresentMatch bool   `json:"presentMatch,omitempty"`
+}
+
+// ServiceBackend references a port of a Service in the namespace of
+// the RouteConfig.
+// +k8s:openapi-gen=true
+type ServiceBackend struct {
+	// Name of the Service.
+	Name string `json:"name"`
+	// Port of the Service.
+	Port ServiceBackendPort `json:"port"`
+}
+
+// WeightedServiceBackend is a Service that receives a share of the traffic
+// of a RouteRule.
+// +k8s:openapi-gen=true
+type WeightedServiceBackend struct {
+	ServiceBackend `json:",inline"`
+	// Weight of the Service, between 0 and 1000. The share of traffic a
+	// Service receives is its weight divided by the sum of all weights.
+	Weight int32 `json:"weight"`
+}
+
+// ServiceBackendPort identifies a Service port by name or by number.
+// +k8s:openapi-gen=true
+type ServiceBackendPort struct {
+	Name   string `json:"name,omitempty"`
+	Number int32  `json:"number,omitempty"`
+}
+
+// RouteConfigStatus is the status for a RouteConfig resource
+type RouteConfigStatus struct{}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RouteConfigList is a list of RouteConfig resources
+type RouteConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []RouteConfig `json:"items"`
+}
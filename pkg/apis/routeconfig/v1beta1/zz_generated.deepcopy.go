@@ -0,0 +1,460 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomErrorResponsePolicy) DeepCopyInto(out *CustomErrorResponsePolicy) {
+	*out = *in
+	if in.ErrorResponseRules != nil {
+		in, out := &in.ErrorResponseRules, &out.ErrorResponseRules
+		*out = make([]CustomErrorResponseRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponsePolicy.
+func (in *CustomErrorResponsePolicy) DeepCopy() *CustomErrorResponsePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomErrorResponsePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomErrorResponsePolicyRule) DeepCopyInto(out *CustomErrorResponsePolicyRule) {
+	*out = *in
+	in.Policy.DeepCopyInto(&out.Policy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponsePolicyRule.
+func (in *CustomErrorResponsePolicyRule) DeepCopy() *CustomErrorResponsePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomErrorResponsePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomErrorResponseRule) DeepCopyInto(out *CustomErrorResponseRule) {
+	*out = *in
+	if in.MatchResponseCodes != nil {
+		in, out := &in.MatchResponseCodes, &out.MatchResponseCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomErrorResponseRule.
+func (in *CustomErrorResponseRule) DeepCopy() *CustomErrorResponseRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomErrorResponseRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderAction) DeepCopyInto(out *HeaderAction) {
+	*out = *in
+	if in.RequestHeadersToAdd != nil {
+		in, out := &in.RequestHeadersToAdd, &out.RequestHeadersToAdd
+		*out = make([]HeaderOption, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestHeadersToRemove != nil {
+		in, out := &in.RequestHeadersToRemove, &out.RequestHeadersToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeadersToAdd != nil {
+		in, out := &in.ResponseHeadersToAdd, &out.ResponseHeadersToAdd
+		*out = make([]HeaderOption, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeadersToRemove != nil {
+		in, out := &in.ResponseHeadersToRemove, &out.ResponseHeadersToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderAction.
+func (in *HeaderAction) DeepCopy() *HeaderAction {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderActionRule) DeepCopyInto(out *HeaderActionRule) {
+	*out = *in
+	in.HeaderAction.DeepCopyInto(&out.HeaderAction)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderActionRule.
+func (in *HeaderActionRule) DeepCopy() *HeaderActionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderActionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatch) DeepCopyInto(out *HeaderMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatch.
+func (in *HeaderMatch) DeepCopy() *HeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderOption) DeepCopyInto(out *HeaderOption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderOption.
+func (in *HeaderOption) DeepCopy() *HeaderOption {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderOption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParameterMatch) DeepCopyInto(out *QueryParameterMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameterMatch.
+func (in *QueryParameterMatch) DeepCopy() *QueryParameterMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParameterMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteConfig) DeepCopyInto(out *RouteConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfig.
+func (in *RouteConfig) DeepCopy() *RouteConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteConfigList) DeepCopyInto(out *RouteConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouteConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfigList.
+func (in *RouteConfigList) DeepCopy() *RouteConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteConfigSpec) DeepCopyInto(out *RouteConfigSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RouteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HeaderActions != nil {
+		in, out := &in.HeaderActions, &out.HeaderActions
+		*out = make([]HeaderActionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomErrorResponsePolicies != nil {
+		in, out := &in.CustomErrorResponsePolicies, &out.CustomErrorResponsePolicies
+		*out = make([]CustomErrorResponsePolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UrlRewrites != nil {
+		in, out := &in.UrlRewrites, &out.UrlRewrites
+		*out = make([]UrlRewriteRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultUrlRewrite != nil {
+		in, out := &in.DefaultUrlRewrite, &out.DefaultUrlRewrite
+		*out = new(UrlRewrite)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfigSpec.
+func (in *RouteConfigSpec) DeepCopy() *RouteConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteConfigStatus) DeepCopyInto(out *RouteConfigStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteConfigStatus.
+func (in *RouteConfigStatus) DeepCopy() *RouteConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteMatch) DeepCopyInto(out *RouteMatch) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HeaderMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParameters != nil {
+		in, out := &in.QueryParameters, &out.QueryParameters
+		*out = make([]QueryParameterMatch, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteMatch.
+func (in *RouteMatch) DeepCopy() *RouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteRule) DeepCopyInto(out *RouteRule) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]RouteMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Backend = in.Backend
+	if in.WeightedBackends != nil {
+		in, out := &in.WeightedBackends, &out.WeightedBackends
+		*out = make([]WeightedServiceBackend, len(*in))
+		copy(*out, *in)
+	}
+	if in.HeaderAction != nil {
+		in, out := &in.HeaderAction, &out.HeaderAction
+		*out = new(HeaderAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UrlRewrite != nil {
+		in, out := &in.UrlRewrite, &out.UrlRewrite
+		*out = new(UrlRewrite)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteRule.
+func (in *RouteRule) DeepCopy() *RouteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBackend) DeepCopyInto(out *ServiceBackend) {
+	*out = *in
+	out.Port = in.Port
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBackend.
+func (in *ServiceBackend) DeepCopy() *ServiceBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBackendPort) DeepCopyInto(out *ServiceBackendPort) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBackendPort.
+func (in *ServiceBackendPort) DeepCopy() *ServiceBackendPort {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBackendPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UrlRewrite) DeepCopyInto(out *UrlRewrite) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UrlRewrite.
+func (in *UrlRewrite) DeepCopy() *UrlRewrite {
+	if in == nil {
+		return nil
+	}
+	out := new(UrlRewrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UrlRewriteRule) DeepCopyInto(out *UrlRewriteRule) {
+	*out = *in
+	out.UrlRewrite = in.UrlRewrite
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UrlRewriteRule.
+func (in *UrlRewriteRule) DeepCopy() *UrlRewriteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(UrlRewriteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedServiceBackend) DeepCopyInto(out *WeightedServiceBackend) {
+	*out = *in
+	out.ServiceBackend = in.ServiceBackend
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedServiceBackend.
+func (in *WeightedServiceBackend) DeepCopy() *WeightedServiceBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedServiceBackend)
+	in.DeepCopyInto(out)
+	return out
+}
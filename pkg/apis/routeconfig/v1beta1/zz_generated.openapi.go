@@ -0,0 +1,830 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by openapi-gen. DO NOT EDIT.
+
+// This file was autogenerated by openapi-gen. Do not edit it manually!
+
+package v1beta1
+
+import (
+	common "k8s.io/kube-openapi/pkg/common"
+	spec "k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
+	return map[string]common.OpenAPIDefinition{
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponsePolicy":     schema_pkg_apis_routeconfig_v1beta1_CustomErrorResponsePolicy(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponsePolicyRule": schema_pkg_apis_routeconfig_v1beta1_CustomErrorResponsePolicyRule(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponseRule":       schema_pkg_apis_routeconfig_v1beta1_CustomErrorResponseRule(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderAction":                  schema_pkg_apis_routeconfig_v1beta1_HeaderAction(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderActionRule":              schema_pkg_apis_routeconfig_v1beta1_HeaderActionRule(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderMatch":                   schema_pkg_apis_routeconfig_v1beta1_HeaderMatch(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderOption":                  schema_pkg_apis_routeconfig_v1beta1_HeaderOption(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.QueryParameterMatch":           schema_pkg_apis_routeconfig_v1beta1_QueryParameterMatch(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteConfig":                   schema_pkg_apis_routeconfig_v1beta1_RouteConfig(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteConfigSpec":               schema_pkg_apis_routeconfig_v1beta1_RouteConfigSpec(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteMatch":                    schema_pkg_apis_routeconfig_v1beta1_RouteMatch(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteRule":                     schema_pkg_apis_routeconfig_v1beta1_RouteRule(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.ServiceBackend":                schema_pkg_apis_routeconfig_v1beta1_ServiceBackend(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.ServiceBackendPort":            schema_pkg_apis_routeconfig_v1beta1_ServiceBackendPort(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewrite":                    schema_pkg_apis_routeconfig_v1beta1_UrlRewrite(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewriteRule":                schema_pkg_apis_routeconfig_v1beta1_UrlRewriteRule(ref),
+		"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.WeightedServiceBackend":        schema_pkg_apis_routeconfig_v1beta1_WeightedServiceBackend(ref),
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_CustomErrorResponsePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CustomErrorResponsePolicy replaces error responses of backends with custom error content served from a BackendBucket or a BackendService.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"errorService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorService is the name of the BackendBucket or BackendService that contains the custom error content. If empty, the error service of the FrontendConfig of the Ingress is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorServiceKind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorServiceKind is the kind of ErrorService, BackendBucket or BackendService. Defaults to BackendBucket.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorResponseRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorResponseRules map response codes to custom error content.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponseRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"errorResponseRules"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponseRule"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_CustomErrorResponsePolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CustomErrorResponsePolicyRule applies a CustomErrorResponsePolicy to a host or to a path of the Ingress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host the policy applies to. If empty, it applies to requests that do not match any host of the Ingress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is a path of the Ingress rule for the host, as written in the Ingress. If empty, the policy applies to every request to the host.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy specifies the error responses to replace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponsePolicy"),
+						},
+					},
+				},
+				Required: []string{"policy"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponsePolicy"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_CustomErrorResponseRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CustomErrorResponseRule maps response codes to custom error content.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"matchResponseCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchResponseCodes are response codes between 400 and 599, or the response code classes 4xx and 5xx. A specific code takes precedence over its class.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the error content in the error service, such as /errors/404.html.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overrideResponseCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OverrideResponseCode is the response code returned with the error content. If not set, the response code of the backend is returned.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"matchResponseCodes", "path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_HeaderAction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HeaderAction specifies the request and response headers to add and remove.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requestHeadersToAdd": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderOption"),
+									},
+								},
+							},
+						},
+					},
+					"requestHeadersToRemove": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"responseHeadersToAdd": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderOption"),
+									},
+								},
+							},
+						},
+					},
+					"responseHeadersToRemove": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderOption"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_HeaderActionRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HeaderActionRule applies a HeaderAction to a host or to a path of the Ingress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host the header action applies to. If empty, it applies to requests that do not match any host of the Ingress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is a path of the Ingress rule for the host, as written in the Ingress. If empty, the header action applies to every request to the host.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headerAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HeaderAction specifies the headers to add and remove.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderAction"),
+						},
+					},
+				},
+				Required: []string{"headerAction"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderAction"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_HeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HeaderMatch matches a request header. Only one of ExactMatch, PrefixMatch, SuffixMatch, RegexMatch and PresentMatch can be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"exactMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"prefixMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"suffixMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"regexMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"presentMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"invertMatch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InvertMatch negates the result of the match.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_HeaderOption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HeaderOption is a header to add.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"replace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replace replaces existing values of the header instead of appending another value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_QueryParameterMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QueryParameterMatch matches a query parameter. Only one of ExactMatch, RegexMatch and PresentMatch can be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"exactMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"regexMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"presentMatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_RouteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteConfigSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteConfigStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteConfigSpec", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteConfigStatus"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_RouteConfigSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteConfigSpec is the spec for a RouteConfig resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules are evaluated in order, the first rule that matches a request wins. Rules take precedence over the paths of the Ingress.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteRule"),
+									},
+								},
+							},
+						},
+					},
+					"headerActions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HeaderActions adds and removes request and response headers for all requests to a host or to a path of the Ingress.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderActionRule"),
+									},
+								},
+							},
+						},
+					},
+					"customErrorResponsePolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomErrorResponsePolicies replaces the error responses of the backends of a host or of a path of the Ingress with custom error content. They require a global external Application Load Balancer, which is not provisioned for Ingresses, so they are rejected for now.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponsePolicyRule"),
+									},
+								},
+							},
+						},
+					},
+					"urlRewrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UrlRewrites rewrites the URL of requests to paths of the Ingress before they are sent to the backend.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewriteRule"),
+									},
+								},
+							},
+						},
+					},
+					"defaultUrlRewrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultUrlRewrite rewrites the URL of requests sent to the default backend of the Ingress.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewrite"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponsePolicyRule", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderActionRule", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteRule", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewrite", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewriteRule"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_RouteMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteMatch contains the criteria a request has to satisfy. All criteria specified within one RouteMatch have to match.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefixMatch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrefixMatch requires the request path to start with the given value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fullPathMatch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FullPathMatch requires the request path to be exactly the given value. Only one of PrefixMatch and FullPathMatch can be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ignoreCase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IgnoreCase makes PrefixMatch and FullPathMatch case insensitive.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers is the list of header matches that all have to match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParameters is the list of query parameter matches that all have to match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.QueryParameterMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderMatch", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.QueryParameterMatch"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_RouteRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RouteRule routes requests for a host that satisfy any of its matches to a single backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host the rule applies to. If empty, the rule applies to requests that do not match any host of the Ingress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Matches is the list of match conditions, a request is routed to the backend if any of them matches.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteMatch"),
+									},
+								},
+							},
+						},
+					},
+					"backend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backend is the Service the matching requests are sent to. Only one of Backend and WeightedBackends can be set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.ServiceBackend"),
+						},
+					},
+					"weightedBackends": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WeightedBackends splits the matching requests between several Services in proportion to their weights. The retry policy, fault injection policy and route timeout of their BackendConfigs, and their shadow Services, must be the same. Weighted backends are only supported by the gce-internal and gce-regional-external Ingress classes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.WeightedServiceBackend"),
+									},
+								},
+							},
+						},
+					},
+					"headerAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HeaderAction specifies the headers to add and remove for requests matching the rule.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderAction"),
+						},
+					},
+					"urlRewrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UrlRewrite specifies how the URL of requests matching the rule is rewritten. The matched part of the path is the PrefixMatch or FullPathMatch of the match.",
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewrite"),
+						},
+					},
+				},
+				Required: []string{"matches"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.HeaderAction", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteMatch", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.ServiceBackend", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewrite", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.WeightedServiceBackend"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_ServiceBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBackend references a port of a Service in the namespace of the RouteConfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Service.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port of the Service.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.ServiceBackendPort"),
+						},
+					},
+				},
+				Required: []string{"name", "port"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.ServiceBackendPort"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_ServiceBackendPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBackendPort identifies a Service port by name or by number.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"number": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_UrlRewrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UrlRewrite specifies how the URL of a request is rewritten before it is sent to the backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pathPrefixRewrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathPrefixRewrite replaces the part of the request path that matched. For example, with a path of /app1/* and a PathPrefixRewrite of /, a request for /app1/foo is sent as /foo.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hostRewrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostRewrite replaces the Host header of the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_UrlRewriteRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UrlRewriteRule applies a UrlRewrite to a path of the Ingress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host of the Ingress rule. If empty, the rewrite applies to the path of the Ingress rule without a host.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is a path of the Ingress rule for the host, as written in the Ingress.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"urlRewrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UrlRewrite specifies how the URL is rewritten.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewrite"),
+						},
+					},
+				},
+				Required: []string{"path", "urlRewrite"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewrite"},
+	}
+}
+
+func schema_pkg_apis_routeconfig_v1beta1_WeightedServiceBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WeightedServiceBackend is a Service that receives a share of the traffic of a RouteRule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Service.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port of the Service.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.ServiceBackendPort"),
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight of the Service, between 0 and 1000. The share of traffic a Service receives is its weight divided by the sum of all weights.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "port", "weight"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.ServiceBackendPort"},
+	}
+}
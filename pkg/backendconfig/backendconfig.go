@@ -49,7 +49,7 @@ func CRDMeta() *crd.CRDMeta {
 		},
 		"bc",
 	)
-	return meta
+	return meta.WithStatusSubresource()
 }
 
 // GetBackendConfigForServicePort returns the corresponding BackendConfig for
@@ -40,6 +40,7 @@ type BackendConfigsGetter interface {
 type BackendConfigInterface interface {
 	Create(ctx context.Context, backendConfig *v1.BackendConfig, opts metav1.CreateOptions) (*v1.BackendConfig, error)
 	Update(ctx context.Context, backendConfig *v1.BackendConfig, opts metav1.UpdateOptions) (*v1.BackendConfig, error)
+	UpdateStatus(ctx context.Context, backendConfig *v1.BackendConfig, opts metav1.UpdateOptions) (*v1.BackendConfig, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.BackendConfig, error)
@@ -135,6 +136,22 @@ func (c *backendConfigs) Update(ctx context.Context, backendConfig *v1.BackendCo
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *backendConfigs) UpdateStatus(ctx context.Context, backendConfig *v1.BackendConfig, opts metav1.UpdateOptions) (result *v1.BackendConfig, err error) {
+	result = &v1.BackendConfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("backendconfigs").
+		Name(backendConfig.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(backendConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the backendConfig and deletes it. Returns an error if one occurs.
 func (c *backendConfigs) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
 	return c.client.Delete().
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendconfig
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/utils/patch"
+	"k8s.io/klog/v2"
+)
+
+// UpdateStatus applies update to the status of the BackendConfig and patches
+// the status subresource if it changed. The BackendConfig is usually from the
+// cache. The patch only contains the changed fields of the status, so the
+// spec of the BackendConfig of a service port, which is modified during its
+// validation, is never written. The patch is conditional on the resource
+// version of the BackendConfig; on a conflict, update is applied again to the
+// latest BackendConfig read from the API server.
+func UpdateStatus(client backendconfigclient.Interface, beConfig *backendconfigv1.BackendConfig, update func(status *backendconfigv1.BackendConfigStatus)) error {
+	current := beConfig
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if current == nil {
+			latest, err := client.CloudV1().BackendConfigs(beConfig.Namespace).Get(context.TODO(), beConfig.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			current = latest
+		}
+		status := current.Status.DeepCopy()
+		update(status)
+		if equality.Semantic.DeepEqual(status, &current.Status) {
+			return nil
+		}
+		// The resource version is only set in the updated BackendConfig, so
+		// that it is part of the patch.
+		updated := &backendconfigv1.BackendConfig{Status: *status}
+		updated.ResourceVersion = current.ResourceVersion
+		patchBytes, err := patch.MergePatchBytes(&backendconfigv1.BackendConfig{Status: current.Status}, updated)
+		if err != nil {
+			return err
+		}
+		klog.V(2).Infof("Patching status of BackendConfig %s/%s", beConfig.Namespace, beConfig.Name)
+		_, err = client.CloudV1().BackendConfigs(beConfig.Namespace).Patch(context.TODO(), beConfig.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}, "status")
+		current = nil
+		return err
+	})
+}
+
+// SetCondition sets the condition in the status of the BackendConfig if it
+// changed.
+func SetCondition(client backendconfigclient.Interface, beConfig *backendconfigv1.BackendConfig, condition metav1.Condition) error {
+	condition.ObservedGeneration = beConfig.Generation
+	return UpdateStatus(client, beConfig, func(status *backendconfigv1.BackendConfigStatus) {
+		apimeta.SetStatusCondition(&status.Conditions, condition)
+	})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendconfig
+
+import (
+	"context"
+	"testing"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned/fake"
+)
+
+func TestSetCondition(t *testing.T) {
+	attached := metav1.Condition{
+		Type:    string(backendconfigv1.BackendConfigConditionSecurityPolicyAttached),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(backendconfigv1.BackendConfigReasonSecurityPolicyAttached),
+		Message: "Security policies are attached",
+	}
+	notFound := metav1.Condition{
+		Type:    string(backendconfigv1.BackendConfigConditionSecurityPolicyAttached),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(backendconfigv1.BackendConfigReasonSecurityPolicyNotFound),
+		Message: `Security policy "policy-1" does not exist`,
+	}
+
+	for _, tc := range []struct {
+		desc       string
+		conditions []metav1.Condition
+		condition  metav1.Condition
+		wantUpdate bool
+	}{
+		{
+			desc:       "no conditions",
+			condition:  attached,
+			wantUpdate: true,
+		},
+		{
+			desc:       "condition changed",
+			conditions: []metav1.Condition{attached},
+			condition:  notFound,
+			wantUpdate: true,
+		},
+		{
+			desc:       "condition not changed",
+			conditions: []metav1.Condition{withObservedGeneration(attached, 2)},
+			condition:  attached,
+		},
+		{
+			desc:       "generation changed",
+			conditions: []metav1.Condition{withObservedGeneration(attached, 1)},
+			condition:  attached,
+			wantUpdate: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			beConfig := &backendconfigv1.BackendConfig{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "config", Generation: 2},
+				Status:     backendconfigv1.BackendConfigStatus{Conditions: tc.conditions},
+			}
+			// The BackendConfig of a service port may contain secrets
+			// which are filled in during its validation.
+			cached := beConfig.DeepCopy()
+			cached.Spec.Iap = &backendconfigv1.IAPConfig{
+				OAuthClientCredentials: &backendconfigv1.OAuthClientCredentials{ClientSecret: "secret"},
+			}
+			client := fake.NewSimpleClientset(beConfig)
+
+			if err := SetCondition(client, cached, tc.condition); err != nil {
+				t.Fatalf("SetCondition() = %v, want nil", err)
+			}
+
+			var updated bool
+			for _, action := range client.Actions() {
+				switch action.GetVerb() {
+				case "patch":
+					if action.GetSubresource() != "status" {
+						t.Errorf("SetCondition() patched subresource %q, want status", action.GetSubresource())
+					}
+					updated = true
+				case "get", "update":
+					t.Errorf("SetCondition() made a %s call, want only a status patch", action.GetVerb())
+				}
+			}
+			if updated != tc.wantUpdate {
+				t.Errorf("SetCondition() updated = %t, want %t", updated, tc.wantUpdate)
+			}
+
+			got, err := client.CloudV1().BackendConfigs("default").Get(context.TODO(), "config", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Get() = %v, want nil", err)
+			}
+			if got.Spec.Iap != nil {
+				t.Errorf("SetCondition() updated the spec of the BackendConfig: %+v", got.Spec)
+			}
+			cond := apimeta.FindStatusCondition(got.Status.Conditions, tc.condition.Type)
+			if cond == nil || cond.Status != tc.condition.Status || cond.Reason != tc.condition.Reason || cond.Message != tc.condition.Message || cond.ObservedGeneration != 2 {
+				t.Errorf("Got condition %+v, want %+v", cond, tc.condition)
+			}
+		})
+	}
+}
+
+func withObservedGeneration(condition metav1.Condition, generation int64) metav1.Condition {
+	condition.ObservedGeneration = generation
+	return condition
+}
@@ -19,6 +19,9 @@ package backendconfig
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
 
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -32,10 +35,68 @@ const (
 	SignedUrlKeySecretKey = "key_value"
 )
 
+const (
+	maxHealthCheckHostLength  = 255
+	maxHealthCheckResponseLen = 1024
+	maxDurationNanos          = 999999999
+	minFaultAbortHttpStatus   = 200
+	maxFaultAbortHttpStatus   = 599
+)
+
+var supportedRetryConditions = map[string]bool{
+	"5xx":                true,
+	"gateway-error":      true,
+	"connect-failure":    true,
+	"retriable-4xx":      true,
+	"refused-stream":     true,
+	"cancelled":          true,
+	"deadline-exceeded":  true,
+	"internal":           true,
+	"resource-exhausted": true,
+	"unavailable":        true,
+}
+
+// supportedClientCertVariables are the mTLS variables which forward the
+// client certificate validated by the server TLS policy of the target https
+// proxy to the backends in custom request headers.
+var supportedClientCertVariables = map[string]bool{
+	"client_cert_present":            true,
+	"client_cert_chain_verified":     true,
+	"client_cert_error":              true,
+	"client_cert_sha256_fingerprint": true,
+	"client_cert_serial_number":      true,
+	"client_cert_spiffe_id":          true,
+	"client_cert_uri_sans":           true,
+	"client_cert_dnsname_sans":       true,
+	"client_cert_valid_not_before":   true,
+	"client_cert_valid_not_after":    true,
+	"client_cert_issuer_dn":          true,
+	"client_cert_subject_dn":         true,
+	"client_cert_leaf":               true,
+	"client_cert_chain":              true,
+}
+
+// clientCertVariable matches the mTLS variables in custom request headers.
+var clientCertVariable = regexp.MustCompile(`\{(client_cert_[^}]*)\}`)
+
 var supportedAffinities = map[string]bool{
 	"NONE":             true,
 	"CLIENT_IP":        true,
 	"GENERATED_COOKIE": true,
+	"HEADER_FIELD":     true,
+	"HTTP_COOKIE":      true,
+}
+
+var supportedLocalityLbPolicies = map[string]bool{
+	"ROUND_ROBIN":   true,
+	"LEAST_REQUEST": true,
+	"RING_HASH":     true,
+	"MAGLEV":        true,
+}
+
+var supportedHealthCheckProxyHeaders = map[string]bool{
+	"NONE":     true,
+	"PROXY_V1": true,
 }
 
 func Validate(kubeClient kubernetes.Interface, beConfig *backendconfigv1.BackendConfig, servicePort *utils.ServicePort) error {
@@ -51,7 +112,15 @@ func Validate(kubeClient kubernetes.Interface, beConfig *backendconfigv1.Backend
 		return err
 	}
 
-	if err := validateSessionAffinity(kubeClient, beConfig); err != nil {
+	if err := validateEdgeSecurityPolicy(beConfig, servicePort); err != nil {
+		return err
+	}
+
+	if err := validateSessionAffinity(kubeClient, beConfig, servicePort); err != nil {
+		return err
+	}
+
+	if err := validateHealthCheck(beConfig); err != nil {
 		return err
 	}
 
@@ -59,6 +128,36 @@ func Validate(kubeClient kubernetes.Interface, beConfig *backendconfigv1.Backend
 		return err
 	}
 
+	if err := validateCustomRequestHeaders(beConfig); err != nil {
+		return err
+	}
+
+	if err := validateRetryPolicy(beConfig); err != nil {
+		return err
+	}
+
+	if err := validateFaultInjectionPolicy(beConfig); err != nil {
+		return err
+	}
+
+	if beConfig.Spec.RouteTimeout != nil {
+		if err := validateDuration(*beConfig.Spec.RouteTimeout); err != nil {
+			return fmt.Errorf("unsupported RouteTimeout: %v", err)
+		}
+	}
+
+	if err := validateCircuitBreakers(beConfig, servicePort); err != nil {
+		return err
+	}
+
+	if err := validateOutlierDetection(beConfig, servicePort); err != nil {
+		return err
+	}
+
+	if err := validateCapacity(beConfig, servicePort); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -98,14 +197,29 @@ func validateIAP(kubeClient kubernetes.Interface, beConfig *backendconfigv1.Back
 	return nil
 }
 
-func validateSessionAffinity(kubeClient kubernetes.Interface, beConfig *backendconfigv1.BackendConfig) error {
+// validateEdgeSecurityPolicy validates that an edge security policy is only
+// attached to CDN-enabled global backends. Detaching it is always allowed.
+func validateEdgeSecurityPolicy(beConfig *backendconfigv1.BackendConfig, servicePort *utils.ServicePort) error {
+	if beConfig.Spec.EdgeSecurityPolicy == nil || beConfig.Spec.EdgeSecurityPolicy.Name == "" {
+		return nil
+	}
+	if beConfig.Spec.Cdn == nil || !beConfig.Spec.Cdn.Enabled {
+		return fmt.Errorf("edge security policy %q requires cdn to be enabled", beConfig.Spec.EdgeSecurityPolicy.Name)
+	}
+	if servicePort != nil && (servicePort.L7ILBEnabled || servicePort.L7XLBRegionalEnabled) {
+		return fmt.Errorf("edge security policy is not supported for regional backends")
+	}
+	return nil
+}
+
+func validateSessionAffinity(kubeClient kubernetes.Interface, beConfig *backendconfigv1.BackendConfig, servicePort *utils.ServicePort) error {
 	if beConfig.Spec.SessionAffinity == nil {
 		return nil
 	}
 
 	if beConfig.Spec.SessionAffinity.AffinityType != "" {
 		if _, ok := supportedAffinities[beConfig.Spec.SessionAffinity.AffinityType]; !ok {
-			return fmt.Errorf("unsupported AffinityType: %s, should be one of NONE, CLIENT_IP, or GENERATED_COOKIE",
+			return fmt.Errorf("unsupported AffinityType: %s, should be one of NONE, CLIENT_IP, GENERATED_COOKIE, HEADER_FIELD, or HTTP_COOKIE",
 				beConfig.Spec.SessionAffinity.AffinityType)
 		}
 	}
@@ -117,6 +231,101 @@ func validateSessionAffinity(kubeClient kubernetes.Interface, beConfig *backendc
 		}
 	}
 
+	return validateConsistentHash(beConfig.Spec.SessionAffinity, servicePort)
+}
+
+// validateConsistentHash validates the locality load balancing policy and the
+// consistent hash settings, which must match the affinity type.
+func validateConsistentHash(affinity *backendconfigv1.SessionAffinityConfig, servicePort *utils.ServicePort) error {
+	if isClassicExternal(servicePort) && (affinity.LocalityLbPolicy != "" || affinity.ConsistentHash != nil) {
+		return fmt.Errorf("locality lb policy and consistent hash are not supported by the classic external load balancer")
+	}
+	if affinity.LocalityLbPolicy != "" && !supportedLocalityLbPolicies[affinity.LocalityLbPolicy] {
+		return fmt.Errorf("unsupported LocalityLbPolicy: %s, should be one of ROUND_ROBIN, LEAST_REQUEST, RING_HASH, or MAGLEV", affinity.LocalityLbPolicy)
+	}
+
+	consistentHash := affinity.ConsistentHash
+	if consistentHash == nil {
+		switch affinity.AffinityType {
+		case "HEADER_FIELD", "HTTP_COOKIE":
+			return fmt.Errorf("AffinityType %s requires ConsistentHash", affinity.AffinityType)
+		}
+		return nil
+	}
+
+	if affinity.LocalityLbPolicy != "RING_HASH" && affinity.LocalityLbPolicy != "MAGLEV" {
+		return fmt.Errorf("ConsistentHash requires LocalityLbPolicy RING_HASH or MAGLEV, got %q", affinity.LocalityLbPolicy)
+	}
+	if consistentHash.MinimumRingSize < 0 {
+		return fmt.Errorf("unsupported MinimumRingSize: %d, should not be negative", consistentHash.MinimumRingSize)
+	}
+	if (consistentHash.HttpHeaderName != "") != (affinity.AffinityType == "HEADER_FIELD") {
+		return fmt.Errorf("ConsistentHash HttpHeaderName should be set if and only if AffinityType is HEADER_FIELD")
+	}
+	if (consistentHash.HttpCookie != nil) != (affinity.AffinityType == "HTTP_COOKIE") {
+		return fmt.Errorf("ConsistentHash HttpCookie should be set if and only if AffinityType is HTTP_COOKIE")
+	}
+	if cookie := consistentHash.HttpCookie; cookie != nil {
+		if cookie.Name == "" {
+			return fmt.Errorf("ConsistentHash HttpCookie should have a name")
+		}
+		if cookie.Ttl != nil {
+			if err := validateDuration(*cookie.Ttl); err != nil {
+				return fmt.Errorf("unsupported HttpCookie Ttl: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateHealthCheck(beConfig *backendconfigv1.BackendConfig) error {
+	hc := beConfig.Spec.HealthCheck
+	if hc == nil {
+		return nil
+	}
+
+	if hc.Type != nil && *hc.Type == "GRPC" {
+		// GRPC health checks have no HTTP settings.
+		for _, f := range []struct {
+			name string
+			v    *string
+		}{
+			{"Host", hc.Host},
+			{"Response", hc.Response},
+			{"ProxyHeader", hc.ProxyHeader},
+			{"RequestPath", hc.RequestPath},
+		} {
+			if f.v != nil {
+				return fmt.Errorf("unsupported health check %s for type GRPC", f.name)
+			}
+		}
+	} else if hc.GrpcServiceName != nil {
+		return fmt.Errorf("unsupported health check GrpcServiceName: %q, type should be GRPC", *hc.GrpcServiceName)
+	}
+
+	if hc.Host != nil {
+		if strings.ContainsAny(*hc.Host, "/ ") {
+			return fmt.Errorf("unsupported health check Host: %q, should be a host name", *hc.Host)
+		}
+		if len(*hc.Host) > maxHealthCheckHostLength {
+			return fmt.Errorf("unsupported health check Host: %q, should be at most %d characters", *hc.Host, maxHealthCheckHostLength)
+		}
+	}
+	if hc.Response != nil && len(*hc.Response) > maxHealthCheckResponseLen {
+		return fmt.Errorf("unsupported health check Response, should be at most %d characters", maxHealthCheckResponseLen)
+	}
+	if hc.ProxyHeader != nil && !supportedHealthCheckProxyHeaders[*hc.ProxyHeader] {
+		return fmt.Errorf("unsupported health check ProxyHeader: %q, should be NONE or PROXY_V1", *hc.ProxyHeader)
+	}
+	if hc.GrpcServiceName != nil {
+		for _, r := range *hc.GrpcServiceName {
+			if r > unicode.MaxASCII {
+				return fmt.Errorf("unsupported health check GrpcServiceName: %q, should be ASCII", *hc.GrpcServiceName)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -133,6 +342,231 @@ func validateLogging(beConfig *backendconfigv1.BackendConfig) error {
 	return nil
 }
 
+func validateCustomRequestHeaders(beConfig *backendconfigv1.BackendConfig) error {
+	if beConfig.Spec.CustomRequestHeaders == nil {
+		return nil
+	}
+
+	for _, header := range beConfig.Spec.CustomRequestHeaders.Headers {
+		name, value, found := strings.Cut(header, ":")
+		if !found || strings.TrimSpace(name) == "" {
+			return fmt.Errorf("unsupported custom request header: %q, should be of the form name:value", header)
+		}
+		for _, match := range clientCertVariable.FindAllStringSubmatch(value, -1) {
+			if !supportedClientCertVariables[match[1]] {
+				return fmt.Errorf("unsupported mTLS variable {%s} in custom request header %q", match[1], header)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateRetryPolicy(beConfig *backendconfigv1.BackendConfig) error {
+	retryPolicy := beConfig.Spec.RetryPolicy
+	if retryPolicy == nil {
+		return nil
+	}
+
+	for _, condition := range retryPolicy.RetryConditions {
+		if !supportedRetryConditions[condition] {
+			return fmt.Errorf("unsupported RetryCondition: %q", condition)
+		}
+	}
+	if retryPolicy.NumRetries != nil && *retryPolicy.NumRetries < 1 {
+		return fmt.Errorf("unsupported NumRetries: %d, should be at least 1", *retryPolicy.NumRetries)
+	}
+	if retryPolicy.PerTryTimeout != nil {
+		if err := validateDuration(*retryPolicy.PerTryTimeout); err != nil {
+			return fmt.Errorf("unsupported PerTryTimeout: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func validateFaultInjectionPolicy(beConfig *backendconfigv1.BackendConfig) error {
+	faultInjection := beConfig.Spec.FaultInjectionPolicy
+	if faultInjection == nil {
+		return nil
+	}
+
+	if delay := faultInjection.Delay; delay != nil {
+		if err := validateDuration(delay.FixedDelay); err != nil {
+			return fmt.Errorf("unsupported FixedDelay: %v", err)
+		}
+		if delay.Percentage < 0.0 || delay.Percentage > 100.0 {
+			return fmt.Errorf("unsupported delay Percentage: %f, should be between 0.0 and 100.0", delay.Percentage)
+		}
+	}
+
+	if abort := faultInjection.Abort; abort != nil {
+		if abort.HttpStatus < minFaultAbortHttpStatus || abort.HttpStatus > maxFaultAbortHttpStatus {
+			return fmt.Errorf("unsupported HttpStatus: %d, should be between %d and %d", abort.HttpStatus, minFaultAbortHttpStatus, maxFaultAbortHttpStatus)
+		}
+		if abort.Percentage < 0.0 || abort.Percentage > 100.0 {
+			return fmt.Errorf("unsupported abort Percentage: %f, should be between 0.0 and 100.0", abort.Percentage)
+		}
+	}
+
+	return nil
+}
+
+// isClassicExternal returns true if the backend belongs to the classic
+// external HTTP(S) load balancer. Its EXTERNAL load balancing scheme does not
+// support the advanced traffic management settings of a backend service.
+func isClassicExternal(servicePort *utils.ServicePort) bool {
+	return servicePort != nil && !servicePort.L7ILBEnabled && !servicePort.L7XLBRegionalEnabled
+}
+
+func validateCircuitBreakers(beConfig *backendconfigv1.BackendConfig, servicePort *utils.ServicePort) error {
+	circuitBreakers := beConfig.Spec.CircuitBreakers
+	if circuitBreakers == nil {
+		return nil
+	}
+	if isClassicExternal(servicePort) {
+		return fmt.Errorf("circuit breakers are not supported by the classic external load balancer")
+	}
+
+	for name, limit := range map[string]int64{
+		"MaxConnections":           circuitBreakers.MaxConnections,
+		"MaxPendingRequests":       circuitBreakers.MaxPendingRequests,
+		"MaxRequests":              circuitBreakers.MaxRequests,
+		"MaxRequestsPerConnection": circuitBreakers.MaxRequestsPerConnection,
+		"MaxRetries":               circuitBreakers.MaxRetries,
+	} {
+		if limit < 0 {
+			return fmt.Errorf("unsupported %s: %d, should not be negative", name, limit)
+		}
+	}
+
+	return nil
+}
+
+func validateOutlierDetection(beConfig *backendconfigv1.BackendConfig, servicePort *utils.ServicePort) error {
+	outlierDetection := beConfig.Spec.OutlierDetection
+	if outlierDetection == nil {
+		return nil
+	}
+	if isClassicExternal(servicePort) {
+		return fmt.Errorf("outlier detection is not supported by the classic external load balancer")
+	}
+
+	if outlierDetection.BaseEjectionTime != nil {
+		if err := validateDuration(*outlierDetection.BaseEjectionTime); err != nil {
+			return fmt.Errorf("unsupported BaseEjectionTime: %v", err)
+		}
+	}
+	if outlierDetection.Interval != nil {
+		if err := validateDuration(*outlierDetection.Interval); err != nil {
+			return fmt.Errorf("unsupported Interval: %v", err)
+		}
+	}
+	for name, count := range map[string]int64{
+		"ConsecutiveErrors":         outlierDetection.ConsecutiveErrors,
+		"ConsecutiveGatewayFailure": outlierDetection.ConsecutiveGatewayFailure,
+		"SuccessRateMinimumHosts":   outlierDetection.SuccessRateMinimumHosts,
+		"SuccessRateRequestVolume":  outlierDetection.SuccessRateRequestVolume,
+		"SuccessRateStdevFactor":    outlierDetection.SuccessRateStdevFactor,
+	} {
+		if count < 0 {
+			return fmt.Errorf("unsupported %s: %d, should not be negative", name, count)
+		}
+	}
+	for name, percent := range map[string]int64{
+		"EnforcingConsecutiveErrors":         outlierDetection.EnforcingConsecutiveErrors,
+		"EnforcingConsecutiveGatewayFailure": outlierDetection.EnforcingConsecutiveGatewayFailure,
+		"EnforcingSuccessRate":               outlierDetection.EnforcingSuccessRate,
+		"MaxEjectionPercent":                 outlierDetection.MaxEjectionPercent,
+	} {
+		if percent < 0 || percent > 100 {
+			return fmt.Errorf("unsupported %s: %d, should be between 0 and 100", name, percent)
+		}
+	}
+
+	return nil
+}
+
+func validateCapacity(beConfig *backendconfigv1.BackendConfig, servicePort *utils.ServicePort) error {
+	capacity := beConfig.Spec.Capacity
+	if capacity == nil {
+		return nil
+	}
+
+	balancingMode := capacity.BalancingMode
+	if balancingMode == "" {
+		balancingMode = "RATE"
+	}
+	switch balancingMode {
+	case "RATE":
+	case "UTILIZATION":
+		if servicePort != nil && servicePort.NEGEnabled {
+			return fmt.Errorf("unsupported BalancingMode: UTILIZATION, not supported by NEGs")
+		}
+	case "CONNECTION":
+		return fmt.Errorf("unsupported BalancingMode: CONNECTION, not supported by HTTP(S) load balancers")
+	default:
+		return fmt.Errorf("unsupported BalancingMode: %s, should be one of RATE or UTILIZATION", capacity.BalancingMode)
+	}
+
+	if err := validateCapacitySettings(balancingMode, capacity.MaxRatePerEndpoint, capacity.MaxConnectionsPerEndpoint, capacity.CapacityScaler, capacity.MaxUtilization); err != nil {
+		return err
+	}
+	zones := map[string]bool{}
+	for _, zone := range capacity.Zones {
+		if zone.Zone == "" {
+			return fmt.Errorf("capacity Zones should have a zone")
+		}
+		if zones[zone.Zone] {
+			return fmt.Errorf("duplicate capacity settings for zone %q", zone.Zone)
+		}
+		zones[zone.Zone] = true
+		if err := validateCapacitySettings(balancingMode, zone.MaxRatePerEndpoint, zone.MaxConnectionsPerEndpoint, zone.CapacityScaler, zone.MaxUtilization); err != nil {
+			return fmt.Errorf("zone %q: %v", zone.Zone, err)
+		}
+	}
+
+	return nil
+}
+
+// validateCapacitySettings validates the capacity settings of the backends of
+// a backend service, or of the backends in a zone, for the balancing mode.
+func validateCapacitySettings(balancingMode string, maxRatePerEndpoint *float64, maxConnectionsPerEndpoint *int64, capacityScaler, maxUtilization *float64) error {
+	if maxRatePerEndpoint != nil && *maxRatePerEndpoint < 0.0 {
+		return fmt.Errorf("unsupported MaxRatePerEndpoint: %f, should not be negative", *maxRatePerEndpoint)
+	}
+	if maxConnectionsPerEndpoint != nil {
+		if balancingMode != "UTILIZATION" {
+			return fmt.Errorf("MaxConnectionsPerEndpoint is only supported with BalancingMode UTILIZATION")
+		}
+		if *maxConnectionsPerEndpoint < 0 {
+			return fmt.Errorf("unsupported MaxConnectionsPerEndpoint: %d, should not be negative", *maxConnectionsPerEndpoint)
+		}
+	}
+	if capacityScaler != nil && (*capacityScaler < 0.0 || *capacityScaler > 1.0) {
+		return fmt.Errorf("unsupported CapacityScaler: %f, should be between 0.0 and 1.0", *capacityScaler)
+	}
+	if maxUtilization != nil {
+		if balancingMode != "UTILIZATION" {
+			return fmt.Errorf("MaxUtilization is only supported with BalancingMode UTILIZATION")
+		}
+		if *maxUtilization < 0.0 || *maxUtilization > 1.0 {
+			return fmt.Errorf("unsupported MaxUtilization: %f, should be between 0.0 and 1.0", *maxUtilization)
+		}
+	}
+	return nil
+}
+
+func validateDuration(d backendconfigv1.DurationConfig) error {
+	if d.Seconds < 0 {
+		return fmt.Errorf("seconds %d should not be negative", d.Seconds)
+	}
+	if d.Nanos < 0 || d.Nanos > maxDurationNanos {
+		return fmt.Errorf("nanos %d should be between 0 and %d", d.Nanos, maxDurationNanos)
+	}
+	return nil
+}
+
 func validateCDN(kubeClient kubernetes.Interface, beConfig *backendconfigv1.BackendConfig, servicePort *utils.ServicePort) error {
 	if beConfig.Spec.Cdn == nil || beConfig.Spec.Cdn.Enabled == false {
 		return nil
@@ -140,6 +574,9 @@ func validateCDN(kubeClient kubernetes.Interface, beConfig *backendconfigv1.Back
 	if servicePort != nil && servicePort.L7XLBRegionalEnabled {
 		return fmt.Errorf("CDN configuration is not supported in TPC Environment")
 	}
+	if gracePeriod := beConfig.Spec.Cdn.SignedUrlKeyRotationGracePeriodSec; gracePeriod != nil && *gracePeriod < 0 {
+		return fmt.Errorf("signedUrlKeyRotationGracePeriodSec %d should not be negative", *gracePeriod)
+	}
 	for _, key := range beConfig.Spec.Cdn.SignedUrlKeys {
 		if key.SecretName != "" {
 			secret, err := kubeClient.CoreV1().Secrets(beConfig.Namespace).Get(context.TODO(), key.SecretName, meta_v1.GetOptions{})
@@ -18,6 +18,7 @@ package backendconfig
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -33,6 +34,20 @@ var (
 	goodTTL int64 = 86400
 	badTTL  int64 = 86400 + 1
 
+	gracePeriod         int64 = 3600
+	negativeGracePeriod int64 = -1
+
+	grpcType           = "GRPC"
+	hcHost             = "foo.com"
+	badHCHost          = "foo.com/bar"
+	hcResponse         = "OK"
+	longHCResponse     = strings.Repeat("a", 1025)
+	hcProxyHeader      = "PROXY_V1"
+	badHCProxyHeader   = "PROXY_V2"
+	hcRequestPath      = "/healthz"
+	grpcServiceName    = "foo.Service"
+	badGrpcServiceName = "føø.Service"
+
 	defaultBeConfig = &backendconfigv1.BackendConfig{
 		ObjectMeta: meta_v1.ObjectMeta{
 			Namespace: "default",
@@ -184,6 +199,21 @@ func TestValidateIAP(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			desc: "IAP with Google-managed OAuth client",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					Iap: &backendconfigv1.IAPConfig{
+						Enabled: true,
+					},
+				},
+			},
+			init:        func(kubeClient kubernetes.Interface) {},
+			expectError: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -209,6 +239,7 @@ func TestValidateSessionAffinity(t *testing.T) {
 	testCases := []struct {
 		desc        string
 		beConfig    *backendconfigv1.BackendConfig
+		servicePort *utils.ServicePort
 		expectError bool
 	}{
 
@@ -224,6 +255,7 @@ func TestValidateSessionAffinity(t *testing.T) {
 					},
 				},
 			},
+			servicePort: &utils.ServicePort{},
 			expectError: true,
 		},
 		{
@@ -238,6 +270,7 @@ func TestValidateSessionAffinity(t *testing.T) {
 					},
 				},
 			},
+			servicePort: &utils.ServicePort{},
 			expectError: false,
 		},
 		{
@@ -252,6 +285,7 @@ func TestValidateSessionAffinity(t *testing.T) {
 					},
 				},
 			},
+			servicePort: &utils.ServicePort{},
 			expectError: true,
 		},
 		{
@@ -266,13 +300,213 @@ func TestValidateSessionAffinity(t *testing.T) {
 					},
 				},
 			},
+			servicePort: &utils.ServicePort{},
+			expectError: false,
+		},
+		{
+			desc: "supported locality lb policy",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						LocalityLbPolicy: "LEAST_REQUEST",
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: false,
+		},
+		{
+			desc: "unsupported locality lb policy",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						LocalityLbPolicy: "RANDOM",
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "header field consistent hash",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						AffinityType:     "HEADER_FIELD",
+						LocalityLbPolicy: "RING_HASH",
+						ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+							HttpHeaderName:  "x-session-id",
+							MinimumRingSize: 2048,
+						},
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: false,
+		},
+		{
+			desc: "http cookie consistent hash",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						AffinityType:     "HTTP_COOKIE",
+						LocalityLbPolicy: "MAGLEV",
+						ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+							HttpCookie: &backendconfigv1.ConsistentHashHttpCookieConfig{
+								Name: "session",
+								Path: "/",
+								Ttl:  &backendconfigv1.DurationConfig{Seconds: 3600},
+							},
+						},
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: false,
+		},
+		{
+			desc: "consistent hash without hash based locality lb policy",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						AffinityType:     "HEADER_FIELD",
+						LocalityLbPolicy: "ROUND_ROBIN",
+						ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+							HttpHeaderName: "x-session-id",
+						},
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "header field affinity without consistent hash",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						AffinityType:     "HEADER_FIELD",
+						LocalityLbPolicy: "RING_HASH",
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "http cookie affinity with header name",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						AffinityType:     "HTTP_COOKIE",
+						LocalityLbPolicy: "RING_HASH",
+						ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+							HttpHeaderName: "x-session-id",
+						},
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "http cookie without name",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						AffinityType:     "HTTP_COOKIE",
+						LocalityLbPolicy: "RING_HASH",
+						ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+							HttpCookie: &backendconfigv1.ConsistentHashHttpCookieConfig{},
+						},
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "locality lb policy on a classic external backend",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						LocalityLbPolicy: "LEAST_REQUEST",
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "consistent hash on a classic external backend",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						AffinityType:     "HEADER_FIELD",
+						LocalityLbPolicy: "RING_HASH",
+						ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+							HttpHeaderName: "x-session-id",
+						},
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "consistent hash on a regional external backend",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+						AffinityType:     "HEADER_FIELD",
+						LocalityLbPolicy: "RING_HASH",
+						ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+							HttpHeaderName: "x-session-id",
+						},
+					},
+				},
+			},
+			servicePort: &utils.ServicePort{L7XLBRegionalEnabled: true},
 			expectError: false,
 		},
 	}
 
 	for _, testCase := range testCases {
 		kubeClient := fake.NewSimpleClientset()
-		err := Validate(kubeClient, testCase.beConfig, &utils.ServicePort{})
+		err := Validate(kubeClient, testCase.beConfig, testCase.servicePort)
 		if testCase.expectError && err == nil {
 			t.Errorf("%v: Expected error but got nil", testCase.desc)
 		}
@@ -369,6 +603,168 @@ func TestValidateLogging(t *testing.T) {
 	}
 }
 
+func TestValidateHealthCheck(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		healthCheck *backendconfigv1.HealthCheckConfig
+		expectError bool
+	}{
+		{
+			desc:        "nil health check config",
+			healthCheck: nil,
+		},
+		{
+			desc:        "valid host, response and proxy header",
+			healthCheck: &backendconfigv1.HealthCheckConfig{Host: &hcHost, Response: &hcResponse, ProxyHeader: &hcProxyHeader},
+		},
+		{
+			desc:        "host with path",
+			healthCheck: &backendconfigv1.HealthCheckConfig{Host: &badHCHost},
+			expectError: true,
+		},
+		{
+			desc:        "response too long",
+			healthCheck: &backendconfigv1.HealthCheckConfig{Response: &longHCResponse},
+			expectError: true,
+		},
+		{
+			desc:        "unsupported proxy header",
+			healthCheck: &backendconfigv1.HealthCheckConfig{ProxyHeader: &badHCProxyHeader},
+			expectError: true,
+		},
+		{
+			desc:        "valid grpc service name",
+			healthCheck: &backendconfigv1.HealthCheckConfig{Type: &grpcType, GrpcServiceName: &grpcServiceName},
+		},
+		{
+			desc:        "grpc service name without grpc type",
+			healthCheck: &backendconfigv1.HealthCheckConfig{GrpcServiceName: &grpcServiceName},
+			expectError: true,
+		},
+		{
+			desc:        "non-ascii grpc service name",
+			healthCheck: &backendconfigv1.HealthCheckConfig{Type: &grpcType, GrpcServiceName: &badGrpcServiceName},
+			expectError: true,
+		},
+		{
+			desc:        "grpc with host",
+			healthCheck: &backendconfigv1.HealthCheckConfig{Type: &grpcType, Host: &hcHost},
+			expectError: true,
+		},
+		{
+			desc:        "grpc with request path",
+			healthCheck: &backendconfigv1.HealthCheckConfig{Type: &grpcType, RequestPath: &hcRequestPath},
+			expectError: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			beConfig := &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					HealthCheck: tc.healthCheck,
+				},
+			}
+			kubeClient := fake.NewSimpleClientset()
+			err := Validate(kubeClient, beConfig, &utils.ServicePort{})
+			if tc.expectError && err == nil {
+				t.Errorf("Expected error but got nil")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateRouteAction(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		spec        backendconfigv1.BackendConfigSpec
+		expectError bool
+	}{
+		{
+			desc: "valid retry policy, fault injection and route timeout",
+			spec: backendconfigv1.BackendConfigSpec{
+				RetryPolicy: &backendconfigv1.RetryPolicyConfig{
+					RetryConditions: []string{"5xx", "connect-failure"},
+					NumRetries:      utils.NewInt64Pointer(3),
+					PerTryTimeout:   &backendconfigv1.DurationConfig{Seconds: 2},
+				},
+				FaultInjectionPolicy: &backendconfigv1.FaultInjectionPolicyConfig{
+					Delay: &backendconfigv1.FaultDelayConfig{FixedDelay: backendconfigv1.DurationConfig{Nanos: 500000000}, Percentage: 50},
+					Abort: &backendconfigv1.FaultAbortConfig{HttpStatus: 503, Percentage: 10},
+				},
+				RouteTimeout: &backendconfigv1.DurationConfig{Seconds: 30},
+			},
+		},
+		{
+			desc: "unsupported retry condition",
+			spec: backendconfigv1.BackendConfigSpec{
+				RetryPolicy: &backendconfigv1.RetryPolicyConfig{RetryConditions: []string{"always"}},
+			},
+			expectError: true,
+		},
+		{
+			desc: "zero retries",
+			spec: backendconfigv1.BackendConfigSpec{
+				RetryPolicy: &backendconfigv1.RetryPolicyConfig{NumRetries: utils.NewInt64Pointer(0)},
+			},
+			expectError: true,
+		},
+		{
+			desc: "negative per try timeout",
+			spec: backendconfigv1.BackendConfigSpec{
+				RetryPolicy: &backendconfigv1.RetryPolicyConfig{PerTryTimeout: &backendconfigv1.DurationConfig{Seconds: -1}},
+			},
+			expectError: true,
+		},
+		{
+			desc: "delay percentage out of range",
+			spec: backendconfigv1.BackendConfigSpec{
+				FaultInjectionPolicy: &backendconfigv1.FaultInjectionPolicyConfig{
+					Delay: &backendconfigv1.FaultDelayConfig{FixedDelay: backendconfigv1.DurationConfig{Seconds: 1}, Percentage: 101},
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "abort status out of range",
+			spec: backendconfigv1.BackendConfigSpec{
+				FaultInjectionPolicy: &backendconfigv1.FaultInjectionPolicyConfig{
+					Abort: &backendconfigv1.FaultAbortConfig{HttpStatus: 700, Percentage: 10},
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "route timeout nanos out of range",
+			spec: backendconfigv1.BackendConfigSpec{
+				RouteTimeout: &backendconfigv1.DurationConfig{Nanos: 1000000000},
+			},
+			expectError: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			beConfig := &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: tc.spec,
+			}
+			kubeClient := fake.NewSimpleClientset()
+			err := Validate(kubeClient, beConfig, &utils.ServicePort{})
+			if tc.expectError && err == nil {
+				t.Errorf("Expected error but got nil")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
 func TestValidateCDN(t *testing.T) {
 	testCases := []struct {
 		desc        string
@@ -443,6 +839,38 @@ func TestValidateCDN(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			desc: "negative signed URL key rotation grace period",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					Cdn: &backendconfigv1.CDNConfig{
+						Enabled:                            true,
+						SignedUrlKeyRotationGracePeriodSec: &negativeGracePeriod,
+					},
+				},
+			},
+			init:        func(kubeClient kubernetes.Interface) {},
+			expectError: true,
+		},
+		{
+			desc: "signed URL key rotation grace period",
+			beConfig: &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					Cdn: &backendconfigv1.CDNConfig{
+						Enabled:                            true,
+						SignedUrlKeyRotationGracePeriodSec: &gracePeriod,
+					},
+				},
+			},
+			init:        func(kubeClient kubernetes.Interface) {},
+			expectError: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -463,3 +891,349 @@ func TestValidateCDN(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEdgeSecurityPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		spec        backendconfigv1.BackendConfigSpec
+		servicePort *utils.ServicePort
+		expectError bool
+	}{
+		{
+			desc: "edge security policy with cdn",
+			spec: backendconfigv1.BackendConfigSpec{
+				Cdn:                &backendconfigv1.CDNConfig{Enabled: true},
+				EdgeSecurityPolicy: &backendconfigv1.SecurityPolicyConfig{Name: "edge-policy"},
+			},
+			servicePort: &utils.ServicePort{},
+		},
+		{
+			desc: "edge security policy without cdn",
+			spec: backendconfigv1.BackendConfigSpec{
+				EdgeSecurityPolicy: &backendconfigv1.SecurityPolicyConfig{Name: "edge-policy"},
+			},
+			servicePort: &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "detach edge security policy without cdn",
+			spec: backendconfigv1.BackendConfigSpec{
+				EdgeSecurityPolicy: &backendconfigv1.SecurityPolicyConfig{Name: ""},
+			},
+			servicePort: &utils.ServicePort{},
+		},
+		{
+			desc: "edge security policy for regional backend",
+			spec: backendconfigv1.BackendConfigSpec{
+				Cdn:                &backendconfigv1.CDNConfig{Enabled: true},
+				EdgeSecurityPolicy: &backendconfigv1.SecurityPolicyConfig{Name: "edge-policy"},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			beConfig := &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: tc.spec,
+			}
+			err := validateEdgeSecurityPolicy(beConfig, tc.servicePort)
+			if tc.expectError && err == nil {
+				t.Errorf("Expected error but got nil")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateCustomRequestHeaders(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		headers     []string
+		expectError bool
+	}{
+		{
+			desc:    "custom request headers",
+			headers: []string{"X-Client-Region:{client_region}", "X-Static:value"},
+		},
+		{
+			desc: "mTLS variables",
+			headers: []string{
+				"X-Client-Cert-Present:{client_cert_present}",
+				"X-Client-Cert-Chain-Verified:{client_cert_chain_verified}",
+				"X-Client-Cert-Hash:{client_cert_sha256_fingerprint}",
+				"X-Client-Cert-Names:{client_cert_dnsname_sans},{client_cert_uri_sans}",
+			},
+		},
+		{
+			desc:        "unsupported mTLS variable",
+			headers:     []string{"X-Client-Cert:{client_cert_fingerprint}"},
+			expectError: true,
+		},
+		{
+			desc:        "header without value",
+			headers:     []string{"X-Client-Cert-Present"},
+			expectError: true,
+		},
+		{
+			desc:        "header without name",
+			headers:     []string{":{client_cert_present}"},
+			expectError: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			beConfig := &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: backendconfigv1.BackendConfigSpec{
+					CustomRequestHeaders: &backendconfigv1.CustomRequestHeadersConfig{Headers: tc.headers},
+				},
+			}
+			kubeClient := fake.NewSimpleClientset()
+			err := Validate(kubeClient, beConfig, &utils.ServicePort{})
+			if tc.expectError && err == nil {
+				t.Errorf("Expected error but got nil")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateCircuitBreakersAndOutlierDetection(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		spec        backendconfigv1.BackendConfigSpec
+		servicePort *utils.ServicePort
+		expectError bool
+	}{
+		{
+			desc: "valid circuit breakers and outlier detection",
+			spec: backendconfigv1.BackendConfigSpec{
+				CircuitBreakers: &backendconfigv1.CircuitBreakersConfig{
+					MaxConnections: 1000,
+					MaxRequests:    500,
+					MaxRetries:     3,
+				},
+				OutlierDetection: &backendconfigv1.OutlierDetectionConfig{
+					BaseEjectionTime:     &backendconfigv1.DurationConfig{Seconds: 30},
+					ConsecutiveErrors:    5,
+					EnforcingSuccessRate: 100,
+					Interval:             &backendconfigv1.DurationConfig{Seconds: 1},
+					MaxEjectionPercent:   50,
+				},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+		},
+		{
+			desc: "circuit breakers on a regional external backend",
+			spec: backendconfigv1.BackendConfigSpec{
+				CircuitBreakers: &backendconfigv1.CircuitBreakersConfig{MaxConnections: 1000},
+			},
+			servicePort: &utils.ServicePort{L7XLBRegionalEnabled: true},
+		},
+		{
+			desc: "circuit breakers on a classic external backend",
+			spec: backendconfigv1.BackendConfigSpec{
+				CircuitBreakers: &backendconfigv1.CircuitBreakersConfig{MaxConnections: 1000},
+			},
+			servicePort: &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "outlier detection on a classic external backend",
+			spec: backendconfigv1.BackendConfigSpec{
+				OutlierDetection: &backendconfigv1.OutlierDetectionConfig{ConsecutiveErrors: 5},
+			},
+			servicePort: &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "negative max connections",
+			spec: backendconfigv1.BackendConfigSpec{
+				CircuitBreakers: &backendconfigv1.CircuitBreakersConfig{MaxConnections: -1},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "negative consecutive errors",
+			spec: backendconfigv1.BackendConfigSpec{
+				OutlierDetection: &backendconfigv1.OutlierDetectionConfig{ConsecutiveErrors: -1},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "max ejection percent out of range",
+			spec: backendconfigv1.BackendConfigSpec{
+				OutlierDetection: &backendconfigv1.OutlierDetectionConfig{MaxEjectionPercent: 101},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "interval nanos out of range",
+			spec: backendconfigv1.BackendConfigSpec{
+				OutlierDetection: &backendconfigv1.OutlierDetectionConfig{Interval: &backendconfigv1.DurationConfig{Nanos: -1}},
+			},
+			servicePort: &utils.ServicePort{L7ILBEnabled: true},
+			expectError: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			beConfig := &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: tc.spec,
+			}
+			kubeClient := fake.NewSimpleClientset()
+			err := Validate(kubeClient, beConfig, tc.servicePort)
+			if tc.expectError && err == nil {
+				t.Errorf("Expected error but got nil")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateCapacity(t *testing.T) {
+	f64 := func(x float64) *float64 { return &x }
+
+	for _, tc := range []struct {
+		desc        string
+		spec        backendconfigv1.BackendConfigSpec
+		sp          *utils.ServicePort
+		expectError bool
+	}{
+		{
+			desc: "rate balancing mode with zone drained",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{
+					MaxRatePerEndpoint: f64(100),
+					CapacityScaler:     f64(1.0),
+					Zones: []backendconfigv1.ZoneCapacityConfig{
+						{Zone: "us-central1-a", CapacityScaler: f64(0)},
+					},
+				},
+			},
+			sp: &utils.ServicePort{NEGEnabled: true},
+		},
+		{
+			desc: "connection balancing mode",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{
+					BalancingMode:             "CONNECTION",
+					MaxConnectionsPerEndpoint: utils.NewInt64Pointer(50),
+				},
+			},
+			sp:          &utils.ServicePort{NEGEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "utilization balancing mode with instance groups",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{
+					BalancingMode:             "UTILIZATION",
+					MaxUtilization:            f64(0.8),
+					MaxConnectionsPerEndpoint: utils.NewInt64Pointer(50),
+				},
+			},
+			sp: &utils.ServicePort{},
+		},
+		{
+			desc: "utilization balancing mode with NEGs",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{BalancingMode: "UTILIZATION"},
+			},
+			sp:          &utils.ServicePort{NEGEnabled: true},
+			expectError: true,
+		},
+		{
+			desc: "unsupported balancing mode",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{BalancingMode: "CUSTOM_METRICS"},
+			},
+			sp:          &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "connection balancing mode without max connections",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{BalancingMode: "CONNECTION"},
+			},
+			sp:          &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "max connections with rate balancing mode",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{MaxConnectionsPerEndpoint: utils.NewInt64Pointer(50)},
+			},
+			sp:          &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "max utilization with rate balancing mode",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{MaxUtilization: f64(0.8)},
+			},
+			sp:          &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "capacity scaler out of range",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{CapacityScaler: f64(1.5)},
+			},
+			sp:          &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "zone capacity scaler out of range",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{
+					Zones: []backendconfigv1.ZoneCapacityConfig{{Zone: "us-central1-a", CapacityScaler: f64(-1)}},
+				},
+			},
+			sp:          &utils.ServicePort{},
+			expectError: true,
+		},
+		{
+			desc: "duplicate zone",
+			spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{
+					Zones: []backendconfigv1.ZoneCapacityConfig{{Zone: "us-central1-a"}, {Zone: "us-central1-a"}},
+				},
+			},
+			sp:          &utils.ServicePort{},
+			expectError: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			beConfig := &backendconfigv1.BackendConfig{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: tc.spec,
+			}
+			kubeClient := fake.NewSimpleClientset()
+			err := Validate(kubeClient, beConfig, tc.sp)
+			if tc.expectError && err == nil {
+				t.Errorf("Expected error but got nil")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Did not expect error but got: %v", err)
+			}
+		})
+	}
+}
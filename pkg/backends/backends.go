@@ -95,7 +95,7 @@ func (b *Backends) Create(sp utils.ServicePort, hcLink string) (*composite.Backe
 	be := &composite.BackendService{
 		Version:      version,
 		Name:         name,
-		Protocol:     string(sp.Protocol),
+		Protocol:     sp.Protocol.BackendServiceProtocol(),
 		Port:         namedPort.Port,
 		PortName:     namedPort.Name,
 		HealthChecks: []string{hcLink},
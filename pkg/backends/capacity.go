@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+// capacitySettings are the capacity settings specified in the BackendConfig
+// for the backends of a backend service in a zone.
+type capacitySettings struct {
+	balancingMode             BalancingMode
+	maxRatePerEndpoint        *float64
+	maxConnectionsPerEndpoint *int64
+	capacityScaler            *float64
+	maxUtilization            *float64
+}
+
+// capacityForGroup returns the capacity settings of the BackendConfig of the
+// service port for the backend with the given group (NEG or instance group)
+// URL, or nil if the BackendConfig has no capacity settings. Settings for the
+// zone of the group override the settings for all zones.
+func capacityForGroup(sp *utils.ServicePort, group string) *capacitySettings {
+	if sp.BackendConfig == nil || sp.BackendConfig.Spec.Capacity == nil {
+		return nil
+	}
+	capacity := sp.BackendConfig.Spec.Capacity
+	settings := &capacitySettings{
+		balancingMode:             BalancingMode(capacity.BalancingMode),
+		maxRatePerEndpoint:        capacity.MaxRatePerEndpoint,
+		maxConnectionsPerEndpoint: capacity.MaxConnectionsPerEndpoint,
+		capacityScaler:            capacity.CapacityScaler,
+		maxUtilization:            capacity.MaxUtilization,
+	}
+	if settings.balancingMode == "" {
+		settings.balancingMode = Rate
+	}
+
+	id, err := cloud.ParseResourceURL(group)
+	if err != nil {
+		return settings
+	}
+	for _, zone := range capacity.Zones {
+		if zone.Zone != id.Key.Zone {
+			continue
+		}
+		if zone.MaxRatePerEndpoint != nil {
+			settings.maxRatePerEndpoint = zone.MaxRatePerEndpoint
+		}
+		if zone.MaxConnectionsPerEndpoint != nil {
+			settings.maxConnectionsPerEndpoint = zone.MaxConnectionsPerEndpoint
+		}
+		if zone.CapacityScaler != nil {
+			settings.capacityScaler = zone.CapacityScaler
+		}
+		if zone.MaxUtilization != nil {
+			settings.maxUtilization = zone.MaxUtilization
+		}
+	}
+	return settings
+}
+
+// forBalancingMode returns a copy of the capacity settings for the given
+// balancing mode. Limits which are only supported by the UTILIZATION
+// balancing mode are dropped for other modes.
+func (c *capacitySettings) forBalancingMode(bm BalancingMode) *capacitySettings {
+	settings := *c
+	settings.balancingMode = bm
+	if bm != Utilization {
+		settings.maxConnectionsPerEndpoint = nil
+		settings.maxUtilization = nil
+	}
+	return &settings
+}
+
+// applyToNEGBackend applies the capacity settings to a NEG backend.
+func (c *capacitySettings) applyToNEGBackend(b *composite.Backend) {
+	b.BalancingMode = string(c.balancingMode)
+	if c.maxRatePerEndpoint != nil {
+		b.MaxRatePerEndpoint = *c.maxRatePerEndpoint
+	}
+	c.applyCapacityScaler(b)
+}
+
+// applyToIGBackend applies the capacity settings to an instance group
+// backend, created with the balancing mode of the settings. The capacity
+// scaler defaults to 1, like in GCE.
+func (c *capacitySettings) applyToIGBackend(b *composite.Backend) {
+	b.CapacityScaler = 1.0
+	if c.maxRatePerEndpoint != nil {
+		b.MaxRatePerInstance = *c.maxRatePerEndpoint
+	}
+	if c.maxConnectionsPerEndpoint != nil {
+		b.MaxConnectionsPerInstance = *c.maxConnectionsPerEndpoint
+	}
+	if c.maxUtilization != nil {
+		b.MaxUtilization = *c.maxUtilization
+	}
+	c.applyCapacityScaler(b)
+}
+
+// applyCapacityScaler sets the capacity scaler of the backend. A capacity
+// scaler of 0 has to be sent explicitly as it is the zero value.
+func (c *capacitySettings) applyCapacityScaler(b *composite.Backend) {
+	if c.capacityScaler == nil {
+		return
+	}
+	b.CapacityScaler = *c.capacityScaler
+	if b.CapacityScaler == 0 {
+		b.ForceSendFields = append(b.ForceSendFields, "CapacityScaler")
+	}
+}
+
+// igBackendNeedsUpdate returns true if the existing instance group backend
+// differs from the wanted backend with the capacity settings. Limits which are
+// not specified are left to GCE and not compared.
+func (c *capacitySettings) igBackendNeedsUpdate(existing, want *composite.Backend) bool {
+	if existing.BalancingMode != want.BalancingMode || existing.CapacityScaler != want.CapacityScaler {
+		return true
+	}
+	if (c.balancingMode == Rate || c.maxRatePerEndpoint != nil) && existing.MaxRatePerInstance != want.MaxRatePerInstance {
+		return true
+	}
+	if c.maxConnectionsPerEndpoint != nil && existing.MaxConnectionsPerInstance != want.MaxConnectionsPerInstance {
+		return true
+	}
+	if c.maxUtilization != nil && existing.MaxUtilization != want.MaxUtilization {
+		return true
+	}
+	return false
+}
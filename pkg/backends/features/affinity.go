@@ -24,8 +24,10 @@ import (
 	"k8s.io/klog/v2"
 )
 
-// EnsureAffinity reads the sessionAffinity and AffinityCookieTtlSec configuration
-// specified in the ServicePort.BackendConfig and applies it to the BackendService.
+// EnsureAffinity reads the sessionAffinity, AffinityCookieTtlSec, LocalityLbPolicy
+// and ConsistentHash configuration specified in the ServicePort.BackendConfig and
+// applies it to the BackendService. LocalityLbPolicy and ConsistentHash are left
+// untouched if they are not specified.
 // It returns true if there were existing settings on the BackendService
 // that were overwritten.
 func EnsureAffinity(sp utils.ServicePort, be *composite.BackendService) bool {
@@ -34,7 +36,9 @@ func EnsureAffinity(sp utils.ServicePort, be *composite.BackendService) bool {
 	}
 	beTemp := &composite.BackendService{}
 	applyAffinitySettings(sp, beTemp)
-	if !reflect.DeepEqual(beTemp.AffinityCookieTtlSec, be.AffinityCookieTtlSec) || beTemp.SessionAffinity != be.SessionAffinity {
+	if !reflect.DeepEqual(beTemp.AffinityCookieTtlSec, be.AffinityCookieTtlSec) || beTemp.SessionAffinity != be.SessionAffinity ||
+		(beTemp.LocalityLbPolicy != "" && beTemp.LocalityLbPolicy != be.LocalityLbPolicy) ||
+		(beTemp.ConsistentHash != nil && !consistentHashApplied(beTemp.ConsistentHash, be.ConsistentHash)) {
 		applyAffinitySettings(sp, be)
 		klog.V(2).Infof("Updated SessionAffinity settings for service %v/%v.", sp.ID.Service.Namespace, sp.ID.Service.Name)
 		return true
@@ -46,8 +50,53 @@ func EnsureAffinity(sp utils.ServicePort, be *composite.BackendService) bool {
 // BackendConfig to the passed in composite.BackendService. A GCE API call still
 // needs to be made to actually persist the changes.
 func applyAffinitySettings(sp utils.ServicePort, be *composite.BackendService) {
-	be.SessionAffinity = sp.BackendConfig.Spec.SessionAffinity.AffinityType
-	if sp.BackendConfig.Spec.SessionAffinity.AffinityCookieTtlSec != nil {
-		be.AffinityCookieTtlSec = *sp.BackendConfig.Spec.SessionAffinity.AffinityCookieTtlSec
+	affinity := sp.BackendConfig.Spec.SessionAffinity
+	be.SessionAffinity = affinity.AffinityType
+	if affinity.AffinityCookieTtlSec != nil {
+		be.AffinityCookieTtlSec = *affinity.AffinityCookieTtlSec
 	}
+	if affinity.LocalityLbPolicy != "" {
+		be.LocalityLbPolicy = affinity.LocalityLbPolicy
+	}
+	if consistentHash := affinity.ConsistentHash; consistentHash != nil {
+		be.ConsistentHash = &composite.ConsistentHashLoadBalancerSettings{
+			HttpHeaderName:  consistentHash.HttpHeaderName,
+			MinimumRingSize: consistentHash.MinimumRingSize,
+		}
+		if cookie := consistentHash.HttpCookie; cookie != nil {
+			be.ConsistentHash.HttpCookie = &composite.ConsistentHashLoadBalancerSettingsHttpCookie{
+				Name: cookie.Name,
+				Path: cookie.Path,
+				Ttl:  toCompositeDuration(cookie.Ttl),
+			}
+		}
+	}
+}
+
+// consistentHashApplied returns true if the consistent hash settings specified
+// in the BackendConfig are set on the BackendService. Settings that are not
+// specified are ignored since GCE fills in their defaults, e.g. a
+// MinimumRingSize of 1024.
+func consistentHashApplied(want, got *composite.ConsistentHashLoadBalancerSettings) bool {
+	if got == nil {
+		return false
+	}
+	if want.HttpHeaderName != "" && want.HttpHeaderName != got.HttpHeaderName {
+		return false
+	}
+	if want.MinimumRingSize != 0 && want.MinimumRingSize != got.MinimumRingSize {
+		return false
+	}
+	if cookie := want.HttpCookie; cookie != nil {
+		if got.HttpCookie == nil || cookie.Name != got.HttpCookie.Name {
+			return false
+		}
+		if cookie.Path != "" && cookie.Path != got.HttpCookie.Path {
+			return false
+		}
+		if cookie.Ttl != nil && !reflect.DeepEqual(cookie.Ttl, got.HttpCookie.Ttl) {
+			return false
+		}
+	}
+	return true
 }
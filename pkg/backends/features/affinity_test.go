@@ -114,6 +114,140 @@ func TestEnsureAffinity(t *testing.T) {
 			},
 			updateExpected: false,
 		},
+		{
+			desc: "locality lb policy differing, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+							LocalityLbPolicy: "LEAST_REQUEST",
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				LocalityLbPolicy: "ROUND_ROBIN",
+			},
+			updateExpected: true,
+		},
+		{
+			desc: "locality lb policy and consistent hash missing from spec, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+							AffinityType: "CLIENT_IP",
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				SessionAffinity:  "CLIENT_IP",
+				LocalityLbPolicy: "RING_HASH",
+				ConsistentHash:   &composite.ConsistentHashLoadBalancerSettings{MinimumRingSize: 2048},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "consistent hash cookie differing, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+							AffinityType:     "HTTP_COOKIE",
+							LocalityLbPolicy: "RING_HASH",
+							ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+								HttpCookie: &backendconfigv1.ConsistentHashHttpCookieConfig{
+									Name: "session",
+									Ttl:  &backendconfigv1.DurationConfig{Seconds: 3600},
+								},
+							},
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				SessionAffinity:  "HTTP_COOKIE",
+				LocalityLbPolicy: "RING_HASH",
+				ConsistentHash: &composite.ConsistentHashLoadBalancerSettings{
+					HttpCookie: &composite.ConsistentHashLoadBalancerSettingsHttpCookie{
+						Name: "session",
+						Ttl:  &composite.Duration{Seconds: 60},
+					},
+				},
+			},
+			updateExpected: true,
+		},
+		{
+			desc: "consistent hash settings identical, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+							AffinityType:     "HEADER_FIELD",
+							LocalityLbPolicy: "MAGLEV",
+							ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+								HttpHeaderName:  "x-session-id",
+								MinimumRingSize: 2048,
+							},
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				SessionAffinity:  "HEADER_FIELD",
+				LocalityLbPolicy: "MAGLEV",
+				ConsistentHash: &composite.ConsistentHashLoadBalancerSettings{
+					HttpHeaderName:  "x-session-id",
+					MinimumRingSize: 2048,
+				},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "consistent hash defaults filled in by GCE, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+							AffinityType:     "HTTP_COOKIE",
+							LocalityLbPolicy: "RING_HASH",
+							ConsistentHash: &backendconfigv1.ConsistentHashConfig{
+								HttpCookie: &backendconfigv1.ConsistentHashHttpCookieConfig{Name: "session"},
+							},
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				SessionAffinity:  "HTTP_COOKIE",
+				LocalityLbPolicy: "RING_HASH",
+				ConsistentHash: &composite.ConsistentHashLoadBalancerSettings{
+					HttpCookie:      &composite.ConsistentHashLoadBalancerSettingsHttpCookie{Name: "session", Path: "/"},
+					MinimumRingSize: 1024,
+				},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "consistent hash missing from backend service, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+							AffinityType:     "HEADER_FIELD",
+							LocalityLbPolicy: "RING_HASH",
+							ConsistentHash:   &backendconfigv1.ConsistentHashConfig{HttpHeaderName: "x-session-id"},
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				SessionAffinity:  "HEADER_FIELD",
+				LocalityLbPolicy: "RING_HASH",
+			},
+			updateExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"testing"
+
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+func TestEnsureCircuitBreakers(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		sp             utils.ServicePort
+		be             *composite.BackendService
+		updateExpected bool
+	}{
+		{
+			desc: "circuit breakers are defined on serviceport but missing from spec, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						CircuitBreakers: &backendconfigv1.CircuitBreakersConfig{
+							MaxRequests: 100,
+						},
+					},
+				},
+			},
+			be:             &composite.BackendService{},
+			updateExpected: true,
+		},
+		{
+			desc: "circuit breakers are missing from spec, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{},
+				},
+			},
+			be: &composite.BackendService{
+				CircuitBreakers: &composite.CircuitBreakers{
+					MaxRequests: 100,
+				},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "circuit breakers are identical, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						CircuitBreakers: &backendconfigv1.CircuitBreakersConfig{
+							MaxConnections: 1000,
+							MaxRequests:    100,
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				CircuitBreakers: &composite.CircuitBreakers{
+					MaxConnections: 1000,
+					MaxRequests:    100,
+				},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "circuit breakers differ, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						CircuitBreakers: &backendconfigv1.CircuitBreakersConfig{
+							MaxRequests: 100,
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				CircuitBreakers: &composite.CircuitBreakers{
+					MaxRequests: 100,
+					MaxRetries:  3,
+				},
+			},
+			updateExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result := EnsureCircuitBreakers(tc.sp, tc.be)
+			if result != tc.updateExpected {
+				t.Errorf("%v: expected %v but got %v", tc.desc, tc.updateExpected, result)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package features
 
 import (
+	"reflect"
 	"testing"
 
 	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
@@ -92,3 +93,31 @@ func TestEnsureCustomRequestHeaders(t *testing.T) {
 		})
 	}
 }
+
+// TestEnsureCustomRequestHeadersClientCert asserts that the mTLS variables are
+// forwarded to the backend service, which fills them in with the client
+// certificate validated by the server TLS policy of the target https proxy.
+func TestEnsureCustomRequestHeadersClientCert(t *testing.T) {
+	headers := []string{
+		"X-Client-Cert-Present:{client_cert_present}",
+		"X-Client-Cert-Chain-Verified:{client_cert_chain_verified}",
+		"X-Client-Cert-Hash:{client_cert_sha256_fingerprint}",
+	}
+	sp := utils.ServicePort{
+		BackendConfig: &backendconfigv1.BackendConfig{
+			Spec: backendconfigv1.BackendConfigSpec{
+				CustomRequestHeaders: &backendconfigv1.CustomRequestHeadersConfig{
+					Headers: headers,
+				},
+			},
+		},
+	}
+	be := &composite.BackendService{CustomRequestHeaders: testCustomHeader}
+
+	if !EnsureCustomRequestHeaders(sp, be) {
+		t.Errorf("EnsureCustomRequestHeaders() = false, want true")
+	}
+	if !reflect.DeepEqual(be.CustomRequestHeaders, headers) {
+		t.Errorf("be.CustomRequestHeaders = %v, want %v", be.CustomRequestHeaders, headers)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/utils"
 )
 
@@ -40,6 +41,9 @@ const (
 	FeatureL7XLBRegional = "L7XLBRegional"
 	//FeatureVMIPNEG defines the feature name of GCE_VM_IP NEGs which are used for L4 ILB.
 	FeatureVMIPNEG = "VMIPNEG"
+	// FeatureLocalityLbPolicy defines the feature name of the locality load
+	// balancing policy and consistent hash settings of the session affinity.
+	FeatureLocalityLbPolicy = "LocalityLbPolicy"
 )
 
 var (
@@ -63,12 +67,15 @@ func SetDescription(desc *utils.Description, sp *utils.ServicePort) {
 // given ServicePort that required non-GA API.
 func featuresFromServicePort(sp *utils.ServicePort) []string {
 	features := []string{}
-	if sp.Protocol == annotations.ProtocolHTTP2 {
+	if sp.Protocol == annotations.ProtocolHTTP2 || sp.Protocol == annotations.ProtocolGRPC {
 		features = append(features, FeatureHTTP2)
 	}
 	if sp.BackendConfig != nil && sp.BackendConfig.Spec.SecurityPolicy != nil {
 		features = append(features, FeatureSecurityPolicy)
 	}
+	if sp.BackendConfig != nil && usesLocalityLbPolicy(sp.BackendConfig.Spec.SessionAffinity) {
+		features = append(features, FeatureLocalityLbPolicy)
+	}
 	if sp.NEGEnabled {
 		features = append(features, FeatureNEG)
 	}
@@ -86,6 +93,12 @@ func featuresFromServicePort(sp *utils.ServicePort) []string {
 	return features
 }
 
+// usesLocalityLbPolicy returns true if the session affinity configures the
+// locality load balancing policy or consistent hash of the backend service.
+func usesLocalityLbPolicy(affinity *backendconfigv1.SessionAffinityConfig) bool {
+	return affinity != nil && (affinity.LocalityLbPolicy != "" || affinity.ConsistentHash != nil)
+}
+
 // VersionFromServicePort returns the meta.Version for the backend that this ServicePort is
 // associated with.
 func VersionFromServicePort(sp *utils.ServicePort) meta.Version {
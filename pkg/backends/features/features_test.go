@@ -70,6 +70,19 @@ var (
 		},
 	}
 
+	svcPortWithLocalityLbPolicy = utils.ServicePort{
+		ID: fakeSvcPortID,
+		BackendConfig: &backendconfigv1.BackendConfig{
+			Spec: backendconfigv1.BackendConfigSpec{
+				SessionAffinity: &backendconfigv1.SessionAffinityConfig{
+					AffinityType:     "HEADER_FIELD",
+					LocalityLbPolicy: "RING_HASH",
+					ConsistentHash:   &backendconfigv1.ConsistentHashConfig{HttpHeaderName: "x-session-id"},
+				},
+			},
+		},
+	}
+
 	svcPortWithNEG = utils.ServicePort{
 		ID:         fakeSvcPortID,
 		NEGEnabled: true,
@@ -102,6 +115,11 @@ func TestFeaturesFromServicePort(t *testing.T) {
 			svcPort:          svcPortWithNEG,
 			expectedFeatures: []string{"NEG"},
 		},
+		{
+			desc:             "LocalityLbPolicy",
+			svcPort:          svcPortWithLocalityLbPolicy,
+			expectedFeatures: []string{"LocalityLbPolicy"},
+		},
 		{
 			desc:             "HTTP2 + SecurityPolicy",
 			svcPort:          svcPortWithHTTP2SecurityPolicy,
@@ -228,6 +246,11 @@ func TestVersionFromServicePort(t *testing.T) {
 			svcPort:         svcPortWithHTTP2SecurityPolicy,
 			expectedVersion: meta.VersionBeta,
 		},
+		{
+			desc:            "enabled locality lb policy",
+			svcPort:         svcPortWithLocalityLbPolicy,
+			expectedVersion: meta.VersionGA,
+		},
 	}
 
 	for _, tc := range testCases {
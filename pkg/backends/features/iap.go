@@ -34,10 +34,7 @@ func EnsureIAP(sp utils.ServicePort, be *composite.BackendService) bool {
 	}
 	beTemp := &composite.BackendService{}
 	applyIAPSettings(sp, beTemp)
-	// We need to compare the SHA256 of the client secret instead of the client secret itself
-	// since that field is redacted when getting a BackendService.
-	beTemp.Iap.Oauth2ClientSecretSha256 = fmt.Sprintf("%x", sha256.Sum256([]byte(beTemp.Iap.Oauth2ClientSecret)))
-	if be.Iap == nil || beTemp.Iap.Enabled != be.Iap.Enabled || beTemp.Iap.Oauth2ClientId != be.Iap.Oauth2ClientId || beTemp.Iap.Oauth2ClientSecretSha256 != be.Iap.Oauth2ClientSecretSha256 {
+	if !iapSettingsEqual(beTemp.Iap, be.Iap) {
 		applyIAPSettings(sp, be)
 		klog.V(2).Infof("Updated IAP settings for service %v/%v.", sp.ID.Service.Namespace, sp.ID.Service.Name)
 		return true
@@ -45,6 +42,22 @@ func EnsureIAP(sp utils.ServicePort, be *composite.BackendService) bool {
 	return false
 }
 
+// iapSettingsEqual returns true if the existing IAP settings of a
+// BackendService match the wanted settings.
+func iapSettingsEqual(want, existing *composite.BackendServiceIAP) bool {
+	if existing == nil || want.Enabled != existing.Enabled || want.Oauth2ClientId != existing.Oauth2ClientId {
+		return false
+	}
+	// A Google-managed OAuth client has neither a client id nor a client
+	// secret, so there is no secret to compare.
+	if want.Oauth2ClientId == "" {
+		return true
+	}
+	// We need to compare the SHA256 of the client secret instead of the client secret itself
+	// since that field is redacted when getting a BackendService.
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(want.Oauth2ClientSecret))) == existing.Oauth2ClientSecretSha256
+}
+
 // applyIAPSettings applies the IAP settings specified in the BackendConfig
 // to the passed in compute.BackendService. A GCE API call still needs to be
 // made to actually persist the changes.
@@ -52,7 +65,11 @@ func applyIAPSettings(sp utils.ServicePort, be *composite.BackendService) {
 	beConfig := sp.BackendConfig
 	// Apply the boolean switch
 	be.Iap = &composite.BackendServiceIAP{Enabled: beConfig.Spec.Iap.Enabled}
-	// Apply the OAuth credentials
-	be.Iap.Oauth2ClientId = beConfig.Spec.Iap.OAuthClientCredentials.ClientID
-	be.Iap.Oauth2ClientSecret = beConfig.Spec.Iap.OAuthClientCredentials.ClientSecret
+	// Apply the OAuth credentials. Without credentials, the Google-managed
+	// OAuth client is used and the credentials of a custom OAuth client are
+	// cleared.
+	if creds := beConfig.Spec.Iap.OAuthClientCredentials; creds != nil {
+		be.Iap.Oauth2ClientId = creds.ClientID
+		be.Iap.Oauth2ClientSecret = creds.ClientSecret
+	}
 }
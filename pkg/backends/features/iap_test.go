@@ -167,6 +167,85 @@ func TestEnsureIAP(t *testing.T) {
 			},
 			updateExpected: true,
 		},
+		{
+			desc: "google-managed oauth client is identical, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						Iap: &backendconfigv1.IAPConfig{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				Iap: &composite.BackendServiceIAP{
+					Enabled: true,
+				},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "google-managed oauth client with empty credentials is identical, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						Iap: &backendconfigv1.IAPConfig{
+							Enabled:                true,
+							OAuthClientCredentials: &backendconfigv1.OAuthClientCredentials{},
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				Iap: &composite.BackendServiceIAP{
+					Enabled: true,
+				},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "switch from custom to google-managed oauth client, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						Iap: &backendconfigv1.IAPConfig{
+							Enabled: true,
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				Iap: &composite.BackendServiceIAP{
+					Enabled:                  true,
+					Oauth2ClientId:           "foo",
+					Oauth2ClientSecretSha256: fmt.Sprintf("%x", sha256.Sum256([]byte("bar"))),
+				},
+			},
+			updateExpected: true,
+		},
+		{
+			desc: "switch from google-managed to custom oauth client, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						Iap: &backendconfigv1.IAPConfig{
+							Enabled: true,
+							OAuthClientCredentials: &backendconfigv1.OAuthClientCredentials{
+								ClientID:     "foo",
+								ClientSecret: "bar",
+							},
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				Iap: &composite.BackendServiceIAP{
+					Enabled: true,
+				},
+			},
+			updateExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -175,6 +254,9 @@ func TestEnsureIAP(t *testing.T) {
 			if result != tc.updateExpected {
 				t.Errorf("%v: expected %v but got %v", tc.desc, tc.updateExpected, result)
 			}
+			if result && tc.sp.BackendConfig.Spec.Iap.OAuthClientCredentials == nil && (tc.be.Iap.Oauth2ClientId != "" || tc.be.Iap.Oauth2ClientSecret != "") {
+				t.Errorf("%v: expected the custom oauth client to be cleared, got %+v", tc.desc, tc.be.Iap)
+			}
 		})
 	}
 }
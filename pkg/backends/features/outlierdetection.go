@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"reflect"
+
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/klog/v2"
+)
+
+// EnsureOutlierDetection reads the OutlierDetection configuration specified in
+// the ServicePort.BackendConfig and applies it to the BackendService.
+// It returns true if there were existing settings on the BackendService
+// that were overwritten.
+func EnsureOutlierDetection(sp utils.ServicePort, be *composite.BackendService) bool {
+	if sp.BackendConfig.Spec.OutlierDetection == nil {
+		return false
+	}
+	beTemp := &composite.BackendService{}
+	applyOutlierDetectionSettings(sp, beTemp)
+	if !reflect.DeepEqual(beTemp.OutlierDetection, be.OutlierDetection) {
+		applyOutlierDetectionSettings(sp, be)
+		klog.V(2).Infof("Updated OutlierDetection settings for service %v/%v.", sp.ID.Service.Namespace, sp.ID.Service.Name)
+		return true
+	}
+	return false
+}
+
+// applyOutlierDetectionSettings applies the OutlierDetection settings
+// specified in the BackendConfig to the passed in composite.BackendService.
+// A GCE API call still needs to be made to actually persist the changes.
+func applyOutlierDetectionSettings(sp utils.ServicePort, be *composite.BackendService) {
+	outlierDetection := sp.BackendConfig.Spec.OutlierDetection
+	be.OutlierDetection = &composite.OutlierDetection{
+		BaseEjectionTime:                   toCompositeDuration(outlierDetection.BaseEjectionTime),
+		ConsecutiveErrors:                  outlierDetection.ConsecutiveErrors,
+		ConsecutiveGatewayFailure:          outlierDetection.ConsecutiveGatewayFailure,
+		EnforcingConsecutiveErrors:         outlierDetection.EnforcingConsecutiveErrors,
+		EnforcingConsecutiveGatewayFailure: outlierDetection.EnforcingConsecutiveGatewayFailure,
+		EnforcingSuccessRate:               outlierDetection.EnforcingSuccessRate,
+		Interval:                           toCompositeDuration(outlierDetection.Interval),
+		MaxEjectionPercent:                 outlierDetection.MaxEjectionPercent,
+		SuccessRateMinimumHosts:            outlierDetection.SuccessRateMinimumHosts,
+		SuccessRateRequestVolume:           outlierDetection.SuccessRateRequestVolume,
+		SuccessRateStdevFactor:             outlierDetection.SuccessRateStdevFactor,
+	}
+}
+
+// toCompositeDuration converts a DurationConfig to a composite.Duration. It
+// returns nil if d is nil.
+func toCompositeDuration(d *backendconfigv1.DurationConfig) *composite.Duration {
+	if d == nil {
+		return nil
+	}
+	return &composite.Duration{Seconds: d.Seconds, Nanos: d.Nanos}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"testing"
+
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+func TestEnsureOutlierDetection(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		sp             utils.ServicePort
+		be             *composite.BackendService
+		updateExpected bool
+	}{
+		{
+			desc: "outlier detection is defined on serviceport but missing from spec, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						OutlierDetection: &backendconfigv1.OutlierDetectionConfig{
+							ConsecutiveErrors: 5,
+						},
+					},
+				},
+			},
+			be:             &composite.BackendService{},
+			updateExpected: true,
+		},
+		{
+			desc: "outlier detection is missing from spec, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{},
+				},
+			},
+			be: &composite.BackendService{
+				OutlierDetection: &composite.OutlierDetection{
+					ConsecutiveErrors: 5,
+				},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "outlier detection settings are identical, no update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						OutlierDetection: &backendconfigv1.OutlierDetectionConfig{
+							BaseEjectionTime:   &backendconfigv1.DurationConfig{Seconds: 30},
+							ConsecutiveErrors:  5,
+							MaxEjectionPercent: 50,
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				OutlierDetection: &composite.OutlierDetection{
+					BaseEjectionTime:   &composite.Duration{Seconds: 30},
+					ConsecutiveErrors:  5,
+					MaxEjectionPercent: 50,
+				},
+			},
+			updateExpected: false,
+		},
+		{
+			desc: "outlier detection interval differs, update needed",
+			sp: utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						OutlierDetection: &backendconfigv1.OutlierDetectionConfig{
+							Interval: &backendconfigv1.DurationConfig{Seconds: 10},
+						},
+					},
+				},
+			},
+			be: &composite.BackendService{
+				OutlierDetection: &composite.OutlierDetection{
+					Interval: &composite.Duration{Seconds: 1},
+				},
+			},
+			updateExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result := EnsureOutlierDetection(tc.sp, tc.be)
+			if result != tc.updateExpected {
+				t.Errorf("%v: expected %v but got %v", tc.desc, tc.updateExpected, result)
+			}
+		})
+	}
+}
@@ -21,9 +21,11 @@ import (
 
 	"k8s.io/klog/v2"
 
+	gcecloud "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/utils"
 )
@@ -31,52 +33,84 @@ import (
 // EnsureSecurityPolicy ensures the security policy link on backend service.
 // TODO(mrhohn): Emit event when attach/detach security policy to backend service.
 func EnsureSecurityPolicy(cloud *gce.Cloud, sp utils.ServicePort, be *composite.BackendService) error {
+	return ensurePolicy("security policy", sp.BackendConfig.Spec.SecurityPolicy, be.SecurityPolicy, sp, be, func(policyName string) error {
+		return composite.SetSecurityPolicy(cloud, be, policyName)
+	})
+}
+
+// EnsureEdgeSecurityPolicy ensures the edge security policy link on backend
+// service. It is reconciled independently of the security policy, so either
+// of them can be detached without the other.
+func EnsureEdgeSecurityPolicy(cloud *gce.Cloud, sp utils.ServicePort, be *composite.BackendService) error {
+	return ensurePolicy("edge security policy", sp.BackendConfig.Spec.EdgeSecurityPolicy, be.EdgeSecurityPolicy, sp, be, func(policyName string) error {
+		return composite.SetEdgeSecurityPolicy(cloud, be, policyName)
+	})
+}
+
+// ensurePolicy ensures that the policy of the given kind, currently linked
+// as existingPolicyLink, matches the desired policy. The policy is set with
+// setPolicy.
+func ensurePolicy(kind string, desiredPolicy *backendconfigv1.SecurityPolicyConfig, existingPolicyLink string, sp utils.ServicePort, be *composite.BackendService, setPolicy func(policyName string) error) error {
 	// It is too dangerous to remove user's security policy that may have been
 	// configured via the UI or gcloud directly rather than via Kubernetes.
 	// Treat nil security policy -> ignored
 	// Treat empty string security policy name -> remove
-	if sp.BackendConfig.Spec.SecurityPolicy == nil {
-		klog.V(2).Infof("Ignoring nil Security Policy on backend service %s (%s:%s)", be.Name, sp.ID.Service.String(), sp.ID.Port.String())
+	if desiredPolicy == nil {
+		klog.V(2).Infof("Ignoring nil %s on backend service %s (%s:%s)", kind, be.Name, sp.ID.Service.String(), sp.ID.Port.String())
 		return nil
 	}
 
 	if be.Scope != meta.Global {
-		err := fmt.Errorf("cloud armor security policies not supported for %s backend service %s", be.Scope, be.Name)
-		klog.Errorf("EnsureSecurityPolicy() = %v", err)
+		err := fmt.Errorf("cloud armor %s not supported for %s backend service %s", kind, be.Scope, be.Name)
+		klog.Errorf("ensurePolicy() = %v", err)
 		return err
 	}
 
-	existingPolicyName, err := utils.KeyName(be.SecurityPolicy)
+	existingPolicyName, err := utils.KeyName(existingPolicyLink)
 	// The parser returns error for empty values.
-	if be.SecurityPolicy != "" && err != nil {
-		err := fmt.Errorf("failed to parse existing security policy name %q: %v", existingPolicyName, err)
-		klog.Errorf("EnsureSecurityPolicy() = %v", err)
+	if existingPolicyLink != "" && err != nil {
+		err := fmt.Errorf("failed to parse existing %s name %q: %v", kind, existingPolicyName, err)
+		klog.Errorf("ensurePolicy() = %v", err)
 		return err
 	}
 
-	desiredPolicyName := sp.BackendConfig.Spec.SecurityPolicy.Name
-	klog.V(2).Infof("Current security policy: %q, desired security policy: %q", existingPolicyName, desiredPolicyName)
+	desiredPolicyName := desiredPolicy.Name
+	klog.V(2).Infof("Current %s: %q, desired %s: %q", kind, existingPolicyName, kind, desiredPolicyName)
 	if existingPolicyName == desiredPolicyName {
-		klog.V(2).Infof("SecurityPolicy on backend service is not changed %s (%s:%s): %q", be.Name, sp.ID.Service.String(), sp.ID.Port.String(), desiredPolicyName)
+		klog.V(2).Infof("%s on backend service is not changed %s (%s:%s): %q", kind, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), desiredPolicyName)
 		return nil
 	}
 
 	if desiredPolicyName != "" {
-		klog.V(2).Infof("Set security policy in backend service %s (%s:%s) from %q to %q", be.Name, sp.ID.Service.String(), sp.ID.Port.String(), existingPolicyName, desiredPolicyName)
-		if err := composite.SetSecurityPolicy(cloud, be, desiredPolicyName); err != nil {
-			err := fmt.Errorf("failed to set security policy from %q to %q for backend service %s (%s:%s): %v", existingPolicyName, desiredPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), err)
-			klog.Errorf("SetSecurityPolicy() = %v", err)
+		klog.V(2).Infof("Set %s in backend service %s (%s:%s) from %q to %q", kind, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), existingPolicyName, desiredPolicyName)
+		if err := setPolicy(desiredPolicyName); err != nil {
+			err := fmt.Errorf("failed to set %s from %q to %q for backend service %s (%s:%s): %v", kind, existingPolicyName, desiredPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), err)
+			klog.Errorf("setPolicy() = %v", err)
 			return err
 		}
-		klog.V(2).Infof("Successfully set security policy in backend service %s (%s:%s) from %q to %q", be.Name, sp.ID.Service.String(), sp.ID.Port.String(), existingPolicyName, desiredPolicyName)
+		klog.V(2).Infof("Successfully set %s in backend service %s (%s:%s) from %q to %q", kind, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), existingPolicyName, desiredPolicyName)
 		return nil
 	}
-	klog.V(2).Infof("Removing security policy %q in backend service %s (%s:%s)", existingPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String())
-	if err := composite.SetSecurityPolicy(cloud, be, desiredPolicyName); err != nil {
-		err := fmt.Errorf("failed to remove security policy %q for backend service %s (%s:%s): %v", existingPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), err)
-		klog.Errorf("SetSecurityPolicy() = %v", err)
+	klog.V(2).Infof("Removing %s %q in backend service %s (%s:%s)", kind, existingPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String())
+	if err := setPolicy(desiredPolicyName); err != nil {
+		err := fmt.Errorf("failed to remove %s %q for backend service %s (%s:%s): %v", kind, existingPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String(), err)
+		klog.Errorf("setPolicy() = %v", err)
 		return err
 	}
-	klog.V(2).Infof("Successfully removed security policy %q in backend service %s (%s:%s)", existingPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String())
+	klog.V(2).Infof("Successfully removed %s %q in backend service %s (%s:%s)", kind, existingPolicyName, be.Name, sp.ID.Service.String(), sp.ID.Port.String())
 	return nil
 }
+
+// SecurityPolicyExists returns true if the global security policy with the
+// given name exists.
+func SecurityPolicyExists(cloud *gce.Cloud, policyName string) (bool, error) {
+	ctx, cancel := gcecloud.ContextWithCallTimeout()
+	defer cancel()
+	if _, err := cloud.Compute().BetaSecurityPolicies().Get(ctx, meta.GlobalKey(policyName)); err != nil {
+		if utils.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
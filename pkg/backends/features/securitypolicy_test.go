@@ -30,6 +30,7 @@ import (
 
 	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/test"
 	"k8s.io/ingress-gce/pkg/utils"
 )
 
@@ -188,3 +189,82 @@ func TestEnsureSecurityPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureEdgeSecurityPolicy(t *testing.T) {
+	const (
+		policyLink     = "https://www.googleapis.com/compute/v1/projects/test-project/global/securityPolicies/policy-1"
+		edgePolicyLink = "https://www.googleapis.com/compute/v1/projects/test-project/global/securityPolicies/edge-policy-1"
+	)
+
+	for _, tc := range []struct {
+		desc              string
+		desiredEdgePolicy *backendconfigv1.SecurityPolicyConfig
+		wantEdgePolicy    string
+	}{
+		{
+			desc:              "attach edge policy",
+			desiredEdgePolicy: &backendconfigv1.SecurityPolicyConfig{Name: "edge-policy-2"},
+			wantEdgePolicy:    "https://www.googleapis.com/compute/v1/projects/test-project/global/securityPolicies/edge-policy-2",
+		},
+		{
+			desc:              "same edge policy",
+			desiredEdgePolicy: &backendconfigv1.SecurityPolicyConfig{Name: "edge-policy-1"},
+			wantEdgePolicy:    edgePolicyLink,
+		},
+		{
+			desc:              "detach edge policy only",
+			desiredEdgePolicy: &backendconfigv1.SecurityPolicyConfig{Name: ""},
+			wantEdgePolicy:    "",
+		},
+		{
+			desc:           "nil edge policy is ignored",
+			wantEdgePolicy: edgePolicyLink,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+			test.NewFakeComputeAPI(t, fakeGCE)
+			key := meta.GlobalKey("be-name")
+			if err := fakeGCE.Compute().BackendServices().Insert(context.TODO(), key, &compute.BackendService{
+				Name:               key.Name,
+				SecurityPolicy:     policyLink,
+				EdgeSecurityPolicy: edgePolicyLink,
+			}); err != nil {
+				t.Fatalf("BackendServices().Insert(%v) = %v", key, err)
+			}
+			be := &composite.BackendService{
+				Name:               key.Name,
+				Scope:              meta.Global,
+				SecurityPolicy:     policyLink,
+				EdgeSecurityPolicy: edgePolicyLink,
+			}
+			sp := utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						// The security policy is not managed by the BackendConfig.
+						EdgeSecurityPolicy: tc.desiredEdgePolicy,
+					},
+				},
+			}
+
+			if err := EnsureSecurityPolicy(fakeGCE, sp, be); err != nil {
+				t.Fatalf("EnsureSecurityPolicy()=%v, want nil", err)
+			}
+			if err := EnsureEdgeSecurityPolicy(fakeGCE, sp, be); err != nil {
+				t.Fatalf("EnsureEdgeSecurityPolicy()=%v, want nil", err)
+			}
+
+			got, err := fakeGCE.Compute().BackendServices().Get(context.TODO(), key)
+			if err != nil {
+				t.Fatalf("BackendServices().Get(%v) = %v", key, err)
+			}
+			if got.EdgeSecurityPolicy != tc.wantEdgePolicy {
+				t.Errorf("EdgeSecurityPolicy = %q, want %q", got.EdgeSecurityPolicy, tc.wantEdgePolicy)
+			}
+			// The security policy is left untouched.
+			if got.SecurityPolicy != policyLink {
+				t.Errorf("SecurityPolicy = %q, want %q", got.SecurityPolicy, policyLink)
+			}
+		})
+	}
+}
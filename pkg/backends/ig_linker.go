@@ -101,6 +101,10 @@ func (igl *instanceGroupLinker) Link(sp utils.ServicePort, groups []GroupKey) er
 		return err
 	}
 
+	if sp.BackendConfig != nil && sp.BackendConfig.Spec.Capacity != nil {
+		return igl.linkWithCapacity(sp, be, igLinks)
+	}
+
 	if len(addIGs) == 0 {
 		return nil
 	}
@@ -114,17 +118,33 @@ func (igl *instanceGroupLinker) Link(sp utils.ServicePort, groups []GroupKey) er
 		}
 	}
 
-	// We first try to create the backend with balancingMode=RATE.  If this	+ return addIGs
+	// We first try to create the backend with balancingMode=RATE.  If this
 	// fails, it's mostly likely because there are existing backends with
-	// balancingMode=UTILIZATION. This failure mode throws a googleapi error
-	// which wraps a HTTP 400 status code. We handle it in the loop below
-	// and come around to retry with the right balancing mode. The goal is to
-	// switch everyone to using RATE.
-	var errs []string
-	for _, bm := range []BalancingMode{Rate, Utilization} {
+	// balancingMode=UTILIZATION. The goal is to switch everyone to using RATE.
+	return igl.updateWithBalancingModes(be, []BalancingMode{Rate, Utilization}, func(bm BalancingMode) ([]*composite.Backend, bool, error) {
 		// Generate backends with given instance groups with a specific mode
-		newBackends := backendsForIGs(addIGs, bm)
-		be.Backends = append(originalIGBackends, newBackends...)
+		return append(originalIGBackends, backendsForIGs(addIGs, bm)...), true, nil
+	})
+}
+
+// updateWithBalancingModes updates the backends of the backend service to the
+// backends returned for each balancing mode in turn, until an update succeeds.
+// Backends of all backend services linked to an instance group must have the
+// same balancing mode. An update that conflicts with the balancing mode of
+// existing backends fails with a googleapi error which wraps a HTTP 400 status
+// code, and is retried with the next mode. No update is made if the backends
+// for a mode are unchanged.
+func (igl *instanceGroupLinker) updateWithBalancingModes(be *composite.BackendService, modes []BalancingMode, backendsForMode func(BalancingMode) ([]*composite.Backend, bool, error)) error {
+	var errs []string
+	for _, bm := range modes {
+		backends, changed, err := backendsForMode(bm)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+		be.Backends = backends
 
 		if err := igl.backendPool.Update(be); err != nil {
 			if utils.IsHTTPErrorCode(err, http.StatusBadRequest) {
@@ -145,6 +165,77 @@ func (igl *instanceGroupLinker) Link(sp utils.ServicePort, groups []GroupKey) er
 	return fmt.Errorf("received errors when updating backend service: %v", strings.Join(errs, "\n"))
 }
 
+// linkWithCapacity links the instance groups to the backend service with the
+// balancing mode and the capacity specified in the BackendConfig of the
+// service port. Unlike the default linking, the backends of instance groups
+// which are already linked are updated if their capacity differs. If the
+// instance groups are shared with backend services in the other balancing
+// mode, the backends are linked in that mode.
+func (igl *instanceGroupLinker) linkWithCapacity(sp utils.ServicePort, be *composite.BackendService, igLinks []string) error {
+	modes := []BalancingMode{Rate, Utilization}
+	if BalancingMode(sp.BackendConfig.Spec.Capacity.BalancingMode) == Utilization {
+		modes = []BalancingMode{Utilization, Rate}
+	}
+	existingBackends := be.Backends
+	return igl.updateWithBalancingModes(be, modes, func(bm BalancingMode) ([]*composite.Backend, bool, error) {
+		return capacityBackendsForIGs(&sp, be.Name, existingBackends, igLinks, bm)
+	})
+}
+
+// capacityBackendsForIGs returns the instance group backends of a backend
+// service with the given existing backends, with the capacity settings of the
+// service port in the balancing mode. It returns true if the backends changed.
+func capacityBackendsForIGs(sp *utils.ServicePort, beName string, existingBackends []*composite.Backend, igLinks []string, bm BalancingMode) ([]*composite.Backend, bool, error) {
+	wantBackends := map[string]*composite.Backend{}
+	capacities := map[string]*capacitySettings{}
+	var wantPaths []string
+	for _, igLink := range igLinks {
+		path, err := utils.RelativeResourceName(igLink)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to parse instance group: %w", err)
+		}
+		capacity := capacityForGroup(sp, igLink).forBalancingMode(bm)
+		backend := backendsForIGs([]string{igLink}, bm)[0]
+		capacity.applyToIGBackend(backend)
+		wantBackends[path] = backend
+		capacities[path] = capacity
+		wantPaths = append(wantPaths, path)
+	}
+
+	var backends []*composite.Backend
+	var changed bool
+	for _, backend := range existingBackends {
+		// Backend service is not able to point to NEG and IG at the same time.
+		// Filter IG backends here.
+		if !strings.Contains(backend.Group, "instanceGroups") {
+			continue
+		}
+		path, err := utils.RelativeResourceName(backend.Group)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to parse instance group: %w", err)
+		}
+		want, ok := wantBackends[path]
+		if !ok {
+			backends = append(backends, backend)
+			continue
+		}
+		delete(wantBackends, path)
+		if capacities[path].igBackendNeedsUpdate(backend, want) {
+			klog.V(2).Infof("Updating capacity of backend %q of backend service %q", path, beName)
+			backend = want
+			changed = true
+		}
+		backends = append(backends, backend)
+	}
+	for _, path := range wantPaths {
+		if want, ok := wantBackends[path]; ok {
+			backends = append(backends, want)
+			changed = true
+		}
+	}
+	return backends, changed, nil
+}
+
 func backendsForIGs(igLinks []string, bm BalancingMode) []*composite.Backend {
 	var backends []*composite.Backend
 
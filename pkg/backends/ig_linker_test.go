@@ -29,6 +29,7 @@ import (
 	"google.golang.org/api/googleapi"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/backends/features"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/instancegroups"
@@ -87,6 +88,152 @@ func TestLink(t *testing.T) {
 	}
 }
 
+func TestLinkWithCapacity(t *testing.T) {
+	fakeIGs := instancegroups.NewEmptyFakeInstanceGroups()
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	fakeZL := &instancegroups.FakeZoneLister{Zones: []string{defaultZone}}
+	fakeNodePool := instancegroups.NewManager(&instancegroups.ManagerConfig{
+		Cloud:      fakeIGs,
+		Namer:      defaultNamer,
+		Recorders:  &test.FakeRecorderSource{},
+		BasePath:   utils.GetBasePath(fakeGCE),
+		ZoneLister: fakeZL,
+		MaxIGSize:  1000,
+	})
+	linker := newTestIGLinker(fakeGCE, fakeNodePool)
+
+	maxUtilization := 0.6
+	sp := utils.ServicePort{
+		NodePort:     8080,
+		Protocol:     annotations.ProtocolHTTP,
+		BackendNamer: defaultNamer,
+		BackendConfig: &backendconfigv1.BackendConfig{
+			Spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{
+					BalancingMode:  "UTILIZATION",
+					MaxUtilization: &maxUtilization,
+				},
+			},
+		},
+	}
+
+	// Mimic the instance group being created
+	if _, err := linker.instancePool.EnsureInstanceGroupsAndPorts(defaultNamer.InstanceGroup(), []int64{sp.NodePort}); err != nil {
+		t.Fatalf("Did not expect error when ensuring IG for ServicePort %+v: %v", sp, err)
+	}
+
+	// Mimic the syncer creating the backend.
+	linker.backendPool.Create(sp, "fake-health-check-link")
+
+	if err := linker.Link(sp, []GroupKey{{Zone: defaultZone}}); err != nil {
+		t.Fatalf("%v", err)
+	}
+	be, err := fakeGCE.GetGlobalBackendService(sp.BackendName())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(be.Backends) != 1 {
+		t.Fatalf("Expected 1 backend, got %d", len(be.Backends))
+	}
+	if b := be.Backends[0]; b.BalancingMode != string(Utilization) || b.MaxUtilization != maxUtilization || b.CapacityScaler != 1 {
+		t.Errorf("Got backend %+v, want balancing mode %s, max utilization %v and capacity scaler 1", b, Utilization, maxUtilization)
+	}
+
+	// Drain the zone of the instance group.
+	capacityScaler := 0.0
+	sp.BackendConfig.Spec.Capacity.Zones = []backendconfigv1.ZoneCapacityConfig{{Zone: defaultZone, CapacityScaler: &capacityScaler}}
+	if err := linker.Link(sp, []GroupKey{{Zone: defaultZone}}); err != nil {
+		t.Fatalf("%v", err)
+	}
+	be, err = fakeGCE.GetGlobalBackendService(sp.BackendName())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(be.Backends) != 1 {
+		t.Fatalf("Expected 1 backend, got %d", len(be.Backends))
+	}
+	if b := be.Backends[0]; b.BalancingMode != string(Utilization) || b.CapacityScaler != 0 {
+		t.Errorf("Got backend %+v, want balancing mode %s and capacity scaler 0", b, Utilization)
+	}
+}
+
+func TestLinkWithCapacityModeError(t *testing.T) {
+	fakeIGs := instancegroups.NewEmptyFakeInstanceGroups()
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	fakeZL := &instancegroups.FakeZoneLister{Zones: []string{defaultZone}}
+	fakeNodePool := instancegroups.NewManager(&instancegroups.ManagerConfig{
+		Cloud:      fakeIGs,
+		Namer:      defaultNamer,
+		Recorders:  &test.FakeRecorderSource{},
+		BasePath:   utils.GetBasePath(fakeGCE),
+		ZoneLister: fakeZL,
+		MaxIGSize:  1000,
+	})
+	linker := newTestIGLinker(fakeGCE, fakeNodePool)
+
+	maxUtilization := 0.6
+	sp := utils.ServicePort{
+		NodePort:     8080,
+		Protocol:     annotations.ProtocolHTTP,
+		BackendNamer: defaultNamer,
+		BackendConfig: &backendconfigv1.BackendConfig{
+			Spec: backendconfigv1.BackendConfigSpec{
+				Capacity: &backendconfigv1.CapacityConfig{
+					BalancingMode:  "UTILIZATION",
+					MaxUtilization: &maxUtilization,
+				},
+			},
+		},
+	}
+
+	// The instance group is shared with backend services in RATE mode, so
+	// backends in UTILIZATION mode are rejected.
+	var updates int
+	(fakeGCE.Compute().(*cloud.MockGCE)).MockBackendServices.UpdateHook = func(ctx context.Context, key *meta.Key, be *compute.BackendService, m *cloud.MockBackendServices) error {
+		updates++
+		for _, b := range be.Backends {
+			if b.BalancingMode == string(Utilization) {
+				return &googleapi.Error{Code: http.StatusBadRequest}
+			}
+		}
+		return mock.UpdateBackendServiceHook(ctx, key, be, m)
+	}
+
+	// Mimic the instance group being created
+	if _, err := linker.instancePool.EnsureInstanceGroupsAndPorts(defaultNamer.InstanceGroup(), []int64{sp.NodePort}); err != nil {
+		t.Fatalf("Did not expect error when ensuring IG for ServicePort %+v: %v", sp, err)
+	}
+
+	// Mimic the syncer creating the backend.
+	linker.backendPool.Create(sp, "fake-health-check-link")
+
+	if err := linker.Link(sp, []GroupKey{{Zone: defaultZone}}); err != nil {
+		t.Fatalf("%v", err)
+	}
+	be, err := fakeGCE.GetGlobalBackendService(sp.BackendName())
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(be.Backends) != 1 {
+		t.Fatalf("Expected 1 backend, got %d", len(be.Backends))
+	}
+	if b := be.Backends[0]; b.BalancingMode != string(Rate) || b.MaxUtilization != 0 || b.MaxRatePerInstance != maxRPS {
+		t.Errorf("Got backend %+v, want balancing mode %s without max utilization", b, Rate)
+	}
+	if updates != 2 {
+		t.Errorf("Got %d updates, want 2", updates)
+	}
+
+	// The backend in the fallback mode is not updated again.
+	updates = 0
+	if err := linker.Link(sp, []GroupKey{{Zone: defaultZone}}); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if updates != 1 {
+		t.Errorf("Got %d updates, want only the rejected update in UTILIZATION mode", updates)
+	}
+}
+
 func TestLinkWithCreationModeError(t *testing.T) {
 	fakeIGs := instancegroups.NewEmptyFakeInstanceGroups()
 	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
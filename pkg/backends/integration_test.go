@@ -71,7 +71,7 @@ func newTestJig(fakeGCE *gce.Cloud) *Jig {
 	return &Jig{
 		fakeInstancePool: fakeInstancePool,
 		linker:           NewInstanceGroupLinker(fakeInstancePool, fakeBackendPool),
-		syncer:           NewBackendSyncer(fakeBackendPool, fakeHealthChecks, fakeGCE),
+		syncer:           NewBackendSyncer(fakeBackendPool, fakeHealthChecks, fakeGCE, nil, nil),
 		pool:             fakeBackendPool,
 	}
 }
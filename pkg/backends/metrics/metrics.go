@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	backendsSubsystem     = "backends"
+	signedUrlKeyRotations = "signed_url_key_rotation_stages"
+
+	// SignedUrlKeyAdded is the stage of a key added to a backend service.
+	SignedUrlKeyAdded = "added"
+	// SignedUrlKeyRetired is the stage of a key removed from the
+	// BackendConfig which is kept for the rotation grace period.
+	SignedUrlKeyRetired = "retired"
+	// SignedUrlKeyDeleted is the stage of a key deleted from a backend service.
+	SignedUrlKeyDeleted = "deleted"
+	// SignedUrlKeyDeletedEarly is the stage of a retiring key deleted before
+	// its grace period expired, to make room for a new key.
+	SignedUrlKeyDeletedEarly = "deleted_early"
+)
+
+var (
+	SignedUrlKeyRotations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: backendsSubsystem,
+			Name:      signedUrlKeyRotations,
+			Help:      "Number of signed URL keys which reached a stage of their rotation",
+		},
+		[]string{
+			"stage", // stage of the rotation
+		},
+	)
+)
+
+var register sync.Once
+
+func RegisterMetrics() {
+	register.Do(func() {
+		prometheus.MustRegister(SignedUrlKeyRotations)
+	})
+}
+
+// PublishSignedUrlKeyRotationMetrics counts a signed URL key reaching the
+// given stage of its rotation.
+func PublishSignedUrlKeyRotationMetrics(stage string) {
+	SignedUrlKeyRotations.WithLabelValues(stage).Inc()
+}
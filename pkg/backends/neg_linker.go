@@ -103,7 +103,8 @@ func (nl *negLinker) Link(sp utils.ServicePort, groups []GroupKey) error {
 		mergedBackend = newBackends
 	}
 
-	diff := diffBackends(backendService.Backends, mergedBackend)
+	compareCapacity := flags.F.EnableTrafficScaling || (sp.BackendConfig != nil && sp.BackendConfig.Spec.Capacity != nil)
+	diff := diffBackends(backendService.Backends, mergedBackend, compareCapacity)
 	if diff.isEqual() {
 		klog.V(2).Infof("No changes in backends for service port %s", sp.ID)
 		return nil
@@ -158,7 +159,10 @@ func mergeBackends(old, new []*composite.Backend) ([]*composite.Backend, error)
 	return ret, nil
 }
 
-func diffBackends(old, new []*composite.Backend) *backendDiff {
+// diffBackends returns the difference between the old and the new backends.
+// Changes to the capacity of a backend are only detected if compareCapacity
+// is true.
+func diffBackends(old, new []*composite.Backend, compareCapacity bool) *backendDiff {
 	d := &backendDiff{
 		old:     sets.NewString(),
 		new:     sets.NewString(),
@@ -180,9 +184,11 @@ func diffBackends(old, new []*composite.Backend) *backendDiff {
 			// value (e.g. CapacityScaler is 1.0), you will need to set that
 			// value when creating a new Backend to avoid a false positive when
 			// computing diffs.
-			if flags.F.EnableTrafficScaling {
+			if compareCapacity {
 				var changed bool
+				changed = changed || oldBe.BalancingMode != be.BalancingMode
 				changed = changed || oldBe.MaxRatePerEndpoint != be.MaxRatePerEndpoint
+				changed = changed || oldBe.MaxConnectionsPerEndpoint != be.MaxConnectionsPerEndpoint
 				changed = changed || oldBe.CapacityScaler != be.CapacityScaler
 				if changed {
 					d.changed.Insert(beGroup)
@@ -227,6 +233,11 @@ func backendsForNEGs(negSelfLinks []string, sp *utils.ServicePort) []*composite.
 					newBackend.CapacityScaler = *sp.CapacityScaler
 				}
 			}
+			// The capacity settings of the BackendConfig take precedence
+			// over the traffic scaling annotations of the Service.
+			if capacity := capacityForGroup(sp, neg); capacity != nil {
+				capacity.applyToNEGBackend(newBackend)
+			}
 		}
 
 		backends = append(backends, newBackend)
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	befeatures "k8s.io/ingress-gce/pkg/backends/features"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/flags"
@@ -251,7 +252,7 @@ func TestMergeBackends(t *testing.T) {
 			}
 
 			if !tc.expectError {
-				diffBackend := diffBackends(tc.expect, ret)
+				diffBackend := diffBackends(tc.expect, ret, flags.F.EnableTrafficScaling)
 				if !diffBackend.isEqual() {
 					t.Errorf("Expect tc.expect == ret, however got, tc.expect = %v, ret = %v", tc.expect, ret)
 				}
@@ -261,10 +262,7 @@ func TestMergeBackends(t *testing.T) {
 }
 
 func TestDiffBackends(t *testing.T) {
-	// No t.Parallel().
-	oldFlag := flags.F.EnableTrafficScaling
-	flags.F.EnableTrafficScaling = true
-	defer func() { flags.F.EnableTrafficScaling = oldFlag }()
+	t.Parallel()
 
 	for _, tc := range []struct {
 		name string
@@ -341,9 +339,15 @@ func TestDiffBackends(t *testing.T) {
 			new:     []*composite.Backend{{Group: "a", CapacityScaler: 1.0}},
 			isEqual: true,
 		},
+		{
+			name:    "update balancing mode",
+			old:     []*composite.Backend{{Group: "a", BalancingMode: "RATE", MaxRatePerEndpoint: 1}},
+			new:     []*composite.Backend{{Group: "a", BalancingMode: "CONNECTION", MaxConnectionsPerEndpoint: 10}},
+			changed: sets.NewString("a"),
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			diff := diffBackends(tc.old, tc.new)
+			diff := diffBackends(tc.old, tc.new, true)
 			if got := diff.isEqual(); got != tc.isEqual {
 				t.Errorf("diff := diffBackends(%s, %s); diff.isEqual() = %t, want %t", pretty.Sprint(tc.old), pretty.Sprint(tc.new), got, tc.isEqual)
 			}
@@ -528,6 +532,75 @@ func TestBackendsForNEG(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "neg endpoint (backend config capacity overrides traffic policy)",
+			negs: []*composite.NetworkEndpointGroup{
+				{
+					NetworkEndpointType: string(negtypes.VmIpPortEndpointType),
+					SelfLink:            "/neg1",
+				},
+			},
+			sp: &utils.ServicePort{
+				MaxRatePerEndpoint: f64(1234),
+				CapacityScaler:     f64(0.5),
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						Capacity: &backendconfigv1.CapacityConfig{
+							MaxRatePerEndpoint: f64(100),
+						},
+					},
+				},
+			},
+			want: []*composite.Backend{
+				{
+					BalancingMode:      "RATE",
+					MaxRatePerEndpoint: 100,
+					CapacityScaler:     0.5,
+					Group:              "/neg1",
+				},
+			},
+		},
+		{
+			name: "neg endpoint (backend config rate balancing mode, zone drained)",
+			negs: []*composite.NetworkEndpointGroup{
+				{
+					NetworkEndpointType: string(negtypes.VmIpPortEndpointType),
+					SelfLink:            "https://www.googleapis.com/compute/v1/projects/mock-project/zones/us-central1-a/networkEndpointGroups/neg",
+				},
+				{
+					NetworkEndpointType: string(negtypes.VmIpPortEndpointType),
+					SelfLink:            "https://www.googleapis.com/compute/v1/projects/mock-project/zones/us-central1-b/networkEndpointGroups/neg",
+				},
+			},
+			sp: &utils.ServicePort{
+				BackendConfig: &backendconfigv1.BackendConfig{
+					Spec: backendconfigv1.BackendConfigSpec{
+						Capacity: &backendconfigv1.CapacityConfig{
+							BalancingMode:      "RATE",
+							MaxRatePerEndpoint: f64(10),
+							Zones: []backendconfigv1.ZoneCapacityConfig{
+								{Zone: "us-central1-b", CapacityScaler: f64(0)},
+							},
+						},
+					},
+				},
+			},
+			want: []*composite.Backend{
+				{
+					BalancingMode:      "RATE",
+					MaxRatePerEndpoint: 10,
+					CapacityScaler:     1.0,
+					Group:              "https://www.googleapis.com/compute/v1/projects/mock-project/zones/us-central1-a/networkEndpointGroups/neg",
+				},
+				{
+					BalancingMode:      "RATE",
+					MaxRatePerEndpoint: 10,
+					CapacityScaler:     0,
+					Group:              "https://www.googleapis.com/compute/v1/projects/mock-project/zones/us-central1-b/networkEndpointGroups/neg",
+					ForceSendFields:    []string{"CapacityScaler"},
+				},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			negUrls := []string{}
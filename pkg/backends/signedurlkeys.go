@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/backendconfig"
+	"k8s.io/ingress-gce/pkg/backends/metrics"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/klog/v2"
+)
+
+// maxSignedUrlKeys is the maximum number of signed URL keys of a backend service.
+const maxSignedUrlKeys = 3
+
+// signedUrlKeyRotationGracePeriod returns the time a signed URL key removed
+// from the BackendConfig of the service port is kept on its backend service.
+func signedUrlKeyRotationGracePeriod(sp utils.ServicePort) time.Duration {
+	if sp.BackendConfig == nil || sp.BackendConfig.Spec.Cdn == nil || sp.BackendConfig.Spec.Cdn.SignedUrlKeyRotationGracePeriodSec == nil {
+		return 0
+	}
+	return time.Duration(*sp.BackendConfig.Spec.Cdn.SignedUrlKeyRotationGracePeriodSec) * time.Second
+}
+
+// rotateBackendSignedUrlKeys adds the new signed URL keys to the backend
+// service and retires the keys removed from the BackendConfig. A retired key
+// is deleted once its grace period expired, or earlier if the backend service
+// has no room for a new key. The retiring keys are tracked in the status of
+// the BackendConfig. existingKeyNames maps the keys of the backend service to
+// whether they are in the BackendConfig.
+func (s *backendSyncer) rotateBackendSignedUrlKeys(sp utils.ServicePort, be *composite.BackendService, existingKeyNames map[string]bool, newKeys []*composite.SignedUrlKey, gracePeriod time.Duration) error {
+	status := &sp.BackendConfig.Status
+	var removedKeyNames []string
+	for keyName, found := range existingKeyNames {
+		if !found {
+			removedKeyNames = append(removedKeyNames, keyName)
+		}
+	}
+	sort.Strings(removedKeyNames)
+	if len(removedKeyNames) > 0 {
+		// The cached status may not have the keys retired by the last sync yet.
+		latest, err := s.backendConfigClient.CloudV1().BackendConfigs(sp.BackendConfig.Namespace).Get(context.TODO(), sp.BackendConfig.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		status = &latest.Status
+	}
+
+	retiring := map[string]metav1.Time{}
+	for _, key := range status.RetiringSignedUrlKeys {
+		if found, exists := existingKeyNames[key.KeyName]; key.BackendService == be.Name && exists && !found {
+			retiring[key.KeyName] = key.DeletionTime
+		}
+	}
+
+	err := func() error {
+		now := time.Now()
+		keyCount := len(existingKeyNames)
+		for _, keyName := range removedKeyNames {
+			deletionTime, ok := retiring[keyName]
+			if !ok {
+				deletionTime = metav1.NewTime(now.Add(gracePeriod))
+				retiring[keyName] = deletionTime
+				s.recordSignedUrlKeyStage(sp, metrics.SignedUrlKeyRetired, "Retired signed URL key %q of backend service %s, it is deleted after %s", keyName, be.Name, deletionTime.UTC().Format(time.RFC3339))
+			}
+			if now.Before(deletionTime.Time) {
+				continue
+			}
+			klog.V(5).Infof("Removing SignedUrlKey %q from backend %q after its grace period", keyName, be.Name)
+			if err := s.backendPool.DeleteSignedUrlKey(be, keyName); err != nil {
+				return err
+			}
+			delete(retiring, keyName)
+			keyCount--
+			s.recordSignedUrlKeyStage(sp, metrics.SignedUrlKeyDeleted, "Deleted signed URL key %q from backend service %s after its grace period", keyName, be.Name)
+		}
+
+		// Make room for the new keys by deleting the retiring keys closest to
+		// the end of their grace period.
+		for keyCount+len(newKeys) > maxSignedUrlKeys && len(retiring) > 0 {
+			keyName := oldestRetiringKey(retiring)
+			klog.V(5).Infof("Removing SignedUrlKey %q from backend %q before the end of its grace period", keyName, be.Name)
+			if err := s.backendPool.DeleteSignedUrlKey(be, keyName); err != nil {
+				return err
+			}
+			delete(retiring, keyName)
+			keyCount--
+			s.recordSignedUrlKeyStage(sp, metrics.SignedUrlKeyDeletedEarly, "Deleted signed URL key %q from backend service %s before the end of its grace period, a backend service has at most %d keys", keyName, be.Name, maxSignedUrlKeys)
+		}
+
+		for _, key := range newKeys {
+			klog.V(5).Infof("Adding SignedUrlKey %q to backend %q", key.KeyName, be.Name)
+			if err := s.backendPool.AddSignedUrlKey(be, key); err != nil {
+				return err
+			}
+			s.recordSignedUrlKeyStage(sp, metrics.SignedUrlKeyAdded, "Added signed URL key %q to backend service %s", key.KeyName, be.Name)
+		}
+		return nil
+	}()
+
+	// The retiring keys are recorded even if the rotation failed, so that
+	// their grace period is not extended.
+	if statusErr := s.setRetiringSignedUrlKeys(sp, be.Name, retiring); statusErr != nil && err == nil {
+		err = fmt.Errorf("failed to update status of BackendConfig %s/%s: %w", sp.BackendConfig.Namespace, sp.BackendConfig.Name, statusErr)
+	}
+	return err
+}
+
+// oldestRetiringKey returns the name of the retiring key with the earliest
+// deletion time.
+func oldestRetiringKey(retiring map[string]metav1.Time) string {
+	var oldest string
+	for keyName, deletionTime := range retiring {
+		oldestTime := retiring[oldest].Time
+		if oldest == "" || deletionTime.Time.Before(oldestTime) || (deletionTime.Time.Equal(oldestTime) && keyName < oldest) {
+			oldest = keyName
+		}
+	}
+	return oldest
+}
+
+// setRetiringSignedUrlKeys replaces the retiring signed URL keys of the
+// backend service in the status of the BackendConfig of the service port.
+func (s *backendSyncer) setRetiringSignedUrlKeys(sp utils.ServicePort, beName string, retiring map[string]metav1.Time) error {
+	if s.backendConfigClient == nil || sp.BackendConfig == nil {
+		return nil
+	}
+	return backendconfig.UpdateStatus(s.backendConfigClient, sp.BackendConfig, func(status *backendconfigv1.BackendConfigStatus) {
+		var keys []backendconfigv1.RetiringSignedUrlKey
+		for _, key := range status.RetiringSignedUrlKeys {
+			if key.BackendService != beName {
+				keys = append(keys, key)
+			}
+		}
+		for keyName, deletionTime := range retiring {
+			keys = append(keys, backendconfigv1.RetiringSignedUrlKey{BackendService: beName, KeyName: keyName, DeletionTime: deletionTime})
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].BackendService != keys[j].BackendService {
+				return keys[i].BackendService < keys[j].BackendService
+			}
+			return keys[i].KeyName < keys[j].KeyName
+		})
+		status.RetiringSignedUrlKeys = keys
+	})
+}
+
+// recordSignedUrlKeyStage counts a signed URL key reaching a stage of its
+// rotation and emits an event for the BackendConfig of the service port.
+func (s *backendSyncer) recordSignedUrlKeyStage(sp utils.ServicePort, stage string, messageFmt string, args ...interface{}) {
+	metrics.PublishSignedUrlKeyRotationMetrics(stage)
+	if s.recorders == nil || sp.BackendConfig == nil {
+		return
+	}
+	eventType, reason := apiv1.EventTypeNormal, events.SignedUrlKeyAdded
+	switch stage {
+	case metrics.SignedUrlKeyRetired:
+		reason = events.SignedUrlKeyRetired
+	case metrics.SignedUrlKeyDeleted:
+		reason = events.SignedUrlKeyDeleted
+	case metrics.SignedUrlKeyDeletedEarly:
+		eventType, reason = apiv1.EventTypeWarning, events.SignedUrlKeyDeleted
+	}
+	s.recorders.Recorder(sp.BackendConfig.Namespace).Eventf(sp.BackendConfig, eventType, reason, messageFmt, args...)
+}
@@ -19,10 +19,17 @@ import (
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/cloud-provider-gcp/providers/gce"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/backendconfig"
+	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned"
 	"k8s.io/ingress-gce/pkg/backends/features"
+	"k8s.io/ingress-gce/pkg/backends/metrics"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/healthchecks"
 	lbfeatures "k8s.io/ingress-gce/pkg/loadbalancers/features"
 	"k8s.io/ingress-gce/pkg/utils"
@@ -35,6 +42,11 @@ type backendSyncer struct {
 	healthChecker healthchecks.HealthChecker
 	prober        ProbeProvider
 	cloud         *gce.Cloud
+	// backendConfigClient is used to update the status of BackendConfigs.
+	// It may be nil, in which case the status is not updated.
+	backendConfigClient backendconfigclient.Interface
+	// recorders emit events for BackendConfigs. It may be nil.
+	recorders events.RecorderProducer
 }
 
 // backendSyncer is a Syncer
@@ -43,11 +55,16 @@ var _ Syncer = (*backendSyncer)(nil)
 func NewBackendSyncer(
 	backendPool Pool,
 	healthChecker healthchecks.HealthChecker,
-	cloud *gce.Cloud) Syncer {
+	cloud *gce.Cloud,
+	backendConfigClient backendconfigclient.Interface,
+	recorders events.RecorderProducer) Syncer {
+	metrics.RegisterMetrics()
 	return &backendSyncer{
-		backendPool:   backendPool,
-		healthChecker: healthChecker,
-		cloud:         cloud,
+		backendPool:         backendPool,
+		healthChecker:       healthChecker,
+		cloud:               cloud,
+		backendConfigClient: backendConfigClient,
+		recorders:           recorders,
 	}
 }
 
@@ -110,6 +127,8 @@ func (s *backendSyncer) ensureBackendService(sp utils.ServicePort) error {
 		needUpdate = features.EnsureCustomRequestHeaders(sp, be) || needUpdate
 		needUpdate = features.EnsureCustomResponseHeaders(sp, be) || needUpdate
 		needUpdate = features.EnsureLogging(sp, be) || needUpdate
+		needUpdate = features.EnsureCircuitBreakers(sp, be) || needUpdate
+		needUpdate = features.EnsureOutlierDetection(sp, be) || needUpdate
 	}
 
 	if needUpdate {
@@ -127,7 +146,18 @@ func (s *backendSyncer) ensureBackendService(sp utils.ServicePort) error {
 		// available. meta.Key is not needed as security policy supported only for
 		// global backends.
 		be.Scope = scope
+		// The security policy and the edge security policy are reconciled
+		// independently, so a failure of one does not block the other.
+		var errList []error
 		if err := features.EnsureSecurityPolicy(s.cloud, sp, be); err != nil {
+			errList = append(errList, err)
+		}
+		if err := features.EnsureEdgeSecurityPolicy(s.cloud, sp, be); err != nil {
+			errList = append(errList, err)
+		}
+		err := utilerrors.NewAggregate(errList)
+		s.updateSecurityPolicyCondition(sp, err)
+		if err != nil {
 			return err
 		}
 	}
@@ -135,6 +165,42 @@ func (s *backendSyncer) ensureBackendService(sp utils.ServicePort) error {
 	return nil
 }
 
+// updateSecurityPolicyCondition sets the SecurityPolicyAttached condition of
+// the BackendConfig of the service port from the result of attaching its
+// security policies. Failures to update the status are only logged.
+func (s *backendSyncer) updateSecurityPolicyCondition(sp utils.ServicePort, attachErr error) {
+	spec := sp.BackendConfig.Spec
+	if s.backendConfigClient == nil || (spec.SecurityPolicy == nil && spec.EdgeSecurityPolicy == nil) {
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:    string(backendconfigv1.BackendConfigConditionSecurityPolicyAttached),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(backendconfigv1.BackendConfigReasonSecurityPolicyAttached),
+		Message: "Security policies are attached",
+	}
+	if attachErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(backendconfigv1.BackendConfigReasonSecurityPolicyFailed)
+		condition.Message = attachErr.Error()
+		for _, policy := range []*backendconfigv1.SecurityPolicyConfig{spec.SecurityPolicy, spec.EdgeSecurityPolicy} {
+			if policy == nil || policy.Name == "" {
+				continue
+			}
+			if exists, err := features.SecurityPolicyExists(s.cloud, policy.Name); err == nil && !exists {
+				condition.Reason = string(backendconfigv1.BackendConfigReasonSecurityPolicyNotFound)
+				condition.Message = fmt.Sprintf("Security policy %q does not exist", policy.Name)
+				break
+			}
+		}
+	}
+
+	if err := backendconfig.SetCondition(s.backendConfigClient, sp.BackendConfig, condition); err != nil {
+		klog.Errorf("Failed to update status of BackendConfig %s/%s: %v", sp.BackendConfig.Namespace, sp.BackendConfig.Name, err)
+	}
+}
+
 func (s *backendSyncer) ensureBackendSignedUrlKeys(sp utils.ServicePort, be *composite.BackendService) error {
 
 	existingKeyNames := map[string]bool{}
@@ -157,6 +223,10 @@ func (s *backendSyncer) ensureBackendSignedUrlKeys(sp utils.ServicePort, be *com
 			}
 		}
 	}
+	// keys removed from the BackendConfig are kept for the rotation grace period
+	if gracePeriod := signedUrlKeyRotationGracePeriod(sp); gracePeriod > 0 && s.backendConfigClient != nil {
+		return s.rotateBackendSignedUrlKeys(sp, be, existingKeyNames, newSignedUrlKeys, gracePeriod)
+	}
 	// delete all removed keys
 	for keyName, found := range existingKeyNames {
 		if !found {
@@ -164,6 +234,7 @@ func (s *backendSyncer) ensureBackendSignedUrlKeys(sp utils.ServicePort, be *com
 			if err := s.backendPool.DeleteSignedUrlKey(be, keyName); err != nil {
 				return err
 			}
+			s.recordSignedUrlKeyStage(sp, metrics.SignedUrlKeyDeleted, "Deleted signed URL key %q from backend service %s", keyName, be.Name)
 		}
 	}
 	// add all appended keys
@@ -172,6 +243,11 @@ func (s *backendSyncer) ensureBackendSignedUrlKeys(sp utils.ServicePort, be *com
 		if err := s.backendPool.AddSignedUrlKey(be, key); err != nil {
 			return err
 		}
+		s.recordSignedUrlKeyStage(sp, metrics.SignedUrlKeyAdded, "Added signed URL key %q to backend service %s", key.KeyName, be.Name)
+	}
+	// forget the keys of an interrupted rotation
+	if err := s.setRetiringSignedUrlKeys(sp, be.Name, nil); err != nil {
+		klog.Errorf("Failed to update status of BackendConfig %s/%s: %v", sp.BackendConfig.Namespace, sp.BackendConfig.Name, err)
 	}
 	return nil
 }
@@ -302,10 +378,10 @@ func getHealthCheckLink(be *composite.BackendService) string {
 
 // ensureProtocol updates the BackendService Protocol with the expected value
 func ensureProtocol(be *composite.BackendService, p utils.ServicePort) (needsUpdate bool) {
-	if be.Protocol == string(p.Protocol) {
+	if be.Protocol == p.Protocol.BackendServiceProtocol() {
 		return false
 	}
-	be.Protocol = string(p.Protocol)
+	be.Protocol = p.Protocol.BackendServiceProtocol()
 	return true
 }
 
@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
@@ -31,13 +33,17 @@ import (
 	"google.golang.org/api/googleapi"
 	api_v1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned/fake"
 	"k8s.io/ingress-gce/pkg/backends/features"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/healthchecks"
+	"k8s.io/ingress-gce/pkg/test"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/namer"
 	"k8s.io/klog/v2"
@@ -294,6 +300,35 @@ func TestSyncUpdateHTTP2(t *testing.T) {
 	}
 }
 
+func TestSyncUpdateGRPC(t *testing.T) {
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	syncer := newTestSyncer(fakeGCE)
+
+	p := utils.ServicePort{NodePort: 3000, Protocol: annotations.ProtocolHTTP2, BackendNamer: defaultNamer}
+	syncer.Sync([]utils.ServicePort{p})
+	beName := p.BackendName()
+
+	// Update service port to GRPC
+	p.Protocol = annotations.ProtocolGRPC
+	syncer.Sync([]utils.ServicePort{p})
+
+	be, err := syncer.backendPool.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p))
+	if err != nil {
+		t.Fatalf("Unexpected err retrieving backend service after update: %v", err)
+	}
+
+	// gRPC is served by HTTP2 backend services.
+	if be.Protocol != string(annotations.ProtocolHTTP2) {
+		t.Fatalf("Expected scheme %v but got %v", annotations.ProtocolHTTP2, be.Protocol)
+	}
+
+	// Assert a GRPC health check was created
+	hc, _ := syncer.healthChecker.Get(beName, features.VersionFromServicePort(&p), features.ScopeFromServicePort(&p))
+	if hc == nil || hc.Protocol() != annotations.ProtocolGRPC {
+		t.Fatalf("Expected %s health check, received %v: ", annotations.ProtocolGRPC, hc)
+	}
+}
+
 // Test GC with both ELB and ILBs
 func TestGC(t *testing.T) {
 	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
@@ -684,3 +719,222 @@ func TestEnsureBackendServiceHealthCheckLink(t *testing.T) {
 		t.Fatalf("Expected ensureHealthCheckLink for healthcheck with the same name to return false, got %v", needsHcUpdate)
 	}
 }
+
+// addSignedUrlKeyHook adds the key name to the CDN policy of the backend service.
+func addSignedUrlKeyHook(ctx context.Context, key *meta.Key, signedUrlKey *compute.SignedUrlKey, m *cloud.MockBackendServices) error {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	obj, ok := m.Objects[*key]
+	if !ok {
+		return fmt.Errorf("backend service %s not found", key)
+	}
+	be := obj.ToGA()
+	if be.CdnPolicy == nil {
+		be.CdnPolicy = &compute.BackendServiceCdnPolicy{}
+	}
+	be.CdnPolicy.SignedUrlKeyNames = append(be.CdnPolicy.SignedUrlKeyNames, signedUrlKey.KeyName)
+	m.Objects[*key] = &cloud.MockBackendServicesObj{Obj: be}
+	return nil
+}
+
+// deleteSignedUrlKeyHook removes the key name from the CDN policy of the backend service.
+func deleteSignedUrlKeyHook(ctx context.Context, key *meta.Key, keyName string, m *cloud.MockBackendServices) error {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	obj, ok := m.Objects[*key]
+	if !ok {
+		return fmt.Errorf("backend service %s not found", key)
+	}
+	be := obj.ToGA()
+	var keyNames []string
+	for _, name := range be.CdnPolicy.SignedUrlKeyNames {
+		if name != keyName {
+			keyNames = append(keyNames, name)
+		}
+	}
+	be.CdnPolicy.SignedUrlKeyNames = keyNames
+	m.Objects[*key] = &cloud.MockBackendServicesObj{Obj: be}
+	return nil
+}
+
+func TestSyncSignedUrlKeyRotation(t *testing.T) {
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	(fakeGCE.Compute().(*cloud.MockGCE)).MockBackendServices.AddSignedUrlKeyHook = addSignedUrlKeyHook
+	(fakeGCE.Compute().(*cloud.MockGCE)).MockBackendServices.DeleteSignedUrlKeyHook = deleteSignedUrlKeyHook
+
+	gracePeriod := int64(3600)
+	beConfig := &backendconfigv1.BackendConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cdn"},
+		Spec: backendconfigv1.BackendConfigSpec{
+			Cdn: &backendconfigv1.CDNConfig{
+				Enabled:                            true,
+				SignedUrlKeyRotationGracePeriodSec: &gracePeriod,
+			},
+		},
+	}
+	backendConfigClient := backendconfigclient.NewSimpleClientset(beConfig)
+	recorders := healthchecks.NewFakeSingletonRecorderGetter(20)
+	syncer := newTestSyncer(fakeGCE)
+	syncer.backendConfigClient = backendConfigClient
+	syncer.recorders = recorders
+
+	// staleCache syncs with the BackendConfig without its latest status, like
+	// when the informer lags behind.
+	staleCache := false
+	// sync syncs the service port with the given keys and returns the keys of
+	// the backend service and the retiring keys.
+	sync := func(keyNames ...string) ([]string, []backendconfigv1.RetiringSignedUrlKey) {
+		t.Helper()
+		config := beConfig.DeepCopy()
+		if !staleCache {
+			latest, err := backendConfigClient.CloudV1().BackendConfigs("default").Get(context.TODO(), "cdn", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Get() = %v", err)
+			}
+			config.Status = latest.Status
+		}
+		config.Spec.Cdn.SignedUrlKeys = nil
+		for _, keyName := range keyNames {
+			config.Spec.Cdn.SignedUrlKeys = append(config.Spec.Cdn.SignedUrlKeys, &backendconfigv1.SignedUrlKey{KeyName: keyName, KeyValue: "value-" + keyName})
+		}
+		sp := utils.ServicePort{NodePort: 80, Protocol: annotations.ProtocolHTTP, BackendNamer: defaultNamer, BackendConfig: config}
+		if err := syncer.Sync([]utils.ServicePort{sp}); err != nil {
+			t.Fatalf("Sync() = %v", err)
+		}
+		be, err := composite.GetBackendService(fakeGCE, meta.GlobalKey(sp.BackendName()), meta.VersionGA, klog.TODO())
+		if err != nil {
+			t.Fatalf("GetBackendService() = %v", err)
+		}
+		latest, err := backendConfigClient.CloudV1().BackendConfigs("default").Get(context.TODO(), "cdn", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Get() = %v", err)
+		}
+		return be.CdnPolicy.SignedUrlKeyNames, latest.Status.RetiringSignedUrlKeys
+	}
+	events := func() []string {
+		var got []string
+		for len(recorders.FakeRecorder().Events) > 0 {
+			got = append(got, <-recorders.FakeRecorder().Events)
+		}
+		return got
+	}
+	retiringKeyNames := func(retiring []backendconfigv1.RetiringSignedUrlKey) []string {
+		var keyNames []string
+		for _, key := range retiring {
+			keyNames = append(keyNames, key.KeyName)
+		}
+		return keyNames
+	}
+
+	keyNames, retiring := sync("key-1")
+	if !reflect.DeepEqual(keyNames, []string{"key-1"}) || len(retiring) != 0 {
+		t.Errorf("Got keys %v and retiring keys %+v, want [key-1] and none", keyNames, retiring)
+	}
+	if got := events(); len(got) != 1 || !strings.Contains(got[0], "SignedUrlKeyAdded") {
+		t.Errorf("Got events %v, want a SignedUrlKeyAdded event", got)
+	}
+
+	// Rotating keeps the old key for the grace period.
+	start := time.Now()
+	keyNames, retiring = sync("key-2")
+	if !reflect.DeepEqual(keyNames, []string{"key-1", "key-2"}) {
+		t.Errorf("Got keys %v, want [key-1 key-2]", keyNames)
+	}
+	if len(retiring) != 1 || retiring[0].KeyName != "key-1" || retiring[0].DeletionTime.Time.Before(start.Add(time.Hour).Truncate(time.Second)) {
+		t.Errorf("Got retiring keys %+v, want key-1 retiring in an hour", retiring)
+	}
+	if got := events(); len(got) != 2 {
+		t.Errorf("Got events %v, want a SignedUrlKeyRetired and a SignedUrlKeyAdded event", got)
+	}
+
+	// Syncing again neither extends the grace period nor retires the key again.
+	deletionTime := retiring[0].DeletionTime
+	staleCache = true
+	keyNames, retiring = sync("key-2")
+	staleCache = false
+	if !reflect.DeepEqual(keyNames, []string{"key-1", "key-2"}) || len(retiring) != 1 || !retiring[0].DeletionTime.Equal(&deletionTime) {
+		t.Errorf("Got keys %v and retiring keys %+v, want the grace period of key-1 to be unchanged", keyNames, retiring)
+	}
+	if got := events(); len(got) != 0 {
+		t.Errorf("Got events %v, want none", got)
+	}
+
+	// The old key is deleted once the grace period expired.
+	latest, err := backendConfigClient.CloudV1().BackendConfigs("default").Get(context.TODO(), "cdn", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	latest.Status.RetiringSignedUrlKeys[0].DeletionTime = metav1.NewTime(start.Add(-time.Minute))
+	if _, err := backendConfigClient.CloudV1().BackendConfigs("default").UpdateStatus(context.TODO(), latest, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("UpdateStatus() = %v", err)
+	}
+	keyNames, retiring = sync("key-2")
+	if !reflect.DeepEqual(keyNames, []string{"key-2"}) || len(retiring) != 0 {
+		t.Errorf("Got keys %v and retiring keys %+v, want [key-2] and none", keyNames, retiring)
+	}
+	if got := events(); len(got) != 1 || !strings.Contains(got[0], "SignedUrlKeyDeleted") {
+		t.Errorf("Got events %v, want a SignedUrlKeyDeleted event", got)
+	}
+
+	// Retiring keys are deleted early to make room for new keys, oldest first.
+	sync("key-3")
+	sync("key-4")
+	events()
+	keyNames, retiring = sync("key-5")
+	if !reflect.DeepEqual(keyNames, []string{"key-3", "key-4", "key-5"}) {
+		t.Errorf("Got keys %v, want [key-3 key-4 key-5]", keyNames)
+	}
+	if got := retiringKeyNames(retiring); !reflect.DeepEqual(got, []string{"key-3", "key-4"}) {
+		t.Errorf("Got retiring keys %v, want [key-3 key-4]", got)
+	}
+	if got := events(); len(got) != 3 || !strings.HasPrefix(got[1], api_v1.EventTypeWarning) {
+		t.Errorf("Got events %v, want a warning for the early deletion of key-2", got)
+	}
+
+	// A key added back to the BackendConfig is no longer retiring.
+	keyNames, retiring = sync("key-4", "key-5")
+	if !reflect.DeepEqual(keyNames, []string{"key-3", "key-4", "key-5"}) {
+		t.Errorf("Got keys %v, want [key-3 key-4 key-5]", keyNames)
+	}
+	if got := retiringKeyNames(retiring); !reflect.DeepEqual(got, []string{"key-3"}) {
+		t.Errorf("Got retiring keys %v, want [key-3]", got)
+	}
+
+	// Without a grace period, removed keys are deleted immediately.
+	beConfig.Spec.Cdn.SignedUrlKeyRotationGracePeriodSec = nil
+	keyNames, retiring = sync("key-5")
+	if !reflect.DeepEqual(keyNames, []string{"key-5"}) || len(retiring) != 0 {
+		t.Errorf("Got keys %v and retiring keys %+v, want [key-5] and none", keyNames, retiring)
+	}
+}
+
+func TestSyncSecurityPoliciesIndependently(t *testing.T) {
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	test.NewFakeComputeAPI(t, fakeGCE)
+	(fakeGCE.Compute().(*cloud.MockGCE)).MockBackendServices.SetSecurityPolicyHook = func(_ context.Context, _ *meta.Key, _ *compute.SecurityPolicyReference, _ *cloud.MockBackendServices) error {
+		return fmt.Errorf("security policy not found")
+	}
+	syncer := newTestSyncer(fakeGCE)
+
+	sp := utils.ServicePort{NodePort: 80, Protocol: annotations.ProtocolHTTP, BackendNamer: defaultNamer, BackendConfig: &backendconfigv1.BackendConfig{
+		Spec: backendconfigv1.BackendConfigSpec{
+			Cdn:                &backendconfigv1.CDNConfig{Enabled: true},
+			SecurityPolicy:     &backendconfigv1.SecurityPolicyConfig{Name: "policy"},
+			EdgeSecurityPolicy: &backendconfigv1.SecurityPolicyConfig{Name: "edge-policy"},
+		},
+	}}
+	err := syncer.Sync([]utils.ServicePort{sp})
+	if err == nil || !strings.Contains(err.Error(), "security policy not found") {
+		t.Errorf("Sync() = %v, want the error of the security policy", err)
+	}
+
+	// The edge security policy is attached although the security policy is not.
+	be, err := fakeGCE.Compute().BackendServices().Get(context.TODO(), meta.GlobalKey(sp.BackendName()))
+	if err != nil {
+		t.Fatalf("BackendServices().Get() = %v", err)
+	}
+	wantEdgePolicy := cloud.SelfLink(meta.VersionGA, fakeGCE.ProjectID(), "securityPolicies", meta.GlobalKey("edge-policy"))
+	if be.EdgeSecurityPolicy != wantEdgePolicy {
+		t.Errorf("EdgeSecurityPolicy = %q, want %q", be.EdgeSecurityPolicy, wantEdgePolicy)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheinvalidation
+
+import (
+	"fmt"
+	"strings"
+
+	apiscacheinvalidation "k8s.io/ingress-gce/pkg/apis/cacheinvalidation"
+	cachev1beta1 "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1"
+	"k8s.io/ingress-gce/pkg/crd"
+)
+
+func CRDMeta() *crd.CRDMeta {
+	meta := crd.NewCRDMeta(
+		apiscacheinvalidation.GroupName,
+		"CacheInvalidation",
+		"CacheInvalidationList",
+		"cacheinvalidation",
+		"cacheinvalidations",
+		[]*crd.Version{
+			crd.NewVersion("v1beta1", "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1.CacheInvalidation", cachev1beta1.GetOpenAPIDefinitions, false),
+		},
+	)
+	return meta.WithStatusSubresource()
+}
+
+// Validate returns an error if the spec of the CacheInvalidation is invalid.
+func Validate(ci *cachev1beta1.CacheInvalidation) error {
+	if ci.Spec.Ingress == "" {
+		return fmt.Errorf("ingress must be set")
+	}
+	if strings.ContainsAny(ci.Spec.Host, "/*") {
+		return fmt.Errorf("invalid host %q, should be a host name without a path", ci.Spec.Host)
+	}
+	if len(ci.Spec.Paths) == 0 {
+		return fmt.Errorf("at least one path must be set")
+	}
+	for _, path := range ci.Spec.Paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("invalid path %q, should start with /", path)
+		}
+		if strings.ContainsAny(path, "?#") {
+			return fmt.Errorf("invalid path %q, should not contain a query or a fragment", path)
+		}
+		if i := strings.Index(path, "*"); i >= 0 && i != len(path)-1 {
+			return fmt.Errorf("invalid path %q, * is only allowed at the end", path)
+		}
+	}
+	return nil
+}
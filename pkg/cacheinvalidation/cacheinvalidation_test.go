@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheinvalidation
+
+import (
+	"testing"
+
+	cachev1beta1 "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		spec    cachev1beta1.CacheInvalidationSpec
+		wantErr bool
+	}{
+		{
+			desc: "valid",
+			spec: cachev1beta1.CacheInvalidationSpec{Ingress: "ing", Host: "example.com", Paths: []string{"/index.html", "/static/*"}},
+		},
+		{
+			desc: "all hosts",
+			spec: cachev1beta1.CacheInvalidationSpec{Ingress: "ing", Paths: []string{"/*"}},
+		},
+		{
+			desc:    "no ingress",
+			spec:    cachev1beta1.CacheInvalidationSpec{Paths: []string{"/"}},
+			wantErr: true,
+		},
+		{
+			desc:    "no paths",
+			spec:    cachev1beta1.CacheInvalidationSpec{Ingress: "ing"},
+			wantErr: true,
+		},
+		{
+			desc:    "relative path",
+			spec:    cachev1beta1.CacheInvalidationSpec{Ingress: "ing", Paths: []string{"index.html"}},
+			wantErr: true,
+		},
+		{
+			desc:    "path with query",
+			spec:    cachev1beta1.CacheInvalidationSpec{Ingress: "ing", Paths: []string{"/index.html?v=1"}},
+			wantErr: true,
+		},
+		{
+			desc:    "wildcard in the middle",
+			spec:    cachev1beta1.CacheInvalidationSpec{Ingress: "ing", Paths: []string{"/*/index.html"}},
+			wantErr: true,
+		},
+		{
+			desc:    "host with path",
+			spec:    cachev1beta1.CacheInvalidationSpec{Ingress: "ing", Host: "example.com/static", Paths: []string{"/"}},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := Validate(&cachev1beta1.CacheInvalidation{Spec: tc.spec})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Validate() = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1beta1 "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1"
+)
+
+// FakeCacheInvalidations implements CacheInvalidationInterface
+type FakeCacheInvalidations struct {
+	Fake *FakeNetworkingV1beta1
+	ns   string
+}
+
+var cacheinvalidationsResource = schema.GroupVersionResource{Group: "networking.gke.io", Version: "v1beta1", Resource: "cacheinvalidations"}
+
+var cacheinvalidationsKind = schema.GroupVersionKind{Group: "networking.gke.io", Version: "v1beta1", Kind: "CacheInvalidation"}
+
+// Get takes name of the cacheInvalidation, and returns the corresponding cacheInvalidation object, and an error if there is any.
+func (c *FakeCacheInvalidations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.CacheInvalidation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(cacheinvalidationsResource, c.ns, name), &v1beta1.CacheInvalidation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CacheInvalidation), err
+}
+
+// List takes label and field selectors, and returns the list of CacheInvalidations that match those selectors.
+func (c *FakeCacheInvalidations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.CacheInvalidationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(cacheinvalidationsResource, cacheinvalidationsKind, c.ns, opts), &v1beta1.CacheInvalidationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.CacheInvalidationList{ListMeta: obj.(*v1beta1.CacheInvalidationList).ListMeta}
+	for _, item := range obj.(*v1beta1.CacheInvalidationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested cacheInvalidations.
+func (c *FakeCacheInvalidations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(cacheinvalidationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a cacheInvalidation and creates it.  Returns the server's representation of the cacheInvalidation, and an error, if there is any.
+func (c *FakeCacheInvalidations) Create(ctx context.Context, cacheInvalidation *v1beta1.CacheInvalidation, opts v1.CreateOptions) (result *v1beta1.CacheInvalidation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(cacheinvalidationsResource, c.ns, cacheInvalidation), &v1beta1.CacheInvalidation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CacheInvalidation), err
+}
+
+// Update takes the representation of a cacheInvalidation and updates it. Returns the server's representation of the cacheInvalidation, and an error, if there is any.
+func (c *FakeCacheInvalidations) Update(ctx context.Context, cacheInvalidation *v1beta1.CacheInvalidation, opts v1.UpdateOptions) (result *v1beta1.CacheInvalidation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(cacheinvalidationsResource, c.ns, cacheInvalidation), &v1beta1.CacheInvalidation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CacheInvalidation), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeCacheInvalidations) UpdateStatus(ctx context.Context, cacheInvalidation *v1beta1.CacheInvalidation, opts v1.UpdateOptions) (*v1beta1.CacheInvalidation, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(cacheinvalidationsResource, "status", c.ns, cacheInvalidation), &v1beta1.CacheInvalidation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CacheInvalidation), err
+}
+
+// Delete takes name of the cacheInvalidation and deletes it. Returns an error if one occurs.
+func (c *FakeCacheInvalidations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(cacheinvalidationsResource, c.ns, name), &v1beta1.CacheInvalidation{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeCacheInvalidations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(cacheinvalidationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.CacheInvalidationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched cacheInvalidation.
+func (c *FakeCacheInvalidations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.CacheInvalidation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(cacheinvalidationsResource, c.ns, name, pt, data, subresources...), &v1beta1.CacheInvalidation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CacheInvalidation), err
+}
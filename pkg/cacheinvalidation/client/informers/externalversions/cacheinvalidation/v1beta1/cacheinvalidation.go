@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+	cacheinvalidationv1beta1 "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1"
+	versioned "k8s.io/ingress-gce/pkg/cacheinvalidation/client/clientset/versioned"
+	internalinterfaces "k8s.io/ingress-gce/pkg/cacheinvalidation/client/informers/externalversions/internalinterfaces"
+	v1beta1 "k8s.io/ingress-gce/pkg/cacheinvalidation/client/listers/cacheinvalidation/v1beta1"
+)
+
+// CacheInvalidationInformer provides access to a shared informer and lister for
+// CacheInvalidations.
+type CacheInvalidationInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.CacheInvalidationLister
+}
+
+type cacheInvalidationInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewCacheInvalidationInformer constructs a new informer for CacheInvalidation type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewCacheInvalidationInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredCacheInvalidationInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredCacheInvalidationInformer constructs a new informer for CacheInvalidation type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredCacheInvalidationInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetworkingV1beta1().CacheInvalidations(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetworkingV1beta1().CacheInvalidations(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&cacheinvalidationv1beta1.CacheInvalidation{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *cacheInvalidationInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredCacheInvalidationInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *cacheInvalidationInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&cacheinvalidationv1beta1.CacheInvalidation{}, f.defaultInformer)
+}
+
+func (f *cacheInvalidationInformer) Lister() v1beta1.CacheInvalidationLister {
+	return v1beta1.NewCacheInvalidationLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	v1beta1 "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1"
+)
+
+// CacheInvalidationLister helps list CacheInvalidations.
+// All objects returned here must be treated as read-only.
+type CacheInvalidationLister interface {
+	// List lists all CacheInvalidations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.CacheInvalidation, err error)
+	// CacheInvalidations returns an object that can list and get CacheInvalidations.
+	CacheInvalidations(namespace string) CacheInvalidationNamespaceLister
+	CacheInvalidationListerExpansion
+}
+
+// cacheInvalidationLister implements the CacheInvalidationLister interface.
+type cacheInvalidationLister struct {
+	indexer cache.Indexer
+}
+
+// NewCacheInvalidationLister returns a new CacheInvalidationLister.
+func NewCacheInvalidationLister(indexer cache.Indexer) CacheInvalidationLister {
+	return &cacheInvalidationLister{indexer: indexer}
+}
+
+// List lists all CacheInvalidations in the indexer.
+func (s *cacheInvalidationLister) List(selector labels.Selector) (ret []*v1beta1.CacheInvalidation, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.CacheInvalidation))
+	})
+	return ret, err
+}
+
+// CacheInvalidations returns an object that can list and get CacheInvalidations.
+func (s *cacheInvalidationLister) CacheInvalidations(namespace string) CacheInvalidationNamespaceLister {
+	return cacheInvalidationNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// CacheInvalidationNamespaceLister helps list and get CacheInvalidations.
+// All objects returned here must be treated as read-only.
+type CacheInvalidationNamespaceLister interface {
+	// List lists all CacheInvalidations in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.CacheInvalidation, err error)
+	// Get retrieves the CacheInvalidation from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.CacheInvalidation, error)
+	CacheInvalidationNamespaceListerExpansion
+}
+
+// cacheInvalidationNamespaceLister implements the CacheInvalidationNamespaceLister
+// interface.
+type cacheInvalidationNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all CacheInvalidations in the indexer for a given namespace.
+func (s cacheInvalidationNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.CacheInvalidation, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.CacheInvalidation))
+	})
+	return ret, err
+}
+
+// Get retrieves the CacheInvalidation from the indexer for a given namespace and name.
+func (s cacheInvalidationNamespaceLister) Get(name string) (*v1beta1.CacheInvalidation, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("cacheinvalidation"), name)
+	}
+	return obj.(*v1beta1.CacheInvalidation), nil
+}
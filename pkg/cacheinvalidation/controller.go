@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheinvalidation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	apiv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/ingress-gce/pkg/annotations"
+	cachev1beta1 "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1"
+	cacheinvalidationclient "k8s.io/ingress-gce/pkg/cacheinvalidation/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/composite"
+	ingctx "k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/loadbalancers/features"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/common"
+	"k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/klog/v2"
+)
+
+// Invalidations in progress are polled with a backoff between these delays.
+const (
+	minPollDelay = 5 * time.Second
+	maxPollDelay = 5 * time.Minute
+)
+
+// errInProgress requeues a CacheInvalidation whose invalidations have not
+// completed yet.
+var errInProgress = errors.New("cache invalidation in progress")
+
+// Controller watches CacheInvalidations and invalidates the cached content of
+// the URL maps of the Ingresses they reference. A CacheInvalidation is
+// processed once per generation.
+type Controller struct {
+	ctx    *ingctx.ControllerContext
+	client cacheinvalidationclient.Interface
+
+	lister       cache.Indexer
+	ingLister    cache.Indexer
+	namerFactory namer.IngressFrontendNamerFactory
+	queue        utils.TaskQueue
+	hasSynced    func() bool
+}
+
+// NewController returns a CacheInvalidation controller.
+func NewController(ctx *ingctx.ControllerContext) *Controller {
+	c := &Controller{
+		ctx:          ctx,
+		client:       ctx.CacheInvalidationClient,
+		lister:       ctx.CacheInvalidationInformer.GetIndexer(),
+		ingLister:    ctx.IngressInformer.GetIndexer(),
+		namerFactory: namer.NewFrontendNamerFactory(ctx.ClusterNamer, ctx.KubeSystemUID),
+		hasSynced:    ctx.HasSynced,
+	}
+	c.queue = utils.NewPeriodicTaskQueueWithLimiter("cacheinvalidation", "cacheinvalidations", c.sync,
+		workqueue.NewItemExponentialFailureRateLimiter(minPollDelay, maxPollDelay))
+
+	ctx.CacheInvalidationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.queue.Enqueue(obj)
+		},
+		UpdateFunc: func(old, cur interface{}) {
+			// Skip updates of the status, which are written by this controller.
+			if old.(*cachev1beta1.CacheInvalidation).Generation == cur.(*cachev1beta1.CacheInvalidation).Generation {
+				return
+			}
+			c.queue.Enqueue(cur)
+		},
+	})
+	return c
+}
+
+// Run waits for the initial sync and processes CacheInvalidations until signaled.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	wait.PollUntil(5*time.Second, func() (bool, error) {
+		klog.V(2).Infof("Waiting for initial sync")
+		return c.hasSynced(), nil
+	}, stopCh)
+
+	klog.V(2).Infof("Starting CacheInvalidation controller")
+	defer func() {
+		klog.V(2).Infof("Shutting down CacheInvalidation controller")
+		c.queue.Shutdown()
+	}()
+
+	go c.queue.Run()
+	<-stopCh
+}
+
+// sync starts the invalidations of the CacheInvalidation with the given key
+// and records their progress in its status. It returns an error to be
+// requeued until all invalidations completed.
+func (c *Controller) sync(key string) error {
+	obj, exists, err := c.lister.GetByKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to lookup CacheInvalidation %q: %v", key, err)
+	}
+	if !exists {
+		return nil
+	}
+	ci := obj.(*cachev1beta1.CacheInvalidation)
+	status := ci.Status.DeepCopy()
+
+	complete := apimeta.FindStatusCondition(status.Conditions, string(cachev1beta1.CacheInvalidationConditionComplete))
+	if complete == nil || complete.ObservedGeneration != ci.Generation {
+		// The spec changed, invalidate again.
+		status.UrlMap = ""
+		status.Operations = nil
+		status.CompletionTime = nil
+	} else if complete.Status == metav1.ConditionTrue {
+		return nil
+	}
+
+	if err := Validate(ci); err != nil {
+		c.ctx.Recorder(ci.Namespace).Eventf(ci, apiv1.EventTypeWarning, events.InvalidateCache, "Invalid CacheInvalidation: %v", err)
+		return c.updateStatus(ci, status, cachev1beta1.CacheInvalidationReasonInvalid, err.Error())
+	}
+	urlMap, reason, err := c.urlMap(ci)
+	if err != nil {
+		c.ctx.Recorder(ci.Namespace).Eventf(ci, apiv1.EventTypeWarning, events.InvalidateCache, "Error: %v", err)
+		if updateErr := c.updateStatus(ci, status, reason, err.Error()); updateErr != nil {
+			return updateErr
+		}
+		if reason == cachev1beta1.CacheInvalidationReasonInvalid {
+			return nil
+		}
+		return err
+	}
+	status.UrlMap = urlMap
+
+	if err := c.invalidate(ci, status); err != nil {
+		c.ctx.Recorder(ci.Namespace).Eventf(ci, apiv1.EventTypeWarning, events.InvalidateCache, "Error invalidating cache: %v", err)
+		if updateErr := c.updateStatus(ci, status, cachev1beta1.CacheInvalidationReasonInvalidationFailed, err.Error()); updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+
+	pending := 0
+	for _, op := range status.Operations {
+		if !op.Done {
+			pending++
+		}
+	}
+	if pending > 0 {
+		msg := fmt.Sprintf("Waiting for %d of %d invalidations", pending, len(status.Operations))
+		if err := c.updateStatus(ci, status, cachev1beta1.CacheInvalidationReasonInProgress, msg); err != nil {
+			return err
+		}
+		return errInProgress
+	}
+
+	now := metav1.Now()
+	status.CompletionTime = &now
+	msg := fmt.Sprintf("Invalidated %d paths of URL map %s", len(status.Operations), urlMap)
+	if err := c.updateStatus(ci, status, cachev1beta1.CacheInvalidationReasonInvalidated, msg); err != nil {
+		return err
+	}
+	c.ctx.Recorder(ci.Namespace).Eventf(ci, apiv1.EventTypeNormal, events.InvalidateCache, msg)
+	return nil
+}
+
+// urlMap returns the name of the URL map of the Ingress referenced by the
+// CacheInvalidation. On error, it also returns the reason for the status.
+func (c *Controller) urlMap(ci *cachev1beta1.CacheInvalidation) (string, cachev1beta1.CacheInvalidationConditionReason, error) {
+	ingKey := types.NamespacedName{Namespace: ci.Namespace, Name: ci.Spec.Ingress}.String()
+	obj, exists, err := c.ingLister.GetByKey(ingKey)
+	if err != nil {
+		return "", cachev1beta1.CacheInvalidationReasonIngressNotFound, fmt.Errorf("failed to lookup Ingress %s: %v", ingKey, err)
+	}
+	if !exists {
+		return "", cachev1beta1.CacheInvalidationReasonIngressNotFound, fmt.Errorf("Ingress %s not found", ingKey)
+	}
+	ing := obj.(*v1.Ingress)
+	if features.ScopeFromIngress(ing) != meta.Global {
+		return "", cachev1beta1.CacheInvalidationReasonInvalid, fmt.Errorf("Ingress %s is not a global external Ingress, Cloud CDN is only supported for global external Ingresses", ingKey)
+	}
+
+	if group, err := annotations.FromIngress(ing).SharedLBGroup(); err == nil && group != "" {
+		// The URL map is owned by the shared load balancer group.
+		ing = &v1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  ing.Namespace,
+				Name:       group,
+				Finalizers: []string{common.SharedLBGroupFinalizerKey},
+			},
+		}
+	}
+	name := c.namerFactory.Namer(ing).UrlMap()
+	if _, err := c.ctx.Cloud.GetURLMap(name); err != nil {
+		if utils.IsNotFoundError(err) {
+			return "", cachev1beta1.CacheInvalidationReasonLoadBalancerNotFound, fmt.Errorf("URL map %s of Ingress %s not found", name, ingKey)
+		}
+		return "", cachev1beta1.CacheInvalidationReasonLoadBalancerNotFound, err
+	}
+	return name, "", nil
+}
+
+// invalidate starts the invalidations of the paths that were not started yet
+// and updates the operations in the status with the ones that completed. A
+// failed operation is removed from the status to be started again.
+func (c *Controller) invalidate(ci *cachev1beta1.CacheInvalidation, status *cachev1beta1.CacheInvalidationStatus) error {
+	started := map[string]bool{}
+	for _, op := range status.Operations {
+		started[op.Path] = true
+	}
+	for _, path := range ci.Spec.Paths {
+		if started[path] {
+			continue
+		}
+		name, err := composite.InvalidateCache(c.ctx.Cloud, status.UrlMap, ci.Spec.Host, path)
+		if err != nil {
+			return fmt.Errorf("failed to invalidate path %s: %v", path, err)
+		}
+		klog.V(2).Infof("Invalidating path %s of URL map %s for CacheInvalidation %s/%s, operation %s", path, status.UrlMap, ci.Namespace, ci.Name, name)
+		status.Operations = append(status.Operations, cachev1beta1.CacheInvalidationOperation{Path: path, Name: name})
+		started[path] = true
+	}
+
+	var errs []error
+	var ops []cachev1beta1.CacheInvalidationOperation
+	for _, op := range status.Operations {
+		if !op.Done {
+			done, err := composite.GlobalOperationDone(c.ctx.Cloud, op.Name)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to invalidate path %s: %v", op.Path, err))
+				if done {
+					continue
+				}
+			}
+			op.Done = done
+		}
+		ops = append(ops, op)
+	}
+	status.Operations = ops
+	if len(errs) > 0 {
+		return utils.JoinErrs(errs)
+	}
+	return nil
+}
+
+// updateStatus sets the Complete condition of the CacheInvalidation and writes
+// its status if it changed. The condition is true for the Invalidated reason.
+func (c *Controller) updateStatus(ci *cachev1beta1.CacheInvalidation, status *cachev1beta1.CacheInvalidationStatus, reason cachev1beta1.CacheInvalidationConditionReason, msg string) error {
+	cond := metav1.Condition{
+		Type:               string(cachev1beta1.CacheInvalidationConditionComplete),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(reason),
+		Message:            msg,
+		ObservedGeneration: ci.Generation,
+	}
+	if reason == cachev1beta1.CacheInvalidationReasonInvalidated {
+		cond.Status = metav1.ConditionTrue
+	}
+	apimeta.SetStatusCondition(&status.Conditions, cond)
+
+	if equality.Semantic.DeepEqual(ci.Status, *status) {
+		return nil
+	}
+	updated := ci.DeepCopy()
+	updated.Status = *status
+	if _, err := c.client.NetworkingV1beta1().CacheInvalidations(ci.Namespace).UpdateStatus(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update status of CacheInvalidation %s/%s: %v", ci.Namespace, ci.Name, err)
+	}
+	return nil
+}
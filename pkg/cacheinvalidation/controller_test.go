@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheinvalidation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	ga "google.golang.org/api/compute/v1"
+	apiv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	cachev1beta1 "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1"
+	cachefake "k8s.io/ingress-gce/pkg/cacheinvalidation/client/clientset/versioned/fake"
+	ingctx "k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/test"
+	"k8s.io/ingress-gce/pkg/utils/namer"
+)
+
+const testNamespace = "test-namespace"
+
+// newTestController returns a controller for a fake GCE cloud whose cache
+// invalidations and operations are served by the returned FakeComputeAPI.
+func newTestController(t *testing.T) (*Controller, *test.FakeComputeAPI) {
+	t.Helper()
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	ctxConfig := ingctx.ControllerContextConfig{
+		Namespace:             apiv1.NamespaceAll,
+		ResyncPeriod:          1 * time.Minute,
+		DefaultBackendSvcPort: test.DefaultBeSvcPort,
+		HealthCheckPath:       "/",
+	}
+	ctx := ingctx.NewControllerContext(nil, fake.NewSimpleClientset(), nil, nil, nil, nil, nil, nil, nil, nil, cachefake.NewSimpleClientset(), nil, fakeGCE, namer.NewNamer("uid1", "fw1"), "kube-system-uid", ctxConfig)
+	return NewController(ctx), test.NewFakeComputeAPI(t, fakeGCE)
+}
+
+// syncAndRefresh syncs the CacheInvalidation and updates the lister with the
+// status written by the sync.
+func syncAndRefresh(t *testing.T, c *Controller, ci *cachev1beta1.CacheInvalidation) (*cachev1beta1.CacheInvalidation, error) {
+	t.Helper()
+	syncErr := c.sync(fmt.Sprintf("%s/%s", ci.Namespace, ci.Name))
+	got, err := c.client.NetworkingV1beta1().CacheInvalidations(ci.Namespace).Get(context.TODO(), ci.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	if err := c.lister.Update(got); err != nil {
+		t.Fatalf("lister.Update() = %v", err)
+	}
+	return got, syncErr
+}
+
+func checkCondition(t *testing.T, ci *cachev1beta1.CacheInvalidation, wantStatus metav1.ConditionStatus, wantReason cachev1beta1.CacheInvalidationConditionReason) {
+	t.Helper()
+	cond := apimeta.FindStatusCondition(ci.Status.Conditions, string(cachev1beta1.CacheInvalidationConditionComplete))
+	if cond == nil || cond.Status != wantStatus || cond.Reason != string(wantReason) || cond.ObservedGeneration != ci.Generation {
+		t.Errorf("Got Complete condition %+v, want status %s with reason %s", cond, wantStatus, wantReason)
+	}
+}
+
+func TestSync(t *testing.T) {
+	c, api := newTestController(t)
+	ci := &cachev1beta1.CacheInvalidation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "release", Generation: 1},
+		Spec: cachev1beta1.CacheInvalidationSpec{
+			Ingress: "static",
+			Host:    "static.example.com",
+			Paths:   []string{"/index.html", "/assets/*"},
+		},
+	}
+	if _, err := c.client.NetworkingV1beta1().CacheInvalidations(testNamespace).Create(context.TODO(), ci, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+	c.lister.Add(ci)
+
+	// The Ingress does not exist.
+	ci, err := syncAndRefresh(t, c, ci)
+	if err == nil {
+		t.Errorf("sync() = nil, want error for a missing Ingress")
+	}
+	checkCondition(t, ci, metav1.ConditionFalse, cachev1beta1.CacheInvalidationReasonIngressNotFound)
+
+	// The load balancer of the Ingress does not exist.
+	ing := &v1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "static"}}
+	c.ingLister.Add(ing)
+	ci, err = syncAndRefresh(t, c, ci)
+	if err == nil {
+		t.Errorf("sync() = nil, want error for a missing load balancer")
+	}
+	checkCondition(t, ci, metav1.ConditionFalse, cachev1beta1.CacheInvalidationReasonLoadBalancerNotFound)
+
+	urlMap := c.namerFactory.Namer(ing).UrlMap()
+	if err := c.ctx.Cloud.CreateURLMap(&ga.UrlMap{Name: urlMap}); err != nil {
+		t.Fatalf("CreateURLMap() = %v", err)
+	}
+	api.SetOperation(&ga.Operation{Name: "operation-1", Status: "RUNNING"})
+	api.SetOperation(&ga.Operation{Name: "operation-2", Status: "RUNNING"})
+	ci, err = syncAndRefresh(t, c, ci)
+	if err != errInProgress {
+		t.Errorf("sync() = %v, want %v", err, errInProgress)
+	}
+	checkCondition(t, ci, metav1.ConditionFalse, cachev1beta1.CacheInvalidationReasonInProgress)
+	wantInvalidations := []*ga.CacheInvalidationRule{
+		{Host: "static.example.com", Path: "/index.html"},
+		{Host: "static.example.com", Path: "/assets/*"},
+	}
+	if got := api.Invalidations(urlMap); !reflect.DeepEqual(got, wantInvalidations) {
+		t.Errorf("Got invalidations %+v, want %+v", got, wantInvalidations)
+	}
+	if ci.Status.UrlMap != urlMap || len(ci.Status.Operations) != 2 {
+		t.Errorf("Got status %+v, want URL map %s and 2 operations", ci.Status, urlMap)
+	}
+
+	// The first invalidation completes, the second one fails and is restarted.
+	api.SetOperation(&ga.Operation{Name: "operation-1", Status: "DONE"})
+	api.SetOperation(&ga.Operation{Name: "operation-2", Status: "DONE", Error: &ga.OperationError{
+		Errors: []*ga.OperationErrorErrors{{Message: "internal error"}},
+	}})
+	api.SetOperation(&ga.Operation{Name: "operation-3", Status: "RUNNING"})
+	ci, err = syncAndRefresh(t, c, ci)
+	if err == nil {
+		t.Errorf("sync() = nil, want error for a failed operation")
+	}
+	checkCondition(t, ci, metav1.ConditionFalse, cachev1beta1.CacheInvalidationReasonInvalidationFailed)
+	ci, err = syncAndRefresh(t, c, ci)
+	if err != errInProgress {
+		t.Errorf("sync() = %v, want %v", err, errInProgress)
+	}
+	if got := api.Invalidations(urlMap); len(got) != 3 || got[2].Path != "/assets/*" {
+		t.Errorf("Got invalidations %+v, want /assets/* to be invalidated again", got)
+	}
+
+	api.SetOperation(&ga.Operation{Name: "operation-3", Status: "DONE"})
+	ci, err = syncAndRefresh(t, c, ci)
+	if err != nil {
+		t.Errorf("sync() = %v, want nil", err)
+	}
+	checkCondition(t, ci, metav1.ConditionTrue, cachev1beta1.CacheInvalidationReasonInvalidated)
+	if ci.Status.CompletionTime == nil {
+		t.Errorf("Got nil completion time, want it to be set")
+	}
+
+	// A completed CacheInvalidation is not invalidated again.
+	if _, err = syncAndRefresh(t, c, ci); err != nil {
+		t.Errorf("sync() = %v, want nil", err)
+	}
+	if got := api.Invalidations(urlMap); len(got) != 3 {
+		t.Errorf("Got %d invalidations, want 3", len(got))
+	}
+
+	// A change of the spec invalidates again.
+	ci = ci.DeepCopy()
+	ci.Generation = 2
+	ci.Spec.Paths = []string{"/index.html"}
+	if ci, err = c.client.NetworkingV1beta1().CacheInvalidations(testNamespace).Update(context.TODO(), ci, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	c.lister.Update(ci)
+	api.SetOperation(&ga.Operation{Name: "operation-4", Status: "RUNNING"})
+	ci, err = syncAndRefresh(t, c, ci)
+	if err != errInProgress {
+		t.Errorf("sync() = %v, want %v", err, errInProgress)
+	}
+	checkCondition(t, ci, metav1.ConditionFalse, cachev1beta1.CacheInvalidationReasonInProgress)
+	if len(ci.Status.Operations) != 1 || ci.Status.Operations[0].Name != "operation-4" || ci.Status.CompletionTime != nil {
+		t.Errorf("Got status %+v, want a single new operation", ci.Status)
+	}
+}
+
+func TestSyncInvalid(t *testing.T) {
+	c, api := newTestController(t)
+	for _, tc := range []struct {
+		desc string
+		ing  *v1.Ingress
+		spec cachev1beta1.CacheInvalidationSpec
+	}{
+		{
+			desc: "no paths",
+			spec: cachev1beta1.CacheInvalidationSpec{Ingress: "static"},
+		},
+		{
+			desc: "internal Ingress",
+			ing: &v1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   testNamespace,
+					Name:        "internal",
+					Annotations: map[string]string{"kubernetes.io/ingress.class": "gce-internal"},
+				},
+			},
+			spec: cachev1beta1.CacheInvalidationSpec{Ingress: "internal", Paths: []string{"/"}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.ing != nil {
+				c.ingLister.Add(tc.ing)
+			}
+			ci := &cachev1beta1.CacheInvalidation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: tc.spec.Ingress},
+				Spec:       tc.spec,
+			}
+			if _, err := c.client.NetworkingV1beta1().CacheInvalidations(testNamespace).Create(context.TODO(), ci, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Create() = %v", err)
+			}
+			c.lister.Add(ci)
+
+			// Invalid CacheInvalidations are not retried.
+			ci, err := syncAndRefresh(t, c, ci)
+			if err != nil {
+				t.Errorf("sync() = %v, want nil", err)
+			}
+			checkCondition(t, ci, metav1.ConditionFalse, cachev1beta1.CacheInvalidationReasonInvalid)
+			ing := &v1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: tc.spec.Ingress}}
+			if got := api.Invalidations(c.namerFactory.Namer(ing).UrlMap()); len(got) != 0 {
+				t.Errorf("Got invalidations %+v, want none", got)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 
 	api_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
@@ -53,14 +54,15 @@ func (op *IngressesOperator) Partition(f func(*v1.Ingress) bool) (*IngressesOper
 	return Ingresses(i), Ingresses(ci)
 }
 
-// ReferencesService returns the Ingresses that references the given Service.
-func (op *IngressesOperator) ReferencesService(svc *api_v1.Service) *IngressesOperator {
+// ReferencesService returns the Ingresses that references the given Service,
+// directly or through the RouteConfig they reference.
+func (op *IngressesOperator) ReferencesService(svc *api_v1.Service, routeConfigsOp *RouteConfigsOperator) *IngressesOperator {
 	dupes := map[string]bool{}
 
 	var i []*v1.Ingress
 	for _, ing := range op.i {
 		key := fmt.Sprintf("%s/%s", ing.Namespace, ing.Name)
-		if doesIngressReferenceService(ing, svc) && !dupes[key] {
+		if doesIngressReferenceService(ing, routeConfigsOp.referencedByIngress(ing), svc) && !dupes[key] {
 			i = append(i, ing)
 			dupes[key] = true
 		}
@@ -68,16 +70,18 @@ func (op *IngressesOperator) ReferencesService(svc *api_v1.Service) *IngressesOp
 	return Ingresses(i)
 }
 
-// ReferencesBackendConfig returns the Ingresses that references the given BackendConfig.
-func (op *IngressesOperator) ReferencesBackendConfig(beConfig *backendconfigv1.BackendConfig, svcsOp *ServicesOperator) *IngressesOperator {
+// ReferencesBackendConfig returns the Ingresses that references the given BackendConfig,
+// through their Services or the Services of the RouteConfig they reference.
+func (op *IngressesOperator) ReferencesBackendConfig(beConfig *backendconfigv1.BackendConfig, svcsOp *ServicesOperator, routeConfigsOp *RouteConfigsOperator) *IngressesOperator {
 	dupes := map[string]bool{}
 
 	var i []*v1.Ingress
 	svcs := svcsOp.ReferencesBackendConfig(beConfig).AsList()
 	for _, ing := range op.i {
+		routeConfig := routeConfigsOp.referencedByIngress(ing)
 		for _, svc := range svcs {
 			key := fmt.Sprintf("%s/%s", ing.Namespace, ing.Name)
-			if doesIngressReferenceService(ing, svc) && !dupes[key] {
+			if doesIngressReferenceService(ing, routeConfig, svc) && !dupes[key] {
 				i = append(i, ing)
 				dupes[key] = true
 			}
@@ -100,3 +104,18 @@ func (op *IngressesOperator) ReferencesFrontendConfig(feConfig *frontendconfigv1
 	}
 	return Ingresses(i)
 }
+
+// ReferencesRouteConfig returns the Ingresses that reference the given RouteConfig.
+func (op *IngressesOperator) ReferencesRouteConfig(routeConfig *routeconfigv1beta1.RouteConfig) *IngressesOperator {
+	dupes := map[string]bool{}
+
+	var i []*v1.Ingress
+	for _, ing := range op.i {
+		key := fmt.Sprintf("%s/%s", ing.Namespace, ing.Name)
+		if doesIngressReferenceRouteConfig(ing, routeConfig) && !dupes[key] {
+			i = append(i, ing)
+			dupes[key] = true
+		}
+	}
+	return Ingresses(i)
+}
@@ -0,0 +1,66 @@
+package operator
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/networking/v1"
+	"k8s.io/ingress-gce/pkg/annotations"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+)
+
+// RouteConfigs returns the wrapper
+func RouteConfigs(f []*routeconfigv1beta1.RouteConfig) *RouteConfigsOperator {
+	return &RouteConfigsOperator{f: f}
+}
+
+// RouteConfigsOperator is an operator wrapper for a list of RouteConfigs.
+type RouteConfigsOperator struct {
+	f []*routeconfigv1beta1.RouteConfig
+}
+
+// AsList returns the underlying list of Services
+func (op *RouteConfigsOperator) AsList() []*routeconfigv1beta1.RouteConfig {
+	if op.f == nil {
+		return []*routeconfigv1beta1.RouteConfig{}
+	}
+	return op.f
+}
+
+// ReferencedByIngress returns the RouteConfigs that are referenced by the passed in Ingress.
+func (op *RouteConfigsOperator) ReferencedByIngress(ing *v1.Ingress) *RouteConfigsOperator {
+	dupes := map[string]bool{}
+
+	var f []*routeconfigv1beta1.RouteConfig
+	for _, routeConfig := range op.f {
+		key := fmt.Sprintf("%s/%s", routeConfig.Namespace, routeConfig.Name)
+		if doesIngressReferenceRouteConfig(ing, routeConfig) && !dupes[key] {
+			f = append(f, routeConfig)
+			dupes[key] = true
+		}
+	}
+	return RouteConfigs(f)
+}
+
+// doesIngressReferenceRouteConfig returns true if the passed in Ingress directly references
+// the passed in RouteConfig.
+func doesIngressReferenceRouteConfig(ing *v1.Ingress, routeConfig *routeconfigv1beta1.RouteConfig) bool {
+	if ing.Namespace != routeConfig.Namespace {
+		return false
+	}
+
+	routeConfigName := annotations.FromIngress(ing).RouteConfig()
+	return routeConfigName == routeConfig.Name
+}
+
+// referencedByIngress returns the RouteConfig referenced by the passed in
+// Ingress, or nil if the Ingress does not reference one.
+func (op *RouteConfigsOperator) referencedByIngress(ing *v1.Ingress) *routeconfigv1beta1.RouteConfig {
+	if op == nil {
+		return nil
+	}
+	matches := op.ReferencedByIngress(ing).AsList()
+	if len(matches) == 0 {
+		return nil
+	}
+	return matches[0]
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/utils"
 
 	api_v1 "k8s.io/api/core/v1"
@@ -43,14 +44,16 @@ func (op *ServicesOperator) ReferencesBackendConfig(beConfig *backendconfigv1.Ba
 	return Services(s)
 }
 
-// ReferencedByIngress returns the Services that are referenced by the passed in Ingress.
-func (op *ServicesOperator) ReferencedByIngress(ing *v1.Ingress) *ServicesOperator {
+// ReferencedByIngress returns the Services that are referenced by the passed in Ingress
+// or by the RouteConfig it references.
+func (op *ServicesOperator) ReferencedByIngress(ing *v1.Ingress, routeConfigsOp *RouteConfigsOperator) *ServicesOperator {
 	dupes := map[string]bool{}
 
 	var s []*api_v1.Service
+	routeConfig := routeConfigsOp.referencedByIngress(ing)
 	for _, svc := range op.s {
 		key := fmt.Sprintf("%s/%s", svc.Namespace, svc.Name)
-		if doesIngressReferenceService(ing, svc) && !dupes[key] {
+		if doesIngressReferenceService(ing, routeConfig, svc) && !dupes[key] {
 			s = append(s, svc)
 			dupes[key] = true
 		}
@@ -58,15 +61,15 @@ func (op *ServicesOperator) ReferencedByIngress(ing *v1.Ingress) *ServicesOperat
 	return Services(s)
 }
 
-// doesIngressReferenceService returns true if the passed in Ingress directly references
-// the passed in Service.
-func doesIngressReferenceService(ing *v1.Ingress, svc *api_v1.Service) bool {
+// doesIngressReferenceService returns true if the passed in Ingress, or the passed in
+// RouteConfig it references, directly references the passed in Service.
+func doesIngressReferenceService(ing *v1.Ingress, routeConfig *routeconfigv1beta1.RouteConfig, svc *api_v1.Service) bool {
 	if ing.Namespace != svc.Namespace {
 		return false
 	}
 
 	doesReference := false
-	utils.TraverseIngressBackends(ing, func(id utils.ServicePortID) bool {
+	utils.TraverseIngressBackends(ing, routeConfig, func(id utils.ServicePortID) bool {
 		if id.Service.Name == svc.Name {
 			doesReference = true
 			return true
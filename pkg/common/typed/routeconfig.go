@@ -0,0 +1,72 @@
+package typed
+
+import (
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+// WrapRouteConfigStore wraps a generic store so the API is type-safe
+func WrapRouteConfigStore(store cache.Store) *RouteConfigStore {
+	return &RouteConfigStore{store: store}
+}
+
+// RouteConfigStore is a typed version of Store.
+type RouteConfigStore struct {
+	store cache.Store
+}
+
+// Add implements Store.
+func (s *RouteConfigStore) Add(b *routeconfigv1beta1.RouteConfig) error {
+	return s.store.Add(b)
+}
+
+// Update implements Store.
+func (s *RouteConfigStore) Update(b *routeconfigv1beta1.RouteConfig) error {
+	return s.store.Update(b)
+}
+
+// Delete implements Store.
+func (s *RouteConfigStore) Delete(b *routeconfigv1beta1.RouteConfig) error {
+	return s.store.Delete(b)
+}
+
+// List implements Store.
+func (s *RouteConfigStore) List() []*routeconfigv1beta1.RouteConfig {
+	var ret []*routeconfigv1beta1.RouteConfig
+	if s.store == nil {
+		// RouteConfigs are not enabled.
+		return ret
+	}
+	for _, obj := range s.store.List() {
+		ret = append(ret, obj.(*routeconfigv1beta1.RouteConfig))
+	}
+	return ret
+}
+
+// ListKeys implements Store.
+func (s *RouteConfigStore) ListKeys() []string { return s.store.ListKeys() }
+
+// Get implements Store.
+func (s *RouteConfigStore) Get(b *routeconfigv1beta1.RouteConfig) (*routeconfigv1beta1.RouteConfig, bool, error) {
+	item, exists, err := s.store.Get(b)
+	if item == nil {
+		return nil, exists, err
+	}
+	return item.(*routeconfigv1beta1.RouteConfig), exists, err
+}
+
+// GetByKey implements Store.
+func (s *RouteConfigStore) GetByKey(key string) (*routeconfigv1beta1.RouteConfig, bool, error) {
+	item, exists, err := s.store.GetByKey(key)
+	if item == nil {
+		return nil, exists, err
+	}
+	return item.(*routeconfigv1beta1.RouteConfig), exists, err
+}
+
+// Resync implements Store.
+func (s *RouteConfigStore) Resync() error { return s.store.Resync() }
+
+// This function is mostly likely not useful for ordinary consumers.
+// func (s *RouteConfigStore) Replace(items []*routeconfigv1beta1.RouteConfig, string) error {}
@@ -18,6 +18,7 @@ limitations under the License.
 package composite
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
@@ -109,6 +110,44 @@ func SetSslCertificateForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, ta
 	}
 }
 
+// SetCertificateMapForTargetHttpsProxy() sets the Certificate Manager certificate map for a target https proxy
+func SetCertificateMapForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, certificateMapLink string) error {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("TargetHttpsProxy", "set_certificate_map", key.Region, key.Zone, string(targetHttpsProxy.Version))
+
+	// Set name in case it is not present in the key
+	key.Name = targetHttpsProxy.Name
+	klog.V(3).Infof("setting CertificateMap for TargetHttpsProxy %v", key)
+
+	switch targetHttpsProxy.Version {
+	case meta.VersionAlpha:
+		req := &computealpha.TargetHttpsProxiesSetCertificateMapRequest{CertificateMap: certificateMapLink}
+		switch key.Type() {
+		case meta.Regional:
+			return fmt.Errorf("SetCertificateMap() is not supported for regional Target Https Proxies")
+		default:
+			return mc.Observe(gceCloud.Compute().AlphaTargetHttpsProxies().SetCertificateMap(ctx, key, req))
+		}
+	case meta.VersionBeta:
+		req := &computebeta.TargetHttpsProxiesSetCertificateMapRequest{CertificateMap: certificateMapLink}
+		switch key.Type() {
+		case meta.Regional:
+			return fmt.Errorf("SetCertificateMap() is not supported for regional Target Https Proxies")
+		default:
+			return mc.Observe(gceCloud.Compute().BetaTargetHttpsProxies().SetCertificateMap(ctx, key, req))
+		}
+	default:
+		req := &compute.TargetHttpsProxiesSetCertificateMapRequest{CertificateMap: certificateMapLink}
+		switch key.Type() {
+		case meta.Regional:
+			return fmt.Errorf("SetCertificateMap() is not supported for regional Target Https Proxies")
+		default:
+			return mc.Observe(gceCloud.Compute().TargetHttpsProxies().SetCertificateMap(ctx, key, req))
+		}
+	}
+}
+
 // SetSslPolicyForTargetHttpsProxy() sets the url map for a target proxy
 func SetSslPolicyForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, SslPolicyLink string) error {
 	ctx, cancel := cloud.ContextWithCallTimeout()
@@ -259,6 +298,149 @@ func SetSecurityPolicy(gceCloud *gce.Cloud, backendService *BackendService, secu
 	}
 }
 
+// SetEdgeSecurityPolicy sets the cloud armor edge security policy for a
+// backend service. The edge security policy is set with the GA API, which is
+// not wrapped by the cloud provider, so the operation is waited for here.
+func SetEdgeSecurityPolicy(gceCloud *gce.Cloud, backendService *BackendService, edgeSecurityPolicy string) error {
+	key := meta.GlobalKey(backendService.Name)
+	if backendService.Scope != meta.Global {
+		return fmt.Errorf("cloud armor edge security policies not supported for %s backend service %s", backendService.Scope, backendService.Name)
+	}
+
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("BackendService", "set_edge_security_policy", key.Region, key.Zone, string(meta.VersionGA))
+
+	ref := &compute.SecurityPolicyReference{}
+	if edgeSecurityPolicy != "" {
+		ref.SecurityPolicy = cloud.SelfLink(meta.VersionGA, gceCloud.ProjectID(), "securityPolicies", meta.GlobalKey(edgeSecurityPolicy))
+	}
+	op, err := gceCloud.ComputeServices().GA.BackendServices.SetEdgeSecurityPolicy(gceCloud.ProjectID(), key.Name, ref).Context(ctx).Do()
+	if err != nil {
+		return mc.Observe(err)
+	}
+	return mc.Observe(waitForGlobalOperation(ctx, gceCloud, op.Name))
+}
+
+// SetServerTlsPolicyForTargetHttpsProxy sets the server TLS policy of a target
+// https proxy. An empty link removes the server TLS policy. The target https
+// proxy is patched with the GA API, which is not wrapped by the cloud provider,
+// so the operation is waited for here. The fingerprint of the proxy is read
+// right before the patch, as other updates of the proxy change it.
+func SetServerTlsPolicyForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, serverTlsPolicyLink string) error {
+	if key.Type() != meta.Global {
+		return fmt.Errorf("server TLS policies not supported for %s target https proxy %s", key.Type(), targetHttpsProxy.Name)
+	}
+
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("TargetHttpsProxy", "set_server_tls_policy", key.Region, key.Zone, string(meta.VersionGA))
+
+	// Set name in case it is not present in the key
+	key.Name = targetHttpsProxy.Name
+	klog.V(3).Infof("Setting ServerTlsPolicy for TargetHttpsProxy %v", key)
+
+	services := gceCloud.ComputeServices()
+	current, err := services.GA.TargetHttpsProxies.Get(gceCloud.ProjectID(), key.Name).Context(ctx).Do()
+	if err != nil {
+		return mc.Observe(err)
+	}
+	patch := &compute.TargetHttpsProxy{
+		Fingerprint:     current.Fingerprint,
+		ServerTlsPolicy: serverTlsPolicyLink,
+	}
+	if serverTlsPolicyLink == "" {
+		patch.NullFields = []string{"ServerTlsPolicy"}
+	}
+	op, err := services.GA.TargetHttpsProxies.Patch(gceCloud.ProjectID(), key.Name, patch).Context(ctx).Do()
+	if err != nil {
+		return mc.Observe(err)
+	}
+	return mc.Observe(waitForGlobalOperation(ctx, gceCloud, op.Name))
+}
+
+// SetQuicOverrideForTargetHttpsProxy sets the QUIC override of a target https
+// proxy. The QUIC override is set with the GA API, which is not wrapped by the
+// cloud provider, so the operation is waited for here.
+func SetQuicOverrideForTargetHttpsProxy(gceCloud *gce.Cloud, key *meta.Key, targetHttpsProxy *TargetHttpsProxy, quicOverride string) error {
+	if key.Type() != meta.Global {
+		return fmt.Errorf("QUIC override not supported for %s target https proxy %s", key.Type(), targetHttpsProxy.Name)
+	}
+
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("TargetHttpsProxy", "set_quic_override", key.Region, key.Zone, string(meta.VersionGA))
+
+	// Set name in case it is not present in the key
+	key.Name = targetHttpsProxy.Name
+	klog.V(3).Infof("Setting QuicOverride for TargetHttpsProxy %v", key)
+
+	req := &compute.TargetHttpsProxiesSetQuicOverrideRequest{QuicOverride: quicOverride}
+	op, err := gceCloud.ComputeServices().GA.TargetHttpsProxies.SetQuicOverride(gceCloud.ProjectID(), key.Name, req).Context(ctx).Do()
+	if err != nil {
+		return mc.Observe(err)
+	}
+	return mc.Observe(waitForGlobalOperation(ctx, gceCloud, op.Name))
+}
+
+// waitForGlobalOperation waits for a global operation to be done and returns
+// its error, if any. Global operations are waited for with the GA API,
+// regardless of the API version which started them.
+func waitForGlobalOperation(ctx context.Context, gceCloud *gce.Cloud, opName string) error {
+	for {
+		op, err := gceCloud.ComputeServices().GA.GlobalOperations.Wait(gceCloud.ProjectID(), opName).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+		if op.Status != "DONE" {
+			continue
+		}
+		if op.Error != nil && len(op.Error.Errors) > 0 {
+			return fmt.Errorf("operation %s failed: %s", op.Name, op.Error.Errors[0].Message)
+		}
+		return nil
+	}
+}
+
+// InvalidateCache starts the invalidation of the cached content of the path of
+// the host on the global URL map and returns the name of the operation. An
+// empty host invalidates the path on all hosts. Cache invalidation is not
+// wrapped by the cloud provider and can take minutes, so the operation is not
+// waited for; use GlobalOperationDone to poll it.
+func InvalidateCache(gceCloud *gce.Cloud, urlMap, host, path string) (string, error) {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("UrlMap", "invalidate_cache", "", "", string(meta.VersionGA))
+
+	rule := &compute.CacheInvalidationRule{Host: host, Path: path}
+	op, err := gceCloud.ComputeServices().GA.UrlMaps.InvalidateCache(gceCloud.ProjectID(), urlMap, rule).Context(ctx).Do()
+	if err != nil {
+		return "", mc.Observe(err)
+	}
+	return op.Name, mc.Observe(nil)
+}
+
+// GlobalOperationDone returns true once the global operation completed, or an
+// error if it failed.
+func GlobalOperationDone(gceCloud *gce.Cloud, opName string) (bool, error) {
+	ctx, cancel := cloud.ContextWithCallTimeout()
+	defer cancel()
+	mc := metrics.NewMetricContext("GlobalOperation", "get", "", "", string(meta.VersionGA))
+
+	op, err := gceCloud.ComputeServices().GA.GlobalOperations.Get(gceCloud.ProjectID(), opName).Context(ctx).Do()
+	if err != nil {
+		return false, mc.Observe(err)
+	}
+	mc.Observe(nil)
+	if op.Status != "DONE" {
+		return false, nil
+	}
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		return true, fmt.Errorf("operation %s failed: %s", op.Name, op.Error.Errors[0].Message)
+	}
+	return true, nil
+}
+
 func AddSignedUrlKey(gceCloud *gce.Cloud, key *meta.Key, backendService *BackendService, signedUrlKey *SignedUrlKey) error {
 	ctx, cancel := cloud.ContextWithCallTimeout()
 	defer cancel()
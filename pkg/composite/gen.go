@@ -4428,6 +4428,13 @@ func (backendService *BackendService) ToAlpha() (*computealpha.BackendService, e
 			alpha.LogConfig.ForceSendFields = []string{"Enable", "SampleRate"}
 		}
 	}
+	// Copy the force send fields of the backends, e.g. to send a
+	// CapacityScaler of 0.
+	for i, backend := range backendService.Backends {
+		if backend != nil && alpha.Backends[i] != nil {
+			alpha.Backends[i].ForceSendFields = backend.ForceSendFields
+		}
+	}
 
 	return alpha, nil
 }
@@ -4456,6 +4463,13 @@ func (backendService *BackendService) ToBeta() (*computebeta.BackendService, err
 			beta.LogConfig.ForceSendFields = []string{"Enable", "SampleRate"}
 		}
 	}
+	// Copy the force send fields of the backends, e.g. to send a
+	// CapacityScaler of 0.
+	for i, backend := range backendService.Backends {
+		if backend != nil && beta.Backends[i] != nil {
+			beta.Backends[i].ForceSendFields = backend.ForceSendFields
+		}
+	}
 
 	return beta, nil
 }
@@ -4484,6 +4498,13 @@ func (backendService *BackendService) ToGA() (*compute.BackendService, error) {
 			ga.LogConfig.ForceSendFields = []string{"Enable", "SampleRate"}
 		}
 	}
+	// Copy the force send fields of the backends, e.g. to send a
+	// CapacityScaler of 0.
+	for i, backend := range backendService.Backends {
+		if backend != nil && ga.Backends[i] != nil {
+			ga.Backends[i].ForceSendFields = backend.ForceSendFields
+		}
+	}
 
 	return ga, nil
 }
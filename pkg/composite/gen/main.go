@@ -849,6 +849,13 @@ func ({{$type.VarName}} *{{$type.Name}}) To{{$version}}() (*compute{{$extension}
 			{{$lower}}.LogConfig.ForceSendFields = []string{"Enable", "SampleRate"}
 		}
 	}
+	// Copy the force send fields of the backends, e.g. to send a
+	// CapacityScaler of 0.
+	for i, backend := range {{$type.VarName}}.Backends {
+		if backend != nil && {{$lower}}.Backends[i] != nil {
+			{{$lower}}.Backends[i].ForceSendFields = backend.ForceSendFields
+		}
+	}
 	{{- end}}
 
 	return {{$lower}}, nil
@@ -37,20 +37,29 @@ import (
 	networkclient "k8s.io/cloud-provider-gcp/crd/client/network/clientset/versioned"
 	informernetwork "k8s.io/cloud-provider-gcp/crd/client/network/informers/externalversions/network/v1"
 	"k8s.io/cloud-provider-gcp/providers/gce"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	cachev1beta1 "k8s.io/ingress-gce/pkg/apis/cacheinvalidation/v1beta1"
+	gatewayv1beta1 "k8s.io/ingress-gce/pkg/apis/gateway/v1beta1"
 	sav1 "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1"
 	sav1beta1 "k8s.io/ingress-gce/pkg/apis/serviceattachment/v1beta1"
 	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned"
 	informerbackendconfig "k8s.io/ingress-gce/pkg/backendconfig/client/informers/externalversions/backendconfig/v1"
+	cacheinvalidationclient "k8s.io/ingress-gce/pkg/cacheinvalidation/client/clientset/versioned"
+	informercacheinvalidation "k8s.io/ingress-gce/pkg/cacheinvalidation/client/informers/externalversions/cacheinvalidation/v1beta1"
 	"k8s.io/ingress-gce/pkg/cmconfig"
 	"k8s.io/ingress-gce/pkg/common/typed"
 	"k8s.io/ingress-gce/pkg/controller/translator"
 	"k8s.io/ingress-gce/pkg/flags"
 	frontendconfigclient "k8s.io/ingress-gce/pkg/frontendconfig/client/clientset/versioned"
 	informerfrontendconfig "k8s.io/ingress-gce/pkg/frontendconfig/client/informers/externalversions/frontendconfig/v1beta1"
+	gatewayclient "k8s.io/ingress-gce/pkg/gateway/client/clientset/versioned"
+	informergateway "k8s.io/ingress-gce/pkg/gateway/client/informers/externalversions/gateway/v1beta1"
 	ingparamsclient "k8s.io/ingress-gce/pkg/ingparams/client/clientset/versioned"
 	informeringparams "k8s.io/ingress-gce/pkg/ingparams/client/informers/externalversions/ingparams/v1beta1"
 	"k8s.io/ingress-gce/pkg/instancegroups"
 	"k8s.io/ingress-gce/pkg/metrics"
+	routeconfigclient "k8s.io/ingress-gce/pkg/routeconfig/client/clientset/versioned"
+	informerrouteconfig "k8s.io/ingress-gce/pkg/routeconfig/client/informers/externalversions/routeconfig/v1beta1"
 	serviceattachmentclient "k8s.io/ingress-gce/pkg/serviceattachment/client/clientset/versioned"
 	informerserviceattachment "k8s.io/ingress-gce/pkg/serviceattachment/client/informers/externalversions/serviceattachment/v1"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
@@ -71,11 +80,14 @@ const (
 
 // ControllerContext holds the state needed for the execution of the controller.
 type ControllerContext struct {
-	KubeConfig     *rest.Config
-	KubeClient     kubernetes.Interface
-	SvcNegClient   svcnegclient.Interface
-	SAClient       serviceattachmentclient.Interface
-	FirewallClient firewallclient.Interface
+	KubeConfig              *rest.Config
+	KubeClient              kubernetes.Interface
+	BackendConfigClient     backendconfigclient.Interface
+	SvcNegClient            svcnegclient.Interface
+	SAClient                serviceattachmentclient.Interface
+	GatewayClient           gatewayclient.Interface
+	CacheInvalidationClient cacheinvalidationclient.Interface
+	FirewallClient          firewallclient.Interface
 
 	Cloud *gce.Cloud
 
@@ -86,21 +98,25 @@ type ControllerContext struct {
 	ControllerContextConfig
 	ASMConfigController *cmconfig.ConfigMapConfigController
 
-	IngressInformer          cache.SharedIndexInformer
-	ServiceInformer          cache.SharedIndexInformer
-	BackendConfigInformer    cache.SharedIndexInformer
-	FrontendConfigInformer   cache.SharedIndexInformer
-	PodInformer              cache.SharedIndexInformer
-	NodeInformer             cache.SharedIndexInformer
-	EndpointSliceInformer    cache.SharedIndexInformer
-	ConfigMapInformer        cache.SharedIndexInformer
-	SvcNegInformer           cache.SharedIndexInformer
-	IngClassInformer         cache.SharedIndexInformer
-	IngParamsInformer        cache.SharedIndexInformer
-	SAInformer               cache.SharedIndexInformer
-	FirewallInformer         cache.SharedIndexInformer
-	NetworkInformer          cache.SharedIndexInformer
-	GKENetworkParamsInformer cache.SharedIndexInformer
+	IngressInformer           cache.SharedIndexInformer
+	ServiceInformer           cache.SharedIndexInformer
+	BackendConfigInformer     cache.SharedIndexInformer
+	FrontendConfigInformer    cache.SharedIndexInformer
+	RouteConfigInformer       cache.SharedIndexInformer
+	PodInformer               cache.SharedIndexInformer
+	NodeInformer              cache.SharedIndexInformer
+	EndpointSliceInformer     cache.SharedIndexInformer
+	ConfigMapInformer         cache.SharedIndexInformer
+	SvcNegInformer            cache.SharedIndexInformer
+	IngClassInformer          cache.SharedIndexInformer
+	IngParamsInformer         cache.SharedIndexInformer
+	SAInformer                cache.SharedIndexInformer
+	GatewayInformer           cache.SharedIndexInformer
+	HTTPRouteInformer         cache.SharedIndexInformer
+	CacheInvalidationInformer cache.SharedIndexInformer
+	FirewallInformer          cache.SharedIndexInformer
+	NetworkInformer           cache.SharedIndexInformer
+	GKENetworkParamsInformer  cache.SharedIndexInformer
 
 	ControllerMetrics *metrics.ControllerMetrics
 
@@ -129,6 +145,7 @@ type ControllerContextConfig struct {
 	DefaultBackendSvcPort         utils.ServicePort
 	HealthCheckPath               string
 	FrontendConfigEnabled         bool
+	RouteConfigEnabled            bool
 	EnableASMConfigMap            bool
 	ASMConfigMapNamespace         string
 	ASMConfigMapName              string
@@ -146,10 +163,13 @@ func NewControllerContext(
 	kubeClient kubernetes.Interface,
 	backendConfigClient backendconfigclient.Interface,
 	frontendConfigClient frontendconfigclient.Interface,
+	routeConfigClient routeconfigclient.Interface,
 	firewallClient firewallclient.Interface,
 	svcnegClient svcnegclient.Interface,
 	ingParamsClient ingparamsclient.Interface,
 	saClient serviceattachmentclient.Interface,
+	gatewayClient gatewayclient.Interface,
+	cacheInvalidationClient cacheinvalidationclient.Interface,
 	networkClient networkclient.Interface,
 	cloud *gce.Cloud,
 	clusterNamer *namer.Namer,
@@ -159,9 +179,12 @@ func NewControllerContext(
 	context := &ControllerContext{
 		KubeConfig:              kubeConfig,
 		KubeClient:              kubeClient,
+		BackendConfigClient:     backendConfigClient,
 		FirewallClient:          firewallClient,
 		SvcNegClient:            svcnegClient,
 		SAClient:                saClient,
+		GatewayClient:           gatewayClient,
+		CacheInvalidationClient: cacheInvalidationClient,
 		Cloud:                   cloud,
 		ClusterNamer:            clusterNamer,
 		L4Namer:                 namer.NewL4Namer(string(kubeSystemUID), clusterNamer),
@@ -183,6 +206,9 @@ func NewControllerContext(
 	if config.FrontendConfigEnabled {
 		context.FrontendConfigInformer = informerfrontendconfig.NewFrontendConfigInformer(frontendConfigClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	}
+	if config.RouteConfigEnabled {
+		context.RouteConfigInformer = informerrouteconfig.NewRouteConfigInformer(routeConfigClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
+	}
 	if ingParamsClient != nil {
 		context.IngClassInformer = informernetworking.NewIngressClassInformer(kubeClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
 		context.IngParamsInformer = informeringparams.NewGCPIngressParamsInformer(ingParamsClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
@@ -192,6 +218,15 @@ func NewControllerContext(
 		context.SAInformer = informerserviceattachment.NewServiceAttachmentInformer(saClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
 	}
 
+	if gatewayClient != nil {
+		context.GatewayInformer = informergateway.NewGatewayInformer(gatewayClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
+		context.HTTPRouteInformer = informergateway.NewHTTPRouteInformer(gatewayClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
+	}
+
+	if cacheInvalidationClient != nil {
+		context.CacheInvalidationInformer = informercacheinvalidation.NewCacheInvalidationInformer(cacheInvalidationClient, config.Namespace, config.ResyncPeriod, utils.NewNamespaceIndexer())
+	}
+
 	if networkClient != nil {
 		context.NetworkInformer = informernetwork.NewNetworkInformer(networkClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
 		context.GKENetworkParamsInformer = informernetwork.NewGKENetworkParamSetInformer(networkClient, config.ResyncPeriod, utils.NewNamespaceIndexer())
@@ -213,6 +248,7 @@ func NewControllerContext(
 		context.NodeInformer,
 		context.PodInformer,
 		context.EndpointSliceInformer,
+		context.RouteConfigInformer,
 		context.KubeClient,
 		context,
 		flags.F.EnableTransparentHealthChecks,
@@ -279,6 +315,10 @@ func (ctx *ControllerContext) HasSynced() bool {
 		funcs = append(funcs, ctx.FrontendConfigInformer.HasSynced)
 	}
 
+	if ctx.RouteConfigInformer != nil {
+		funcs = append(funcs, ctx.RouteConfigInformer.HasSynced)
+	}
+
 	if ctx.ConfigMapInformer != nil {
 		funcs = append(funcs, ctx.ConfigMapInformer.HasSynced)
 	}
@@ -294,6 +334,15 @@ func (ctx *ControllerContext) HasSynced() bool {
 	if ctx.SAInformer != nil {
 		funcs = append(funcs, ctx.SAInformer.HasSynced)
 	}
+	if ctx.GatewayInformer != nil {
+		funcs = append(funcs, ctx.GatewayInformer.HasSynced)
+	}
+	if ctx.HTTPRouteInformer != nil {
+		funcs = append(funcs, ctx.HTTPRouteInformer.HasSynced)
+	}
+	if ctx.CacheInvalidationInformer != nil {
+		funcs = append(funcs, ctx.CacheInvalidationInformer.HasSynced)
+	}
 	if ctx.NetworkInformer != nil {
 		funcs = append(funcs, ctx.NetworkInformer.HasSynced)
 	}
@@ -373,6 +422,9 @@ func (ctx *ControllerContext) Start(stopCh chan struct{}) {
 	if ctx.FrontendConfigInformer != nil {
 		go ctx.FrontendConfigInformer.Run(stopCh)
 	}
+	if ctx.RouteConfigInformer != nil {
+		go ctx.RouteConfigInformer.Run(stopCh)
+	}
 	if ctx.EnableASMConfigMap && ctx.ConfigMapInformer != nil {
 		go ctx.ConfigMapInformer.Run(stopCh)
 	}
@@ -388,6 +440,15 @@ func (ctx *ControllerContext) Start(stopCh chan struct{}) {
 	if ctx.SAInformer != nil {
 		go ctx.SAInformer.Run(stopCh)
 	}
+	if ctx.GatewayInformer != nil {
+		go ctx.GatewayInformer.Run(stopCh)
+	}
+	if ctx.HTTPRouteInformer != nil {
+		go ctx.HTTPRouteInformer.Run(stopCh)
+	}
+	if ctx.CacheInvalidationInformer != nil {
+		go ctx.CacheInvalidationInformer.Run(stopCh)
+	}
 	if ctx.NetworkInformer != nil {
 		go ctx.NetworkInformer.Run(stopCh)
 	}
@@ -421,11 +482,24 @@ func (ctx *ControllerContext) FrontendConfigs() *typed.FrontendConfigStore {
 	return typed.WrapFrontendConfigStore(ctx.FrontendConfigInformer.GetStore())
 }
 
+// RouteConfigs returns the store of RouteConfigs.
+func (ctx *ControllerContext) RouteConfigs() *typed.RouteConfigStore {
+	if ctx.RouteConfigInformer == nil {
+		return typed.WrapRouteConfigStore(nil)
+	}
+	return typed.WrapRouteConfigStore(ctx.RouteConfigInformer.GetStore())
+}
+
 // generateScheme creates a scheme and adds relevant CRD schemes that will be used
 // for events
 func (ctx *ControllerContext) generateScheme() *runtime.Scheme {
 	controllerScheme := scheme.Scheme
 
+	if ctx.BackendConfigInformer != nil {
+		if err := backendconfigv1.AddToScheme(controllerScheme); err != nil {
+			klog.Errorf("Failed to add v1 BackendConfig CRD scheme to event recorder: %s", err)
+		}
+	}
 	if ctx.SAInformer != nil {
 		if err := sav1beta1.AddToScheme(controllerScheme); err != nil {
 			klog.Errorf("Failed to add v1beta1 ServiceAttachment CRD scheme to event recorder: %s", err)
@@ -434,5 +508,15 @@ func (ctx *ControllerContext) generateScheme() *runtime.Scheme {
 			klog.Errorf("Failed to add v1 ServiceAttachment CRD scheme to event recorder: %s", err)
 		}
 	}
+	if ctx.GatewayInformer != nil {
+		if err := gatewayv1beta1.AddToScheme(controllerScheme); err != nil {
+			klog.Errorf("Failed to add v1beta1 Gateway CRD scheme to event recorder: %s", err)
+		}
+	}
+	if ctx.CacheInvalidationInformer != nil {
+		if err := cachev1beta1.AddToScheme(controllerScheme); err != nil {
+			klog.Errorf("Failed to add v1beta1 CacheInvalidation CRD scheme to event recorder: %s", err)
+		}
+	}
 	return controllerScheme
 }
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/backendconfig"
+	ingerrors "k8s.io/ingress-gce/pkg/controller/errors"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/common"
+	"k8s.io/klog/v2"
+)
+
+// backendConfigUsage is the usage of a BackendConfig by an Ingress.
+type backendConfigUsage struct {
+	// backendServices are the names of the backend services of the Ingress
+	// which use the BackendConfig.
+	backendServices sets.String
+	// invalidErr is the validation error of the BackendConfig, if it is not
+	// valid for a service port of the Ingress.
+	invalidErr error
+	// syncErr is the error of the last sync of the Ingress.
+	syncErr error
+}
+
+// backendConfigUsages returns the usages of BackendConfigs by an Ingress,
+// keyed by the keys of the BackendConfigs, from the translated URL map and the
+// translation errors of the Ingress.
+func backendConfigUsages(urlMap *utils.GCEURLMap, errs []error, syncErr error) map[string]*backendConfigUsage {
+	usages := map[string]*backendConfigUsage{}
+	usage := func(beConfig *backendconfigv1.BackendConfig) *backendConfigUsage {
+		key := fmt.Sprintf("%s/%s", beConfig.Namespace, beConfig.Name)
+		if usages[key] == nil {
+			usages[key] = &backendConfigUsage{backendServices: sets.NewString(), syncErr: syncErr}
+		}
+		return usages[key]
+	}
+
+	if urlMap != nil {
+		for _, sp := range urlMap.AllServicePorts() {
+			if sp.BackendConfig != nil {
+				usage(sp.BackendConfig).backendServices.Insert(sp.BackendName())
+			}
+		}
+	}
+	for _, err := range errs {
+		if validationErr, ok := err.(ingerrors.ErrBackendConfigValidation); ok {
+			usage(&validationErr.BackendConfig).invalidErr = validationErr.Err
+		}
+	}
+	return usages
+}
+
+// applyBackendConfigUsages sets the Ingresses, the backend services and the
+// Accepted and Programmed conditions in the status of a BackendConfig from its
+// usages, keyed by the keys of the Ingresses.
+func applyBackendConfigUsages(status *backendconfigv1.BackendConfigStatus, generation int64, usages map[string]*backendConfigUsage) {
+	ingKeys := make([]string, 0, len(usages))
+	for ingKey := range usages {
+		ingKeys = append(ingKeys, ingKey)
+	}
+	sort.Strings(ingKeys)
+
+	status.Ingresses = nil
+	status.BackendServices = nil
+	backendServices := sets.NewString()
+	var invalidIngKey, syncFailedIngKey string
+	for _, ingKey := range ingKeys {
+		usage := usages[ingKey]
+		status.Ingresses = append(status.Ingresses, ingKey)
+		backendServices.Insert(usage.backendServices.UnsortedList()...)
+		if usage.invalidErr != nil && invalidIngKey == "" {
+			invalidIngKey = ingKey
+		}
+		if usage.syncErr != nil && syncFailedIngKey == "" {
+			syncFailedIngKey = ingKey
+		}
+	}
+	if backendServices.Len() > 0 {
+		status.BackendServices = backendServices.List()
+	}
+
+	accepted := metav1.Condition{
+		Type:               string(backendconfigv1.BackendConfigConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(backendconfigv1.BackendConfigReasonAccepted),
+		Message:            "BackendConfig is valid",
+		ObservedGeneration: generation,
+	}
+	programmed := metav1.Condition{
+		Type:               string(backendconfigv1.BackendConfigConditionProgrammed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(backendconfigv1.BackendConfigReasonProgrammed),
+		Message:            "Backend services are programmed",
+		ObservedGeneration: generation,
+	}
+	switch {
+	case len(ingKeys) == 0:
+		programmed.Status = metav1.ConditionFalse
+		programmed.Reason = string(backendconfigv1.BackendConfigReasonNotInUse)
+		programmed.Message = "BackendConfig is not used by any Ingress"
+	case invalidIngKey != "":
+		accepted.Status = metav1.ConditionFalse
+		accepted.Reason = string(backendconfigv1.BackendConfigReasonInvalid)
+		accepted.Message = fmt.Sprintf("BackendConfig is not valid for Ingress %s: %v", invalidIngKey, usages[invalidIngKey].invalidErr)
+		programmed.Status = metav1.ConditionFalse
+		programmed.Reason = string(backendconfigv1.BackendConfigReasonInvalid)
+		programmed.Message = accepted.Message
+	case syncFailedIngKey != "":
+		programmed.Status = metav1.ConditionFalse
+		programmed.Reason = string(backendconfigv1.BackendConfigReasonSyncFailed)
+		programmed.Message = fmt.Sprintf("Error syncing Ingress %s: %v", syncFailedIngKey, usages[syncFailedIngKey].syncErr)
+	}
+	// A BackendConfig which is not used is not validated, so the last
+	// validation result is kept.
+	if len(ingKeys) > 0 {
+		apimeta.SetStatusCondition(&status.Conditions, accepted)
+	}
+	apimeta.SetStatusCondition(&status.Conditions, programmed)
+}
+
+// initBackendConfigUsages rebuilds the usages of BackendConfigs from the
+// Ingresses in the cache, so that the status written by the first syncs after
+// a restart lists every Ingress using a BackendConfig, not only the Ingresses
+// synced so far. The result of the last sync of an Ingress is not known until
+// it is synced again.
+func (lbc *LoadBalancerController) initBackendConfigUsages() {
+	if lbc.ctx.BackendConfigClient == nil {
+		return
+	}
+
+	ings := lbc.ctx.Ingresses().List()
+	lbc.backendConfigUsagesLock.Lock()
+	defer lbc.backendConfigUsagesLock.Unlock()
+	for _, ing := range ings {
+		if utils.NeedsCleanup(ing) {
+			continue
+		}
+		ingKey := common.IngressKeyFunc(ing)
+		urlMap, errs, _ := lbc.Translator.TranslateIngress(ing, lbc.ctx.DefaultBackendSvcPort.ID, lbc.ctx.ClusterNamer)
+		for beConfigKey, usage := range backendConfigUsages(urlMap, errs, nil) {
+			if lbc.backendConfigUsages[beConfigKey] == nil {
+				lbc.backendConfigUsages[beConfigKey] = map[string]*backendConfigUsage{}
+			}
+			lbc.backendConfigUsages[beConfigKey][ingKey] = usage
+		}
+	}
+	klog.V(2).Infof("Rebuilt the usages of %d BackendConfigs from %d Ingresses", len(lbc.backendConfigUsages), len(ings))
+}
+
+// recordBackendConfigUsages records the usages of BackendConfigs by the
+// Ingress with the given key and updates the status of the BackendConfigs
+// which are or were used by the Ingress. Nil usages forget the Ingress.
+// Failures to update the status are only logged.
+func (lbc *LoadBalancerController) recordBackendConfigUsages(ingKey string, usages map[string]*backendConfigUsage) {
+	if lbc.ctx.BackendConfigClient == nil {
+		return
+	}
+
+	lbc.backendConfigUsagesLock.Lock()
+	beConfigKeys := sets.StringKeySet(usages)
+	for beConfigKey, ingUsages := range lbc.backendConfigUsages {
+		if _, ok := ingUsages[ingKey]; ok {
+			beConfigKeys.Insert(beConfigKey)
+			delete(ingUsages, ingKey)
+		}
+	}
+	for beConfigKey, usage := range usages {
+		if lbc.backendConfigUsages[beConfigKey] == nil {
+			lbc.backendConfigUsages[beConfigKey] = map[string]*backendConfigUsage{}
+		}
+		lbc.backendConfigUsages[beConfigKey][ingKey] = usage
+	}
+
+	// Copy the usages, they are updated by other workers once unlocked.
+	beConfigUsages := map[string]map[string]*backendConfigUsage{}
+	for _, beConfigKey := range beConfigKeys.List() {
+		beConfigUsages[beConfigKey] = map[string]*backendConfigUsage{}
+		for key, usage := range lbc.backendConfigUsages[beConfigKey] {
+			beConfigUsages[beConfigKey][key] = usage
+		}
+		if len(lbc.backendConfigUsages[beConfigKey]) == 0 {
+			delete(lbc.backendConfigUsages, beConfigKey)
+		}
+	}
+	lbc.backendConfigUsagesLock.Unlock()
+
+	for beConfigKey, ingUsages := range beConfigUsages {
+		obj, exists, err := lbc.ctx.BackendConfigInformer.GetIndexer().GetByKey(beConfigKey)
+		if err != nil || !exists {
+			continue
+		}
+		beConfig := obj.(*backendconfigv1.BackendConfig)
+		ingUsages := ingUsages
+		err = backendconfig.UpdateStatus(lbc.ctx.BackendConfigClient, beConfig, func(status *backendconfigv1.BackendConfigStatus) {
+			applyBackendConfigUsages(status, beConfig.Generation, ingUsages)
+		})
+		if err != nil {
+			klog.Errorf("Failed to update status of BackendConfig %s: %v", beConfigKey, err)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	context2 "context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	api_v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/test"
+)
+
+func TestApplyBackendConfigUsages(t *testing.T) {
+	for _, tc := range []struct {
+		desc                string
+		usages              map[string]*backendConfigUsage
+		wantIngresses       []string
+		wantBackendServices []string
+		wantAccepted        *meta_v1.ConditionStatus
+		wantProgrammed      meta_v1.ConditionStatus
+		wantReason          backendconfigv1.BackendConfigConditionReason
+	}{
+		{
+			desc:           "not in use",
+			wantProgrammed: meta_v1.ConditionFalse,
+			wantReason:     backendconfigv1.BackendConfigReasonNotInUse,
+		},
+		{
+			desc: "programmed",
+			usages: map[string]*backendConfigUsage{
+				"default/ing-2": {backendServices: sets.NewString("k8s1-be-2", "k8s1-be-1")},
+				"default/ing-1": {backendServices: sets.NewString("k8s1-be-1")},
+			},
+			wantIngresses:       []string{"default/ing-1", "default/ing-2"},
+			wantBackendServices: []string{"k8s1-be-1", "k8s1-be-2"},
+			wantAccepted:        conditionStatus(meta_v1.ConditionTrue),
+			wantProgrammed:      meta_v1.ConditionTrue,
+			wantReason:          backendconfigv1.BackendConfigReasonProgrammed,
+		},
+		{
+			desc: "invalid for one ingress",
+			usages: map[string]*backendConfigUsage{
+				"default/ing-1": {backendServices: sets.NewString("k8s1-be-1")},
+				"default/ing-2": {backendServices: sets.NewString(), invalidErr: errors.New("iap and cdn cannot be enabled at the same time")},
+			},
+			wantIngresses:       []string{"default/ing-1", "default/ing-2"},
+			wantBackendServices: []string{"k8s1-be-1"},
+			wantAccepted:        conditionStatus(meta_v1.ConditionFalse),
+			wantProgrammed:      meta_v1.ConditionFalse,
+			wantReason:          backendconfigv1.BackendConfigReasonInvalid,
+		},
+		{
+			desc: "sync failed",
+			usages: map[string]*backendConfigUsage{
+				"default/ing-1": {backendServices: sets.NewString("k8s1-be-1"), syncErr: errors.New("quota exceeded")},
+			},
+			wantIngresses:       []string{"default/ing-1"},
+			wantBackendServices: []string{"k8s1-be-1"},
+			wantAccepted:        conditionStatus(meta_v1.ConditionTrue),
+			wantProgrammed:      meta_v1.ConditionFalse,
+			wantReason:          backendconfigv1.BackendConfigReasonSyncFailed,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			status := &backendconfigv1.BackendConfigStatus{
+				Ingresses:       []string{"default/old"},
+				BackendServices: []string{"k8s1-old"},
+			}
+			applyBackendConfigUsages(status, 1, tc.usages)
+
+			if !reflect.DeepEqual(status.Ingresses, tc.wantIngresses) {
+				t.Errorf("Got ingresses %v, want %v", status.Ingresses, tc.wantIngresses)
+			}
+			if !reflect.DeepEqual(status.BackendServices, tc.wantBackendServices) {
+				t.Errorf("Got backend services %v, want %v", status.BackendServices, tc.wantBackendServices)
+			}
+			accepted := apimeta.FindStatusCondition(status.Conditions, string(backendconfigv1.BackendConfigConditionAccepted))
+			if tc.wantAccepted == nil && accepted != nil {
+				t.Errorf("Got Accepted condition %+v, want none", accepted)
+			}
+			if tc.wantAccepted != nil && (accepted == nil || accepted.Status != *tc.wantAccepted) {
+				t.Errorf("Got Accepted condition %+v, want status %s", accepted, *tc.wantAccepted)
+			}
+			programmed := apimeta.FindStatusCondition(status.Conditions, string(backendconfigv1.BackendConfigConditionProgrammed))
+			if programmed == nil || programmed.Status != tc.wantProgrammed || programmed.Reason != string(tc.wantReason) || programmed.ObservedGeneration != 1 {
+				t.Errorf("Got Programmed condition %+v, want status %s with reason %s", programmed, tc.wantProgrammed, tc.wantReason)
+			}
+		})
+	}
+}
+
+func TestRecordBackendConfigUsages(t *testing.T) {
+	lbc := newLoadBalancerController()
+	beConfig := &backendconfigv1.BackendConfig{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: "config"},
+	}
+	if _, err := lbc.ctx.BackendConfigClient.CloudV1().BackendConfigs("default").Create(context2.TODO(), beConfig, meta_v1.CreateOptions{}); err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+	lbc.ctx.BackendConfigInformer.GetIndexer().Add(beConfig)
+
+	getStatus := func() backendconfigv1.BackendConfigStatus {
+		t.Helper()
+		got, err := lbc.ctx.BackendConfigClient.CloudV1().BackendConfigs("default").Get(context2.TODO(), "config", meta_v1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Get() = %v", err)
+		}
+		// Mimic the informer.
+		lbc.ctx.BackendConfigInformer.GetIndexer().Update(got)
+		return got.Status
+	}
+
+	lbc.recordBackendConfigUsages("default/ing-1", map[string]*backendConfigUsage{
+		"default/config": {backendServices: sets.NewString("k8s1-be-1")},
+	})
+	lbc.recordBackendConfigUsages("default/ing-2", map[string]*backendConfigUsage{
+		"default/config": {backendServices: sets.NewString("k8s1-be-2")},
+	})
+	status := getStatus()
+	if want := []string{"default/ing-1", "default/ing-2"}; !reflect.DeepEqual(status.Ingresses, want) {
+		t.Errorf("Got ingresses %v, want %v", status.Ingresses, want)
+	}
+	if want := []string{"k8s1-be-1", "k8s1-be-2"}; !reflect.DeepEqual(status.BackendServices, want) {
+		t.Errorf("Got backend services %v, want %v", status.BackendServices, want)
+	}
+	if !apimeta.IsStatusConditionTrue(status.Conditions, string(backendconfigv1.BackendConfigConditionProgrammed)) {
+		t.Errorf("Got conditions %+v, want Programmed", status.Conditions)
+	}
+
+	// Forget both Ingresses.
+	lbc.recordBackendConfigUsages("default/ing-1", nil)
+	getStatus()
+	lbc.recordBackendConfigUsages("default/ing-2", nil)
+	status = getStatus()
+	if len(status.Ingresses) != 0 || len(status.BackendServices) != 0 {
+		t.Errorf("Got ingresses %v and backend services %v, want none", status.Ingresses, status.BackendServices)
+	}
+	if cond := apimeta.FindStatusCondition(status.Conditions, string(backendconfigv1.BackendConfigConditionProgrammed)); cond == nil || cond.Reason != string(backendconfigv1.BackendConfigReasonNotInUse) {
+		t.Errorf("Got Programmed condition %+v, want reason %s", cond, backendconfigv1.BackendConfigReasonNotInUse)
+	}
+	if len(lbc.backendConfigUsages) != 0 {
+		t.Errorf("Got usages %v, want none", lbc.backendConfigUsages)
+	}
+}
+
+func TestInitBackendConfigUsages(t *testing.T) {
+	lbc := newLoadBalancerController()
+	beConfig := &backendconfigv1.BackendConfig{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: "config"},
+	}
+	if _, err := lbc.ctx.BackendConfigClient.CloudV1().BackendConfigs("default").Create(context2.TODO(), beConfig, meta_v1.CreateOptions{}); err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+	lbc.ctx.BackendConfigInformer.GetIndexer().Add(beConfig)
+
+	svc := test.NewService(types.NamespacedName{Name: "my-service", Namespace: "default"}, api_v1.ServiceSpec{
+		Type:  api_v1.ServiceTypeNodePort,
+		Ports: []api_v1.ServicePort{{Port: 80}},
+	})
+	svc.Annotations = map[string]string{annotations.BackendConfigKey: `{"default":"config"}`}
+	addService(lbc, svc)
+	someBackend := backend("my-service", networkingv1.ServiceBackendPort{Number: 80})
+	var ings []*networkingv1.Ingress
+	for _, name := range []string{"ing-1", "ing-2"} {
+		ing := test.NewIngress(types.NamespacedName{Name: name, Namespace: "default"}, networkingv1.IngressSpec{
+			DefaultBackend: &someBackend,
+		})
+		addIngress(lbc, ing)
+		ings = append(ings, ing)
+	}
+
+	// After a restart, the first synced Ingress does not hide the others.
+	ingKey := getKey(ings[0], t)
+	if err := lbc.sync(ingKey); err != nil {
+		t.Fatalf("lbc.sync(%v) = %v, want nil", ingKey, err)
+	}
+	got, err := lbc.ctx.BackendConfigClient.CloudV1().BackendConfigs("default").Get(context2.TODO(), "config", meta_v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+	if want := []string{"default/ing-1", "default/ing-2"}; !reflect.DeepEqual(got.Status.Ingresses, want) {
+		t.Errorf("Got ingresses %v, want %v", got.Status.Ingresses, want)
+	}
+}
+
+func TestEnqueueAtSignedUrlKeyDeletion(t *testing.T) {
+	lbc := newLoadBalancerController()
+	ing := test.NewIngress(types.NamespacedName{Name: "ing", Namespace: "default"}, networkingv1.IngressSpec{})
+	beConfig := &backendconfigv1.BackendConfig{
+		ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: "config"},
+	}
+
+	// Nothing is requeued without retiring keys.
+	lbc.enqueueAtSignedUrlKeyDeletion(beConfig, []*networkingv1.Ingress{ing})
+	beConfig.Status.RetiringSignedUrlKeys = []backendconfigv1.RetiringSignedUrlKey{
+		{BackendService: "k8s1-be", KeyName: "key-1", DeletionTime: meta_v1.NewTime(time.Now().Add(time.Hour))},
+		{BackendService: "k8s1-be", KeyName: "key-2", DeletionTime: meta_v1.NewTime(time.Now().Add(100 * time.Millisecond))},
+	}
+	lbc.enqueueAtSignedUrlKeyDeletion(beConfig, []*networkingv1.Ingress{ing})
+	if got := lbc.ingQueue.Len(); got != 0 {
+		t.Fatalf("Got %d queued Ingresses, want none before the deletion time", got)
+	}
+
+	// The Ingress is requeued at the first deletion time.
+	if err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return lbc.ingQueue.Len() == 1, nil
+	}); err != nil {
+		t.Errorf("Ingress was not requeued at the deletion time of key-2: %v", err)
+	}
+}
+
+func conditionStatus(status meta_v1.ConditionStatus) *meta_v1.ConditionStatus {
+	return &status
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/ingress-gce/pkg/loadbalancers/metrics"
+	"k8s.io/ingress-gce/pkg/translator"
+	"k8s.io/ingress-gce/pkg/utils/common"
+)
+
+// checkTLSCertExpiry exports the time until expiry of the TLS certificates of
+// the Ingress and emits a warning event once for every configured threshold
+// which the time until expiry of a certificate drops below.
+func (lbc *LoadBalancerController) checkTLSCertExpiry(ing *v1.Ingress, tls []*translator.TLSCerts) {
+	ingKey := common.NamespacedName(ing)
+	now := time.Now()
+	expiries := map[string]time.Duration{}
+
+	lbc.tlsCertExpiryWarningsLock.Lock()
+	defer lbc.tlsCertExpiryWarningsLock.Unlock()
+	warned := lbc.tlsCertExpiryWarnings[ingKey]
+	crossed := map[string]time.Duration{}
+	for _, cert := range tls {
+		expiresIn := cert.NotAfter.Sub(now)
+		expiries[cert.Name] = expiresIn
+
+		threshold := tlsCertExpiryThreshold(expiresIn, flags.F.TLSCertExpiryWarningThresholds.Values())
+		if threshold == 0 {
+			continue
+		}
+		crossed[cert.CertHash] = threshold
+		if warned[cert.CertHash] == threshold {
+			continue
+		}
+		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.TLSCertExpiring,
+			"Certificate of secret %q expires in less than %s, at %v", cert.Name, formatThreshold(threshold), cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+	if len(crossed) == 0 {
+		delete(lbc.tlsCertExpiryWarnings, ingKey)
+	} else {
+		lbc.tlsCertExpiryWarnings[ingKey] = crossed
+	}
+	metrics.PublishTLSCertExpiryMetrics(ingKey, expiries)
+}
+
+// deleteTLSCertExpiry removes the exported time until expiry of the TLS
+// certificates of the Ingress with the given key.
+func (lbc *LoadBalancerController) deleteTLSCertExpiry(ingKey string) {
+	lbc.tlsCertExpiryWarningsLock.Lock()
+	delete(lbc.tlsCertExpiryWarnings, ingKey)
+	lbc.tlsCertExpiryWarningsLock.Unlock()
+	metrics.DeleteTLSCertExpiryMetrics(ingKey)
+}
+
+// tlsCertExpiryThreshold returns the shortest of the thresholds which the time
+// until expiry is below, or zero if it is below none of them.
+func tlsCertExpiryThreshold(expiresIn time.Duration, thresholds []time.Duration) time.Duration {
+	var crossed time.Duration
+	for _, threshold := range thresholds {
+		if expiresIn < threshold && (crossed == 0 || threshold < crossed) {
+			crossed = threshold
+		}
+	}
+	return crossed
+}
+
+// formatThreshold formats whole days as such and other durations as is.
+func formatThreshold(threshold time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case threshold == day:
+		return "1 day"
+	case threshold%day == 0:
+		return fmt.Sprintf("%d days", threshold/day)
+	default:
+		return threshold.String()
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/ingress-gce/pkg/translator"
+)
+
+func TestTLSCertExpiryThreshold(t *testing.T) {
+	const day = 24 * time.Hour
+	thresholds := []time.Duration{30 * day, 7 * day, day}
+
+	for _, tc := range []struct {
+		desc       string
+		expiresIn  time.Duration
+		thresholds []time.Duration
+		want       time.Duration
+	}{
+		{
+			desc:       "no thresholds",
+			expiresIn:  time.Hour,
+			thresholds: nil,
+			want:       0,
+		},
+		{
+			desc:       "above all thresholds",
+			expiresIn:  60 * day,
+			thresholds: thresholds,
+			want:       0,
+		},
+		{
+			desc:       "below the longest threshold",
+			expiresIn:  20 * day,
+			thresholds: thresholds,
+			want:       30 * day,
+		},
+		{
+			desc:       "at a threshold",
+			expiresIn:  7 * day,
+			thresholds: thresholds,
+			want:       30 * day,
+		},
+		{
+			desc:       "below the shortest threshold",
+			expiresIn:  time.Hour,
+			thresholds: thresholds,
+			want:       day,
+		},
+		{
+			desc:       "unsorted thresholds",
+			expiresIn:  5 * day,
+			thresholds: []time.Duration{day, 30 * day, 7 * day},
+			want:       7 * day,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := tlsCertExpiryThreshold(tc.expiresIn, tc.thresholds); got != tc.want {
+				t.Errorf("tlsCertExpiryThreshold(%v, %v) = %v, want %v", tc.expiresIn, tc.thresholds, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFormatThreshold(t *testing.T) {
+	for _, tc := range []struct {
+		threshold time.Duration
+		want      string
+	}{
+		{threshold: 24 * time.Hour, want: "1 day"},
+		{threshold: 30 * 24 * time.Hour, want: "30 days"},
+		{threshold: 36 * time.Hour, want: "36h0m0s"},
+	} {
+		if got := formatThreshold(tc.threshold); got != tc.want {
+			t.Errorf("formatThreshold(%v) = %q, want %q", tc.threshold, got, tc.want)
+		}
+	}
+}
+
+// TestCheckTLSCertExpiry asserts that a warning is emitted once for every
+// threshold which the time until expiry of a certificate drops below.
+func TestCheckTLSCertExpiry(t *testing.T) {
+	const day = 24 * time.Hour
+	lbc := newLoadBalancerController()
+	ing := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "ing", Namespace: "default"}}
+	cert := &translator.TLSCerts{Name: "cert", CertHash: "hash"}
+
+	for _, expiresIn := range []time.Duration{60 * day, 20 * day, 19 * day, 5 * day, 4 * day} {
+		cert.NotAfter = time.Now().Add(expiresIn)
+		lbc.checkTLSCertExpiry(ing, []*translator.TLSCerts{cert})
+	}
+
+	// Warnings for the 30 and 7 days thresholds.
+	const want = 2
+	var got int32
+	err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		evts, err := lbc.ctx.KubeClient.CoreV1().Events(ing.Namespace).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		got = 0
+		for _, evt := range evts.Items {
+			if evt.Type == apiv1.EventTypeWarning {
+				got += evt.Count
+			}
+		}
+		return got >= want, nil
+	})
+	if err != nil {
+		t.Fatalf("got %d warning events, want %d: %v", got, want, err)
+	}
+	if got != want {
+		t.Errorf("got %d warning events, want %d", got, want)
+	}
+	if warned := lbc.tlsCertExpiryWarnings["default/ing"]["hash"]; warned != 7*day {
+		t.Errorf("warned threshold = %v, want %v", warned, 7*day)
+	}
+
+	lbc.deleteTLSCertExpiry("default/ing")
+	if _, ok := lbc.tlsCertExpiryWarnings["default/ing"]; ok {
+		t.Errorf("warned thresholds of default/ing were not deleted")
+	}
+}
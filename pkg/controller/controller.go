@@ -35,6 +35,7 @@ import (
 	"k8s.io/ingress-gce/pkg/annotations"
 	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/backends"
 	"k8s.io/ingress-gce/pkg/common/operator"
 	"k8s.io/ingress-gce/pkg/context"
@@ -101,6 +102,31 @@ type LoadBalancerController struct {
 
 	ingClassLister  cache.Indexer
 	ingParamsLister cache.Indexer
+
+	// backendSources return the service ports of load balancers managed by
+	// other controllers, such as the Gateway controller. Their backends are
+	// kept during garbage collection.
+	backendSources []func() []utils.ServicePort
+
+	// sharedLBGroups maps the keys of Ingresses to their shared load balancer
+	// group, so that groups are garbage collected when Ingresses leave them.
+	sharedLBGroups     map[string]string
+	sharedLBGroupsLock sync.Mutex
+
+	// backendConfigUsages maps the keys of BackendConfigs to their usages,
+	// keyed by the keys of the Ingresses which use them. They are reported in
+	// the status of the BackendConfigs.
+	backendConfigUsages     map[string]map[string]*backendConfigUsage
+	backendConfigUsagesLock sync.Mutex
+	// backendConfigUsagesInit rebuilds the usages of BackendConfigs from the
+	// caches once they are synced.
+	backendConfigUsagesInit sync.Once
+
+	// tlsCertExpiryWarnings maps the keys of Ingresses to the expiry
+	// thresholds for which a warning was emitted, keyed by the hashes of the
+	// certificates.
+	tlsCertExpiryWarnings     map[string]map[string]time.Duration
+	tlsCertExpiryWarningsLock sync.Mutex
 }
 
 // NewLoadBalancerController creates a controller for gce loadbalancers.
@@ -122,17 +148,21 @@ func NewLoadBalancerController(
 	backendPool := backends.NewPool(ctx.Cloud, ctx.ClusterNamer)
 
 	lbc := LoadBalancerController{
-		ctx:           ctx,
-		nodeLister:    ctx.NodeInformer.GetIndexer(),
-		Translator:    ctx.Translator,
-		stopCh:        stopCh,
-		hasSynced:     ctx.HasSynced,
-		instancePool:  ctx.InstancePool,
-		l7Pool:        loadbalancers.NewLoadBalancerPool(ctx.Cloud, ctx.ClusterNamer, ctx, namer.NewFrontendNamerFactory(ctx.ClusterNamer, ctx.KubeSystemUID)),
-		backendSyncer: backends.NewBackendSyncer(backendPool, healthChecker, ctx.Cloud),
-		negLinker:     backends.NewNEGLinker(backendPool, negtypes.NewAdapter(ctx.Cloud), ctx.Cloud, ctx.SvcNegInformer.GetIndexer()),
-		igLinker:      backends.NewInstanceGroupLinker(ctx.InstancePool, backendPool),
-		metrics:       ctx.ControllerMetrics,
+		ctx:            ctx,
+		nodeLister:     ctx.NodeInformer.GetIndexer(),
+		Translator:     ctx.Translator,
+		stopCh:         stopCh,
+		hasSynced:      ctx.HasSynced,
+		instancePool:   ctx.InstancePool,
+		l7Pool:         loadbalancers.NewLoadBalancerPool(ctx.Cloud, ctx.ClusterNamer, ctx, namer.NewFrontendNamerFactory(ctx.ClusterNamer, ctx.KubeSystemUID)),
+		backendSyncer:  backends.NewBackendSyncer(backendPool, healthChecker, ctx.Cloud, ctx.BackendConfigClient, ctx),
+		negLinker:      backends.NewNEGLinker(backendPool, negtypes.NewAdapter(ctx.Cloud), ctx.Cloud, ctx.SvcNegInformer.GetIndexer()),
+		igLinker:       backends.NewInstanceGroupLinker(ctx.InstancePool, backendPool),
+		metrics:        ctx.ControllerMetrics,
+		sharedLBGroups: make(map[string]string),
+
+		backendConfigUsages:   make(map[string]map[string]*backendConfigUsage),
+		tlsCertExpiryWarnings: make(map[string]map[string]time.Duration),
 	}
 
 	if ctx.IngClassInformer != nil {
@@ -205,13 +235,13 @@ func NewLoadBalancerController(
 	ctx.ServiceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			svc := obj.(*apiv1.Service)
-			ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesService(svc).AsList()
+			ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesService(svc, operator.RouteConfigs(ctx.RouteConfigs().List())).AsList()
 			lbc.ingQueue.Enqueue(convert(ings)...)
 		},
 		UpdateFunc: func(old, cur interface{}) {
 			if !reflect.DeepEqual(old, cur) {
 				svc := cur.(*apiv1.Service)
-				ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesService(svc).AsList()
+				ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesService(svc, operator.RouteConfigs(ctx.RouteConfigs().List())).AsList()
 				lbc.ingQueue.Enqueue(convert(ings)...)
 			}
 		},
@@ -223,15 +253,22 @@ func NewLoadBalancerController(
 		AddFunc: func(obj interface{}) {
 			klog.V(3).Infof("obj(type %T) added", obj)
 			beConfig := obj.(*backendconfigv1.BackendConfig)
-			ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesBackendConfig(beConfig, operator.Services(ctx.Services().List())).AsList()
+			ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesBackendConfig(beConfig, operator.Services(ctx.Services().List()), operator.RouteConfigs(ctx.RouteConfigs().List())).AsList()
 			lbc.ingQueue.Enqueue(convert(ings)...)
+			lbc.enqueueAtSignedUrlKeyDeletion(beConfig, ings)
 		},
 		UpdateFunc: func(old, cur interface{}) {
 			if !reflect.DeepEqual(old, cur) {
 				klog.V(3).Infof("obj(type %T) updated", cur)
 				beConfig := cur.(*backendconfigv1.BackendConfig)
-				ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesBackendConfig(beConfig, operator.Services(ctx.Services().List())).AsList()
-				lbc.ingQueue.Enqueue(convert(ings)...)
+				ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesBackendConfig(beConfig, operator.Services(ctx.Services().List()), operator.RouteConfigs(ctx.RouteConfigs().List())).AsList()
+				// Skip updates of the status, which are written by this controller,
+				// so that a failing sync does not trigger itself again. The requeue
+				// at the deletion of the retiring signed URL keys is still scheduled.
+				if !reflect.DeepEqual(old.(*backendconfigv1.BackendConfig).Spec, beConfig.Spec) {
+					lbc.ingQueue.Enqueue(convert(ings)...)
+				}
+				lbc.enqueueAtSignedUrlKeyDeletion(beConfig, ings)
 			}
 		},
 		DeleteFunc: func(obj interface{}) {
@@ -254,7 +291,7 @@ func NewLoadBalancerController(
 				}
 			}
 
-			ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesBackendConfig(beConfig, operator.Services(ctx.Services().List())).AsList()
+			ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesBackendConfig(beConfig, operator.Services(ctx.Services().List()), operator.RouteConfigs(ctx.RouteConfigs().List())).AsList()
 			lbc.ingQueue.Enqueue(convert(ings)...)
 		},
 	})
@@ -300,6 +337,46 @@ func NewLoadBalancerController(
 		})
 	}
 
+	// RouteConfig event handlers.
+	if ctx.RouteConfigEnabled {
+		ctx.RouteConfigInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				routeConfig := obj.(*routeconfigv1beta1.RouteConfig)
+				ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesRouteConfig(routeConfig).AsList()
+				lbc.ingQueue.Enqueue(convert(ings)...)
+			},
+			UpdateFunc: func(old, cur interface{}) {
+				if !reflect.DeepEqual(old, cur) {
+					routeConfig := cur.(*routeconfigv1beta1.RouteConfig)
+					ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesRouteConfig(routeConfig).AsList()
+					lbc.ingQueue.Enqueue(convert(ings)...)
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				var routeConfig *routeconfigv1beta1.RouteConfig
+				var ok, routeOk bool
+				routeConfig, ok = obj.(*routeconfigv1beta1.RouteConfig)
+				if !ok {
+					// This can happen if the watch is closed and misses the delete event
+					state, stateOk := obj.(cache.DeletedFinalStateUnknown)
+					if !stateOk {
+						klog.Errorf("Wanted cache.DeleteFinalStateUnknown of routeconfig obj, got: %+v type: %T", obj, obj)
+						return
+					}
+
+					routeConfig, routeOk = state.Obj.(*routeconfigv1beta1.RouteConfig)
+					if !routeOk {
+						klog.Errorf("Wanted routeconfig obj, got %+v, type %T", state.Obj, state.Obj)
+						return
+					}
+				}
+
+				ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesRouteConfig(routeConfig).AsList()
+				lbc.ingQueue.Enqueue(convert(ings)...)
+			},
+		})
+	}
+
 	// Register health check on controller context.
 	ctx.AddHealthCheck("ingress", func() error {
 		_, err := backendPool.Get("k8s-ingress-svc-acct-permission-check-probe", meta.VersionGA, meta.Global)
@@ -347,9 +424,15 @@ func (lbc *LoadBalancerController) Stop(deleteAll bool) error {
 	// TODO(rramkumar): Do we need deleteAll? Can we get rid of its' flag?
 	if deleteAll {
 		klog.Infof("Shutting down cluster manager.")
-		if err := lbc.l7Pool.Shutdown(lbc.ctx.Ingresses().List()); err != nil {
+		ings := lbc.ctx.Ingresses().List()
+		if err := lbc.l7Pool.Shutdown(ings); err != nil {
 			return err
 		}
+		for _, groupIng := range sharedLBGroupIngresses(ings) {
+			if err := lbc.l7Pool.GCv2(groupIng, meta.Global); err != nil {
+				return fmt.Errorf("error deleting load-balancer of shared load balancer group %s: %v", common.NamespacedName(groupIng), err)
+			}
+		}
 
 		// The backend pool will also delete instance groups.
 		return lbc.backendSyncer.Shutdown()
@@ -357,6 +440,33 @@ func (lbc *LoadBalancerController) Stop(deleteAll bool) error {
 	return nil
 }
 
+// enqueueAtSignedUrlKeyDeletion requeues the Ingresses using the BackendConfig
+// once the grace period of its first retiring signed URL key expired, so that
+// the key is deleted without waiting for an unrelated sync.
+func (lbc *LoadBalancerController) enqueueAtSignedUrlKeyDeletion(beConfig *backendconfigv1.BackendConfig, ings []*v1.Ingress) {
+	var deletionTime time.Time
+	for _, key := range beConfig.Status.RetiringSignedUrlKeys {
+		if deletionTime.IsZero() || key.DeletionTime.Time.Before(deletionTime) {
+			deletionTime = key.DeletionTime.Time
+		}
+	}
+	if deletionTime.IsZero() {
+		return
+	}
+	after := time.Until(deletionTime)
+	klog.V(3).Infof("Requeuing %d Ingresses using BackendConfig %s/%s in %v to delete its retiring signed URL keys", len(ings), beConfig.Namespace, beConfig.Name, after)
+	for _, ing := range ings {
+		lbc.ingQueue.EnqueueAfter(ing, after)
+	}
+}
+
+// RegisterBackendSource registers a function returning the service ports of
+// load balancers not managed by this controller, whose backends must not be
+// garbage collected. It must be called before the controller is run.
+func (lbc *LoadBalancerController) RegisterBackendSource(source func() []utils.ServicePort) {
+	lbc.backendSources = append(lbc.backendSources, source)
+}
+
 // SyncBackends implements Controller.
 func (lbc *LoadBalancerController) SyncBackends(state interface{}) error {
 	// TODO: Only lock per resource
@@ -463,12 +573,18 @@ func (lbc *LoadBalancerController) GCBackends(toKeep []*v1.Ingress) error {
 	// Only GCE ingress associated resources are managed by this controller.
 	GCEIngresses := operator.Ingresses(toKeep).Filter(utils.IsGCEIngress).AsList()
 	svcPortsToKeep := lbc.ToSvcPorts(GCEIngresses)
+	var sourcePorts []utils.ServicePort
+	for _, source := range lbc.backendSources {
+		sourcePorts = append(sourcePorts, source()...)
+	}
+	svcPortsToKeep = append(svcPortsToKeep, sourcePorts...)
 	if err := lbc.backendSyncer.GC(svcPortsToKeep); err != nil {
 		return err
 	}
 	// TODO(ingress#120): Move this to the backend pool so it mirrors creation
-	// Do not delete instance group if there exists a GLBC ingress.
-	if len(toKeep) == 0 {
+	// Do not delete instance group if there exists a GLBC ingress or another
+	// load balancer still uses backends.
+	if len(toKeep) == 0 && len(sourcePorts) == 0 {
 		igName := lbc.ctx.ClusterNamer.InstanceGroup()
 		klog.Infof("Deleting instance group %v", igName)
 		if err := lbc.instancePool.DeleteInstanceGroup(igName); err != err {
@@ -549,6 +665,20 @@ func (lbc *LoadBalancerController) PostProcess(state interface{}) error {
 		return fmt.Errorf("expected state type to be syncState, type was %T", state)
 	}
 
+	// Update the status of all members of a shared load balancer group.
+	if len(syncState.members) > 0 {
+		var errs []error
+		for _, member := range syncState.members {
+			if err := lbc.updateIngressStatus(syncState.l7, member); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return utils.JoinErrs(errs)
+		}
+		return nil
+	}
+
 	// Update the ingress status.
 	return lbc.updateIngressStatus(syncState.l7, syncState.ing)
 }
@@ -576,6 +706,9 @@ func (lbc *LoadBalancerController) preSyncGC(key string, scope meta.KeyType, ing
 		if err == nil && ingExists {
 			lbc.metrics.DeleteIngress(key)
 		}
+		if err == nil {
+			lbc.deleteTLSCertExpiry(key)
+		}
 		return false, err
 	}
 	return true, nil
@@ -606,6 +739,7 @@ func (lbc *LoadBalancerController) sync(key string) error {
 		time.Sleep(context.StoreSyncPollPeriod)
 		return fmt.Errorf("waiting for stores to sync")
 	}
+	lbc.backendConfigUsagesInit.Do(lbc.initBackendConfigUsages)
 	klog.V(3).Infof("Syncing %v", key)
 
 	ing, ingExists, err := lbc.ctx.Ingresses().GetByKey(key)
@@ -613,6 +747,11 @@ func (lbc *LoadBalancerController) sync(key string) error {
 		return fmt.Errorf("error getting Ingress for key %s: %v", key, err)
 	}
 
+	// Garbage collect the shared load balancer group the ingress left, if any.
+	if err := lbc.syncSharedLBGroupMembership(key, ingExists, ing); err != nil {
+		return err
+	}
+
 	// Capture GC state for ingress.
 	scope := features.ScopeFromIngress(ing)
 	needSync, err := lbc.preSyncGC(key, scope, ingExists, ing)
@@ -620,6 +759,7 @@ func (lbc *LoadBalancerController) sync(key string) error {
 		return err
 	}
 	if !needSync {
+		lbc.recordBackendConfigUsages(key, nil)
 		klog.V(2).Infof("Ingress %q does not need to be synced. Skipping sync", key)
 		return nil
 	}
@@ -637,6 +777,7 @@ func (lbc *LoadBalancerController) sync(key string) error {
 	if errs != nil {
 		msg := fmt.Errorf("invalid ingress spec: %v", utils.JoinErrs(errs))
 		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.TranslateIngress, "Translation failed: %v", msg)
+		lbc.recordBackendConfigUsages(key, backendConfigUsages(urlMap, errs, msg))
 		return msg
 	}
 
@@ -645,9 +786,25 @@ func (lbc *LoadBalancerController) sync(key string) error {
 		lbc.ctx.Recorder(ing.Namespace).Event(ing, apiv1.EventTypeWarning, "THCAnnotationWithoutFlag", msg)
 	}
 
+	syncState := &syncState{urlMap: urlMap, ing: ing}
+	group, err := annotations.FromIngress(ing).SharedLBGroup()
+	if err == nil && group != "" && sharedLBGroup(ing) == "" {
+		err = fmt.Errorf("annotation %s is only supported on external ingresses", annotations.SharedLBGroupKey)
+	}
+	if err != nil {
+		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.TranslateIngress, "Translation failed: %v", err)
+		return err
+	}
+	if group != "" {
+		if syncState, err = lbc.sharedLBGroupSyncState(ing, group, urlMap); err != nil {
+			lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.SyncIngress, "Error syncing shared load balancer group %q: %v", group, err)
+			return err
+		}
+	}
+
 	// Sync GCP resources.
-	syncState := &syncState{urlMap, ing, nil}
 	syncErr := lbc.ingSyncer.Sync(syncState)
+	lbc.recordBackendConfigUsages(key, backendConfigUsages(urlMap, nil, syncErr))
 	if syncErr != nil {
 		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.SyncIngress, "Error syncing to GCP: %v", syncErr.Error())
 	} else {
@@ -721,10 +878,14 @@ func (lbc *LoadBalancerController) toRuntimeInfo(ing *v1.Ingress, urlMap *utils.
 	}
 
 	tls, errors := translator.ToTLSCerts(env)
+	lbc.checkTLSCertExpiry(ing, tls)
 	for _, err := range errors {
 		if apierrors.IsNotFound(err) {
 			msg := fmt.Sprintf("Could not find TLS certificate: %v", err)
 			lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.SyncIngress, msg)
+		} else if _, ok := err.(*translator.InvalidCertError); ok {
+			// Only the invalid certificate is left out of the load balancer.
+			lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.SyncIngress, "Ignoring TLS certificate: %v", err)
 		} else {
 			klog.Errorf("Could not get certificates for ingress %s/%s: %v", ing.Namespace, ing.Name, err)
 			return nil, err
@@ -732,7 +893,8 @@ func (lbc *LoadBalancerController) toRuntimeInfo(ing *v1.Ingress, urlMap *utils.
 	}
 
 	// Setup HTTP-only if no valid TLS certs
-	// The errors are assumed to be 404s since we short-circuit otherwise
+	// The errors are assumed to be 404s or invalid certificates since we
+	// short-circuit otherwise
 	if len(tls) == 0 && len(errors) > 0 {
 		// TODO: this path should be removed when external certificate managers migrate to a better solution.
 		const msg = "Could not find any TLS certificates. Continuing setup for the load balancer to serve HTTP only. Note: this behavior is deprecated and will be removed in a future version of ingress-gce"
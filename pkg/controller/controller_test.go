@@ -72,7 +72,9 @@ func newLoadBalancerController() *LoadBalancerController {
 		DefaultBackendSvcPort: test.DefaultBeSvcPort,
 		HealthCheckPath:       "/",
 	}
-	ctx := context.NewControllerContext(nil, kubeClient, backendConfigClient, nil, nil, nil, nil, nil, nil, fakeGCE, namer, "" /*kubeSystemUID*/, ctxConfig)
+	ctx := context.NewControllerContext(nil, kubeClient, backendConfigClient, nil, nil, nil, nil, nil, nil, nil, nil, nil, fakeGCE, namer, "" /*kubeSystemUID*/, ctxConfig)
+	// The ingress queue is only created with a positive number of workers.
+	flags.F.NumIngressWorkers = 1
 	lbc := NewLoadBalancerController(ctx, stopCh)
 	// TODO(rramkumar): Fix this so we don't have to override with our fake
 	lbc.instancePool = instancegroups.NewManager(&instancegroups.ManagerConfig{
@@ -468,6 +470,145 @@ func TestIngressesWithSharedResourcesWithFinalizer(t *testing.T) {
 	}
 }
 
+// TestSharedLBGroupWithFinalizer asserts that the Ingresses of a shared load
+// balancer group share one load balancer, which is deleted with the last member.
+// Note: This test cannot be run in parallel as it stubs global flags.
+func TestSharedLBGroupWithFinalizer(t *testing.T) {
+	flagSaver := test.NewFlagSaver()
+	flagSaver.Save(test.FinalizerAddFlag, &flags.F.FinalizerAdd)
+	defer flagSaver.Reset(test.FinalizerAddFlag, &flags.F.FinalizerAdd)
+	flagSaver.Save(test.FinalizerRemoveFlag, &flags.F.FinalizerRemove)
+	defer flagSaver.Reset(test.FinalizerRemoveFlag, &flags.F.FinalizerRemove)
+	flags.F.FinalizerAdd = true
+	flags.F.FinalizerRemove = true
+	lbc := newLoadBalancerController()
+	svc := test.NewService(types.NamespacedName{Name: "my-service", Namespace: "default"}, api_v1.ServiceSpec{
+		Type:  api_v1.ServiceTypeNodePort,
+		Ports: []api_v1.ServicePort{{Port: 80}},
+	})
+	addService(lbc, svc)
+	svcBackend := backend("my-service", networkingv1.ServiceBackendPort{Number: 80})
+
+	var ings []*networkingv1.Ingress
+	for i, host := range []string{"foo.example.com", "bar.example.com"} {
+		ing := test.NewIngress(types.NamespacedName{Name: fmt.Sprintf("ing-%d", i), Namespace: "default"},
+			networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{
+					{
+						Host: host,
+						IngressRuleValue: networkingv1.IngressRuleValue{
+							HTTP: &networkingv1.HTTPIngressRuleValue{
+								Paths: []networkingv1.HTTPIngressPath{{Path: "/*", Backend: svcBackend}},
+							},
+						},
+					},
+				},
+			})
+		ing.CreationTimestamp = meta_v1.NewTime(time.Now().Add(time.Duration(i) * time.Minute))
+		ing.Annotations = map[string]string{annotations.SharedLBGroupKey: "frontends"}
+		addIngress(lbc, ing)
+		ings = append(ings, ing)
+	}
+
+	for _, ing := range ings {
+		ingStoreKey := getKey(ing, t)
+		if err := lbc.sync(ingStoreKey); err != nil {
+			t.Fatalf("lbc.sync(%v) = %v, want nil", ingStoreKey, err)
+		}
+	}
+
+	groupIng := sharedLBGroupIngress("default", "frontends", nil)
+	if hasUrlMap, err := lbc.l7Pool.HasUrlMap(groupIng); err != nil || !hasUrlMap {
+		t.Fatalf("HasUrlMap(%v) = %v, %v; want true, nil", groupIng.Name, hasUrlMap, err)
+	}
+	var ips []string
+	for _, ing := range ings {
+		updatedIng := getUpdatedIngress(t, lbc, ing)
+		if hasUrlMap, err := lbc.l7Pool.HasUrlMap(updatedIng); err != nil || hasUrlMap {
+			t.Errorf("HasUrlMap(%v) = %v, %v; want false, nil", updatedIng.Name, hasUrlMap, err)
+		}
+		if len(updatedIng.Status.LoadBalancer.Ingress) != 1 || updatedIng.Status.LoadBalancer.Ingress[0].IP == "" {
+			t.Fatalf("Get(%q) = status %+v, want non-empty", updatedIng.Name, updatedIng.Status.LoadBalancer.Ingress)
+		}
+		ips = append(ips, updatedIng.Status.LoadBalancer.Ingress[0].IP)
+	}
+	if ips[0] != ips[1] {
+		t.Errorf("Got IPs %v, want the same IP for all members of the group", ips)
+	}
+
+	for i, ing := range ings {
+		ing = getUpdatedIngress(t, lbc, ing)
+		setDeletionTimestamp(lbc, ing)
+		ingStoreKey := getKey(ing, t)
+		if err := lbc.sync(ingStoreKey); err != nil {
+			t.Fatalf("lbc.sync(%v) = %v, want nil", ingStoreKey, err)
+		}
+		deleteIngress(lbc, getUpdatedIngress(t, lbc, ing))
+
+		wantUrlMap := i < len(ings)-1
+		if hasUrlMap, err := lbc.l7Pool.HasUrlMap(groupIng); err != nil || hasUrlMap != wantUrlMap {
+			t.Errorf("After deleting %s, HasUrlMap(%v) = %v, %v; want %v, nil", ing.Name, groupIng.Name, hasUrlMap, err, wantUrlMap)
+		}
+	}
+}
+
+// TestSharedLBGroupChangeAfterRestart asserts that the load balancer of a
+// shared load balancer group is deleted when an Ingress leaves the group while
+// the controller is down.
+func TestSharedLBGroupChangeAfterRestart(t *testing.T) {
+	flagSaver := test.NewFlagSaver()
+	flagSaver.Save(test.FinalizerAddFlag, &flags.F.FinalizerAdd)
+	defer flagSaver.Reset(test.FinalizerAddFlag, &flags.F.FinalizerAdd)
+	flags.F.FinalizerAdd = true
+	lbc := newLoadBalancerController()
+	svc := test.NewService(types.NamespacedName{Name: "my-service", Namespace: "default"}, api_v1.ServiceSpec{
+		Type:  api_v1.ServiceTypeNodePort,
+		Ports: []api_v1.ServicePort{{Port: 80}},
+	})
+	addService(lbc, svc)
+	svcBackend := backend("my-service", networkingv1.ServiceBackendPort{Number: 80})
+	ing := test.NewIngress(types.NamespacedName{Name: "ing", Namespace: "default"},
+		networkingv1.IngressSpec{
+			DefaultBackend: &svcBackend,
+		})
+	ing.Annotations = map[string]string{annotations.SharedLBGroupKey: "frontends"}
+	addIngress(lbc, ing)
+
+	ingStoreKey := getKey(ing, t)
+	if err := lbc.sync(ingStoreKey); err != nil {
+		t.Fatalf("lbc.sync(%v) = %v, want nil", ingStoreKey, err)
+	}
+	ing = getUpdatedIngress(t, lbc, ing)
+	if got := ing.Annotations[annotations.SharedLBGroupStatusKey]; got != "frontends" {
+		t.Errorf("Annotation %s = %q, want %q", annotations.SharedLBGroupStatusKey, got, "frontends")
+	}
+	oldGroupIng := sharedLBGroupIngress("default", "frontends", nil)
+	if hasUrlMap, err := lbc.l7Pool.HasUrlMap(oldGroupIng); err != nil || !hasUrlMap {
+		t.Fatalf("HasUrlMap(%v) = %v, %v; want true, nil", oldGroupIng.Name, hasUrlMap, err)
+	}
+
+	// Simulate a restart of the controller, which forgets the groups of the
+	// Ingresses, while the Ingress moves to another group.
+	lbc.sharedLBGroups = make(map[string]string)
+	ing.Annotations[annotations.SharedLBGroupKey] = "backends"
+	updateIngress(lbc, ing)
+	if err := lbc.sync(ingStoreKey); err != nil {
+		t.Fatalf("lbc.sync(%v) = %v, want nil", ingStoreKey, err)
+	}
+
+	if hasUrlMap, err := lbc.l7Pool.HasUrlMap(oldGroupIng); err != nil || hasUrlMap {
+		t.Errorf("HasUrlMap(%v) = %v, %v; want false, nil", oldGroupIng.Name, hasUrlMap, err)
+	}
+	newGroupIng := sharedLBGroupIngress("default", "backends", nil)
+	if hasUrlMap, err := lbc.l7Pool.HasUrlMap(newGroupIng); err != nil || !hasUrlMap {
+		t.Errorf("HasUrlMap(%v) = %v, %v; want true, nil", newGroupIng.Name, hasUrlMap, err)
+	}
+	ing = getUpdatedIngress(t, lbc, ing)
+	if got := ing.Annotations[annotations.SharedLBGroupStatusKey]; got != "backends" {
+		t.Errorf("Annotation %s = %q, want %q", annotations.SharedLBGroupStatusKey, got, "backends")
+	}
+}
+
 // TestEnableFinalizer asserts that `sync` does not return error and finalizer is added
 // to existing ingressesToCleanup when lbc is upgraded to enable finalizer.
 // Note: This test cannot be run in parallel as it stubs global flags.
@@ -681,18 +822,23 @@ func TestMCIngressIG(t *testing.T) {
 // are included in the RuntimeInfo.
 func TestToRuntimeInfoCerts(t *testing.T) {
 	lbc := newLoadBalancerController()
+	notAfter := time.Now().Add(365 * 24 * time.Hour).Truncate(time.Second)
+	cert, key, err := test.GenerateCert(notAfter, "foo.example.com")
+	if err != nil {
+		t.Fatalf("test.GenerateCert() = %v", err)
+	}
 	secretsMap := map[string]*api_v1.Secret{
 		"tlsCert": {
 			ObjectMeta: meta_v1.ObjectMeta{
 				Name: "tlsCert",
 			},
 			Data: map[string][]byte{
-				api_v1.TLSCertKey:       []byte("cert"),
-				api_v1.TLSPrivateKeyKey: []byte("key"),
+				api_v1.TLSCertKey:       cert,
+				api_v1.TLSPrivateKeyKey: key,
 			},
 		},
 	}
-	tlsCerts := []*translator.TLSCerts{{Key: "key", Cert: "cert", Name: "tlsCert", CertHash: translator.GetCertHash("cert")}}
+	tlsCerts := []*translator.TLSCerts{{Key: string(key), Cert: string(cert), Name: "tlsCert", CertHash: translator.GetCertHash(string(cert)), NotAfter: notAfter}}
 
 	for _, v := range secretsMap {
 		lbc.ctx.KubeClient.CoreV1().Secrets("").Create(context2.TODO(), v, meta_v1.CreateOptions{})
@@ -726,6 +872,43 @@ func TestToRuntimeInfoCerts(t *testing.T) {
 	}
 }
 
+// TestToRuntimeInfoInvalidCert asserts that an invalid secret-based cert is
+// left out of the RuntimeInfo without failing the others.
+func TestToRuntimeInfoInvalidCert(t *testing.T) {
+	lbc := newLoadBalancerController()
+	notAfter := time.Now().Add(365 * 24 * time.Hour).Truncate(time.Second)
+	for name, host := range map[string]string{"valid": "foo.example.com", "other": "foo.other.com"} {
+		cert, key, err := test.GenerateCert(notAfter, host)
+		if err != nil {
+			t.Fatalf("test.GenerateCert() = %v", err)
+		}
+		secret := &api_v1.Secret{
+			ObjectMeta: meta_v1.ObjectMeta{Name: name},
+			Data: map[string][]byte{
+				api_v1.TLSCertKey:       cert,
+				api_v1.TLSPrivateKeyKey: key,
+			},
+		}
+		lbc.ctx.KubeClient.CoreV1().Secrets("").Create(context2.TODO(), secret, meta_v1.CreateOptions{})
+	}
+
+	ing := &networkingv1.Ingress{
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{
+				{SecretName: "other", Hosts: []string{"foo.example.com"}},
+				{SecretName: "valid", Hosts: []string{"foo.example.com"}},
+			},
+		},
+	}
+	lbInfo, err := lbc.toRuntimeInfo(ing, &utils.GCEURLMap{})
+	if err != nil {
+		t.Fatalf("lbc.toRuntimeInfo() = err %v", err)
+	}
+	if len(lbInfo.TLS) != 1 || lbInfo.TLS[0].Name != "valid" {
+		t.Errorf("lbInfo.TLS = %v, want only the valid cert", lbInfo.TLS)
+	}
+}
+
 // TestIngressTagging asserts that appropriate finalizer that defines frontend naming scheme,
 // is added to ingress being synced.
 func TestIngressTagging(t *testing.T) {
@@ -58,6 +58,19 @@ func (e ErrSvcPortNotFound) Error() string {
 	return fmt.Sprintf("could not find port %q in service %q", e.ServicePortID.Port.String(), e.ServicePortID.Service)
 }
 
+// ErrSharedLBGroupHostConflict is returned when a host of an Ingress is owned
+// by another Ingress of the same shared load balancer group.
+type ErrSharedLBGroupHostConflict struct {
+	Group string
+	Host  string
+	Owner types.NamespacedName
+}
+
+// Error returns the host, the group and the Ingress owning the host.
+func (e ErrSharedLBGroupHostConflict) Error() string {
+	return fmt.Sprintf("host %q of shared load balancer group %q is owned by ingress %q", e.Host, e.Group, e.Owner)
+}
+
 // ErrSvcAppProtosParsing is returned when the service is malformed.
 type ErrSvcAppProtosParsing struct {
 	Service types.NamespacedName
@@ -69,6 +82,19 @@ func (e ErrSvcAppProtosParsing) Error() string {
 	return fmt.Sprintf("could not parse %q annotation on service %q, err: %v", annotations.ServiceApplicationProtocolKey, e.Service, e.Err)
 }
 
+// ErrSvcAppProtocolInvalid is returned when the application protocol of a
+// service port is not supported for the port.
+type ErrSvcAppProtocolInvalid struct {
+	utils.ServicePortID
+	Protocol annotations.AppProtocol
+	Reason   string
+}
+
+// Error returns the app protocol error as string.
+func (e ErrSvcAppProtocolInvalid) Error() string {
+	return fmt.Sprintf("application protocol %q of port %q on service %q is not valid: %s", e.Protocol, e.ServicePortID.Port.String(), e.ServicePortID.Service.String(), e.Reason)
+}
+
 // ErrSvcBackendConfig is returned when there was an error getting the
 // BackendConfig for a service port.
 type ErrSvcBackendConfig struct {
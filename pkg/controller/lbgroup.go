@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	apiv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/ingress-gce/pkg/annotations"
+	ingerrors "k8s.io/ingress-gce/pkg/controller/errors"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/loadbalancers/features"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/common"
+	"k8s.io/klog/v2"
+)
+
+// sharedLBGroupMember is an Ingress of a shared load balancer group together
+// with its translated URL map.
+type sharedLBGroupMember struct {
+	ing    *v1.Ingress
+	urlMap *utils.GCEURLMap
+}
+
+// sharedLBGroup returns the shared load balancer group of the Ingress, or an
+// empty string if the Ingress is not a member of a group. Only external
+// Ingresses which are not being deleted are members of a group.
+func sharedLBGroup(ing *v1.Ingress) string {
+	if utils.NeedsCleanup(ing) || !utils.IsGCEIngress(ing) || features.ScopeFromIngress(ing) != meta.Global {
+		return ""
+	}
+	group, err := annotations.FromIngress(ing).SharedLBGroup()
+	if err != nil {
+		return ""
+	}
+	return group
+}
+
+// sharedLBGroupIngress returns the Ingress that represents a shared load
+// balancer group. Its name is the name of the group and its finalizer selects
+// the shared load balancer group naming scheme. The annotations are copied from
+// the oldest member and the TLS certificates of all members are used.
+func sharedLBGroupIngress(namespace, group string, members []*v1.Ingress) *v1.Ingress {
+	ing := &v1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        group,
+			Finalizers:  []string{common.SharedLBGroupFinalizerKey},
+			Annotations: map[string]string{},
+		},
+	}
+	if len(members) == 0 {
+		return ing
+	}
+
+	for k, v := range members[0].Annotations {
+		ing.Annotations[k] = v
+	}
+	ing.Spec.IngressClassName = members[0].Spec.IngressClassName
+	seenSecrets := map[string]bool{}
+	for _, member := range members {
+		for _, tls := range member.Spec.TLS {
+			if !seenSecrets[tls.SecretName] {
+				seenSecrets[tls.SecretName] = true
+				ing.Spec.TLS = append(ing.Spec.TLS, tls)
+			}
+		}
+	}
+	return ing
+}
+
+// mergeSharedLBGroup merges the URL maps of the members of a shared load
+// balancer group, ordered from the oldest to the newest, into a single URL map.
+// A host is owned by the first member with rules for it. Rules of the other
+// members for an owned host are dropped, the returned conflicts are keyed by
+// the member whose rules were dropped. The default backend of the group is the
+// one of the oldest member.
+func mergeSharedLBGroup(group string, members []sharedLBGroupMember) (*utils.GCEURLMap, map[string][]error) {
+	urlMap := utils.NewGCEURLMap()
+	if len(members) == 0 {
+		return urlMap, nil
+	}
+	urlMap.DefaultBackend = members[0].urlMap.DefaultBackend
+
+	owners := map[string]*v1.Ingress{}
+	conflicts := map[string][]error{}
+	for _, member := range members {
+		for _, hostRule := range member.urlMap.HostRules {
+			if owner, ok := owners[hostRule.Hostname]; ok && owner != member.ing {
+				memberKey := common.NamespacedName(member.ing)
+				conflicts[memberKey] = append(conflicts[memberKey], ingerrors.ErrSharedLBGroupHostConflict{
+					Group: group,
+					Host:  hostRule.Hostname,
+					Owner: types.NamespacedName{Namespace: owner.Namespace, Name: owner.Name},
+				})
+				continue
+			}
+			owners[hostRule.Hostname] = member.ing
+			urlMap.PutPathRulesForHost(hostRule.Hostname, hostRule.Paths)
+			if len(hostRule.RouteRules) > 0 {
+				urlMap.PutRouteRulesForHost(hostRule.Hostname, hostRule.RouteRules)
+			}
+			if hostRule.HeaderAction != nil {
+				urlMap.PutHeaderActionForHost(hostRule.Hostname, hostRule.HeaderAction)
+			}
+			if hostRule.CustomErrorResponsePolicy != nil {
+				urlMap.PutCustomErrorResponsePolicyForHost(hostRule.Hostname, hostRule.CustomErrorResponsePolicy)
+			}
+		}
+	}
+	return urlMap, conflicts
+}
+
+// sharedLBGroupMembers returns the members of a shared load balancer group,
+// ordered from the oldest to the newest.
+func (lbc *LoadBalancerController) sharedLBGroupMembers(namespace, group string) []*v1.Ingress {
+	var members []*v1.Ingress
+	for _, ing := range lbc.ctx.Ingresses().List() {
+		if ing.Namespace == namespace && sharedLBGroup(ing) == group {
+			members = append(members, ing)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if !members[i].CreationTimestamp.Equal(&members[j].CreationTimestamp) {
+			return members[i].CreationTimestamp.Before(&members[j].CreationTimestamp)
+		}
+		return members[i].Name < members[j].Name
+	})
+	return members
+}
+
+// sharedLBGroupSyncState returns the state to sync the load balancer of the
+// shared load balancer group of the given Ingress. Its URL map merges the URL
+// maps of all members of the group. Members which fail translation are left
+// out, their own syncs report the errors.
+func (lbc *LoadBalancerController) sharedLBGroupSyncState(ing *v1.Ingress, group string, urlMap *utils.GCEURLMap) (*syncState, error) {
+	// The Ingress no longer needs its own load balancer once it joined a group.
+	hasUrlMap, err := lbc.l7Pool.HasUrlMap(ing)
+	if err != nil {
+		return nil, err
+	}
+	if hasUrlMap {
+		klog.V(2).Infof("Deleting load balancer of ingress %s, which joined shared load balancer group %q", common.NamespacedName(ing), group)
+		if err := lbc.l7Pool.GCv2(ing, features.ScopeFromIngress(ing)); err != nil {
+			return nil, err
+		}
+	}
+
+	ingKey := common.NamespacedName(ing)
+	var members []*v1.Ingress
+	var groupMembers []sharedLBGroupMember
+	for _, member := range lbc.sharedLBGroupMembers(ing.Namespace, group) {
+		if common.NamespacedName(member) == ingKey {
+			// The Ingress in the store may not have the finalizer yet.
+			members = append(members, ing)
+			groupMembers = append(groupMembers, sharedLBGroupMember{ing, urlMap})
+			continue
+		}
+		memberURLMap, errs, _ := lbc.Translator.TranslateIngress(member, lbc.ctx.DefaultBackendSvcPort.ID, lbc.ctx.ClusterNamer)
+		if len(errs) > 0 {
+			klog.V(2).Infof("Leaving ingress %s out of shared load balancer group %q: %v", common.NamespacedName(member), group, utils.JoinErrs(errs))
+			continue
+		}
+		members = append(members, member)
+		groupMembers = append(groupMembers, sharedLBGroupMember{member, memberURLMap})
+	}
+	if len(members) == 0 {
+		// The store has not caught up with the Ingress yet.
+		members = append(members, ing)
+		groupMembers = append(groupMembers, sharedLBGroupMember{ing, urlMap})
+	}
+
+	mergedURLMap, conflicts := mergeSharedLBGroup(group, groupMembers)
+	for _, err := range conflicts[ingKey] {
+		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.SharedLBGroup, "Rules ignored: %v", err)
+	}
+	if leader := members[0]; leader != ing && ing.Spec.DefaultBackend != nil {
+		lbc.ctx.Recorder(ing.Namespace).Eventf(ing, apiv1.EventTypeWarning, events.SharedLBGroup, "Default backend ignored: the default backend of shared load balancer group %q is the one of ingress %q", group, common.NamespacedName(leader))
+	}
+
+	return &syncState{
+		urlMap:  mergedURLMap,
+		ing:     sharedLBGroupIngress(ing.Namespace, group, members),
+		members: members,
+	}, nil
+}
+
+// syncSharedLBGroupMembership records the shared load balancer group of the
+// Ingress with the given key. If the Ingress left a group, because it was
+// deleted or its annotation changed, the group is garbage collected. The group
+// is also recorded in an annotation of the Ingress, so that a group left while
+// the controller was down is garbage collected after a restart.
+func (lbc *LoadBalancerController) syncSharedLBGroupMembership(key string, ingExists bool, ing *v1.Ingress) error {
+	lbc.sharedLBGroupsLock.Lock()
+	defer lbc.sharedLBGroupsLock.Unlock()
+
+	group := ""
+	if ingExists {
+		group = sharedLBGroup(ing)
+	}
+	oldGroup, ok := lbc.sharedLBGroups[key]
+	if !ok && ingExists {
+		// The controller may have restarted since the Ingress was last synced.
+		oldGroup, ok = ing.Annotations[annotations.SharedLBGroupStatusKey]
+		if !ok && utils.NeedsCleanup(ing) {
+			oldGroup, _ = annotations.FromIngress(ing).SharedLBGroup()
+		}
+	}
+
+	if oldGroup != "" && oldGroup != group {
+		namespace, _, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return err
+		}
+		if err := lbc.gcSharedLBGroup(namespace, oldGroup); err != nil {
+			return err
+		}
+	}
+
+	if ingExists && !utils.NeedsCleanup(ing) {
+		if err := lbc.updateSharedLBGroupStatus(ing, group); err != nil {
+			return err
+		}
+	}
+	if group == "" {
+		delete(lbc.sharedLBGroups, key)
+	} else {
+		lbc.sharedLBGroups[key] = group
+	}
+	return nil
+}
+
+// updateSharedLBGroupStatus records the shared load balancer group of the
+// Ingress in its status annotation, or removes the annotation if the Ingress
+// is not a member of a group.
+func (lbc *LoadBalancerController) updateSharedLBGroupStatus(ing *v1.Ingress, group string) error {
+	if oldGroup, ok := ing.Annotations[annotations.SharedLBGroupStatusKey]; oldGroup == group && ok == (group != "") {
+		return nil
+	}
+	newAnnotations := map[string]string{}
+	for k, v := range ing.Annotations {
+		newAnnotations[k] = v
+	}
+	if group == "" {
+		delete(newAnnotations, annotations.SharedLBGroupStatusKey)
+	} else {
+		newAnnotations[annotations.SharedLBGroupStatusKey] = group
+	}
+	return updateAnnotations(lbc.ctx.KubeClient, ing, newAnnotations)
+}
+
+// gcSharedLBGroup deletes the load balancer of a shared load balancer group if
+// the group has no members left. Otherwise, a remaining member is synced to
+// remove the rules of departed members from the load balancer.
+func (lbc *LoadBalancerController) gcSharedLBGroup(namespace, group string) error {
+	if members := lbc.sharedLBGroupMembers(namespace, group); len(members) > 0 {
+		klog.V(2).Infof("Shared load balancer group %s/%s has %d members left, syncing ingress %s", namespace, group, len(members), common.NamespacedName(members[0]))
+		lbc.ingQueue.Enqueue(members[0])
+		return nil
+	}
+	klog.V(2).Infof("Deleting load balancer of shared load balancer group %s/%s", namespace, group)
+	groupIng := sharedLBGroupIngress(namespace, group, nil)
+	if err := lbc.l7Pool.GCv2(groupIng, meta.Global); err != nil {
+		return err
+	}
+	lbc.deleteTLSCertExpiry(common.NamespacedName(groupIng))
+	return nil
+}
+
+// sharedLBGroupIngresses returns the Ingresses that represent the shared load
+// balancer groups of the given Ingresses.
+func sharedLBGroupIngresses(ings []*v1.Ingress) []*v1.Ingress {
+	var groupIngs []*v1.Ingress
+	seen := map[types.NamespacedName]bool{}
+	for _, ing := range ings {
+		group := sharedLBGroup(ing)
+		if group == "" {
+			continue
+		}
+		key := types.NamespacedName{Namespace: ing.Namespace, Name: group}
+		if !seen[key] {
+			seen[key] = true
+			groupIngs = append(groupIngs, sharedLBGroupIngress(ing.Namespace, group, nil))
+		}
+	}
+	return groupIngs
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ingerrors "k8s.io/ingress-gce/pkg/controller/errors"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+func TestMergeSharedLBGroup(t *testing.T) {
+	svcPort := func(name string) utils.ServicePort {
+		return utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Namespace: "default", Name: name}}}
+	}
+	member := func(name string, defaultBackend string, hosts map[string]string) sharedLBGroupMember {
+		ing := &networkingv1.Ingress{ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: name}}
+		urlMap := utils.NewGCEURLMap()
+		backend := svcPort(defaultBackend)
+		urlMap.DefaultBackend = &backend
+		for _, host := range []string{"foo.example.com", "bar.example.com", "*"} {
+			if svc, ok := hosts[host]; ok {
+				urlMap.PutPathRulesForHost(host, []utils.PathRule{{Path: "/*", Backend: svcPort(svc)}})
+			}
+		}
+		return sharedLBGroupMember{ing, urlMap}
+	}
+
+	for _, tc := range []struct {
+		desc          string
+		members       []sharedLBGroupMember
+		want          *utils.GCEURLMap
+		wantConflicts map[string][]error
+	}{
+		{
+			desc: "disjoint hosts",
+			members: []sharedLBGroupMember{
+				member("ing-1", "default-1", map[string]string{"foo.example.com": "foo"}),
+				member("ing-2", "default-2", map[string]string{"bar.example.com": "bar"}),
+			},
+			want: func() *utils.GCEURLMap {
+				m := member("", "default-1", map[string]string{"foo.example.com": "foo"}).urlMap
+				m.PutPathRulesForHost("bar.example.com", []utils.PathRule{{Path: "/*", Backend: svcPort("bar")}})
+				return m
+			}(),
+			wantConflicts: map[string][]error{},
+		},
+		{
+			desc: "host owned by the oldest member",
+			members: []sharedLBGroupMember{
+				member("ing-1", "default-1", map[string]string{"foo.example.com": "foo"}),
+				member("ing-2", "default-2", map[string]string{"foo.example.com": "other", "*": "catch-all"}),
+			},
+			want: func() *utils.GCEURLMap {
+				m := member("", "default-1", map[string]string{"foo.example.com": "foo"}).urlMap
+				m.PutPathRulesForHost("*", []utils.PathRule{{Path: "/*", Backend: svcPort("catch-all")}})
+				return m
+			}(),
+			wantConflicts: map[string][]error{
+				"default/ing-2": {ingerrors.ErrSharedLBGroupHostConflict{
+					Group: "frontends",
+					Host:  "foo.example.com",
+					Owner: types.NamespacedName{Namespace: "default", Name: "ing-1"},
+				}},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, conflicts := mergeSharedLBGroup("frontends", tc.members)
+			if !utils.EqualMapping(got, tc.want) {
+				t.Errorf("mergeSharedLBGroup() = %v, want %v", got, tc.want)
+			}
+			if len(conflicts) != len(tc.wantConflicts) {
+				t.Fatalf("mergeSharedLBGroup() conflicts = %v, want %v", conflicts, tc.wantConflicts)
+			}
+			for key, want := range tc.wantConflicts {
+				if len(conflicts[key]) != len(want) || conflicts[key][0] != want[0] {
+					t.Errorf("mergeSharedLBGroup() conflicts[%q] = %v, want %v", key, conflicts[key], want)
+				}
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ package translator
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -33,14 +34,19 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 
 	"k8s.io/ingress-gce/pkg/annotations"
 	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/backendconfig"
+	"k8s.io/ingress-gce/pkg/common/typed"
 	"k8s.io/ingress-gce/pkg/controller/errors"
+	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/ingress-gce/pkg/routeconfig"
 	"k8s.io/ingress-gce/pkg/utils"
 	namer_util "k8s.io/ingress-gce/pkg/utils/namer"
 )
@@ -59,6 +65,9 @@ const (
 type getServicePortParams struct {
 	isL7ILB         bool
 	isL7XLBRegional bool
+	// useStandaloneNEG enables NEG for the Service ports exposed as
+	// standalone NEGs, for backends that are not referenced by an Ingress.
+	useStandaloneNEG bool
 }
 
 func (t *Translator) getServicePortParamsForIngress(ing *v1.Ingress) *getServicePortParams {
@@ -74,6 +83,7 @@ func NewTranslator(serviceInformer cache.SharedIndexInformer,
 	nodeInformer cache.SharedIndexInformer,
 	podInformer cache.SharedIndexInformer,
 	endpointSliceInformer cache.SharedIndexInformer,
+	routeConfigInformer cache.SharedIndexInformer,
 	kubeClient kubernetes.Interface,
 	recorderGetter healthchecks.RecorderGetter,
 	enableTHC,
@@ -85,6 +95,7 @@ func NewTranslator(serviceInformer cache.SharedIndexInformer,
 		NodeInformer:          nodeInformer,
 		PodInformer:           podInformer,
 		EndpointSliceInformer: endpointSliceInformer,
+		RouteConfigInformer:   routeConfigInformer,
 		KubeClient:            kubeClient,
 		enableTHC:             enableTHC,
 		recorderGetter:        recorderGetter,
@@ -99,6 +110,7 @@ type Translator struct {
 	NodeInformer          cache.SharedIndexInformer
 	PodInformer           cache.SharedIndexInformer
 	EndpointSliceInformer cache.SharedIndexInformer
+	RouteConfigInformer   cache.SharedIndexInformer
 	KubeClient            kubernetes.Interface
 	recorderGetter        healthchecks.RecorderGetter
 	enableTHC             bool
@@ -136,10 +148,17 @@ func (t *Translator) GetService(namespace, name string) (*api_v1.Service, error)
 }
 
 // maybeEnableNEG enables NEG on the service port if necessary
-func maybeEnableNEG(sp *utils.ServicePort, svc *api_v1.Service) error {
+func maybeEnableNEG(sp *utils.ServicePort, svc *api_v1.Service, params *getServicePortParams) error {
 	negAnnotation, ok, err := annotations.FromService(svc).NEGAnnotation()
 	if ok && err == nil {
 		sp.NEGEnabled = negAnnotation.NEGEnabledForIngress()
+		if params.useStandaloneNEG {
+			// Standalone NEGs with custom names can not be linked as they do
+			// not follow the NEG naming scheme of the backends.
+			if attributes, exposed := negAnnotation.ExposedPorts[sp.Port]; exposed && attributes.Name == "" {
+				sp.NEGEnabled = true
+			}
+		}
 	}
 
 	if !sp.NEGEnabled && svc.Spec.Type != api_v1.ServiceTypeNodePort &&
@@ -167,6 +186,14 @@ func setAppProtocol(sp *utils.ServicePort, svc *api_v1.Service, port *api_v1.Ser
 	if protoStr, exists := appProtocols[port.Name]; exists {
 		proto = annotations.AppProtocol(protoStr)
 	}
+	// gRPC is served over HTTP/2, which requires a TCP port.
+	if proto == annotations.ProtocolGRPC && port.Protocol != "" && port.Protocol != api_v1.ProtocolTCP {
+		return errors.ErrSvcAppProtocolInvalid{
+			ServicePortID: sp.ID,
+			Protocol:      proto,
+			Reason:        fmt.Sprintf("port protocol is %s, should be TCP", port.Protocol),
+		}
+	}
 	sp.Protocol = proto
 
 	return nil
@@ -294,7 +321,7 @@ func (t *Translator) getServicePort(id utils.ServicePortID, params *getServicePo
 		BackendNamer:         namer,
 	}
 
-	if err := maybeEnableNEG(svcPort, svc); err != nil {
+	if err := maybeEnableNEG(svcPort, svc, params); err != nil {
 		return nil, err, false
 	}
 
@@ -317,6 +344,15 @@ func (t *Translator) getServicePort(id utils.ServicePortID, params *getServicePo
 	return svcPort, nil, flagWarning
 }
 
+// GetServicePort returns the ServicePort of a backend that is not referenced
+// by an Ingress, such as a backend of a Gateway API HTTPRoute. As the NEG
+// controller only creates NEGs for Service ports used by Ingresses, the
+// backend uses NEGs if the Service port is exposed as a standalone NEG.
+// The returned bool indicates whether there is a warning being returned or not.
+func (t *Translator) GetServicePort(id utils.ServicePortID, namer namer_util.BackendNamer) (*utils.ServicePort, error, bool) {
+	return t.getServicePort(id, &getServicePortParams{useStandaloneNEG: true}, namer)
+}
+
 // TranslateIngress converts an Ingress into our internal UrlMap representation.
 // The returned bool is for warnings (there is one type of warnings currently possible).
 func (t *Translator) TranslateIngress(ing *v1.Ingress, systemDefaultBackend utils.ServicePortID, namer namer_util.BackendNamer) (*utils.GCEURLMap, []error, bool) {
@@ -325,11 +361,38 @@ func (t *Translator) TranslateIngress(ing *v1.Ingress, systemDefaultBackend util
 	urlMap := utils.NewGCEURLMap()
 	params := t.getServicePortParamsForIngress(ing)
 
-	for _, rule := range ing.Spec.Rules {
+	mirrors, err := annotations.FromIngress(ing).RequestMirrors()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	var routeConfig *routeconfigv1beta1.RouteConfig
+	if t.RouteConfigInformer != nil {
+		routeConfig, err = t.getRouteConfig(ing)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// claims records the backend and the rule each host and path is routed
+	// by, to detect rules for the same host that conflict with each other.
+	claims := map[string]map[string]pathClaim{}
+	for i, rule := range ing.Spec.Rules {
 		if rule.HTTP == nil {
 			continue
 		}
 
+		host := rule.Host
+		if host == "" {
+			host = DefaultHost
+		} else if err := validateHost(host); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if claims[host] == nil {
+			claims[host] = map[string]pathClaim{}
+		}
+
 		pathRules := []utils.PathRule{}
 		for _, p := range rule.HTTP.Paths {
 			svcPortID, err := utils.BackendToServicePortID(p.Backend, ing.Namespace)
@@ -338,7 +401,7 @@ func (t *Translator) TranslateIngress(ing *v1.Ingress, systemDefaultBackend util
 				errs = append(errs, err)
 				continue
 			}
-			svcPort, err, warning := t.getServicePort(svcPortID, params, namer)
+			svcPort, err, warning := t.getMirroredServicePort(svcPortID, mirrors, params, namer)
 			warnings = warnings || warning
 			if err != nil {
 				errs = append(errs, err)
@@ -357,32 +420,48 @@ func (t *Translator) TranslateIngress(ing *v1.Ingress, systemDefaultBackend util
 					if path == "" {
 						path = DefaultPath
 					}
-					pathRules = append(pathRules, utils.PathRule{Path: path, Backend: *svcPort})
+					if claim, ok := claims[host][path]; ok && claim.rule != i && claim.backend != svcPort.ID {
+						// The rule added last wins, see PutPathRulesForHost.
+						t.recorderGetter.Recorder(ing.Namespace).Eventf(ing, api_v1.EventTypeWarning, events.HostPathConflict,
+							"Host %q and path %q are routed to both service %q port %q and service %q port %q, using service %q port %q",
+							host, path, claim.backend.Service, claim.backend.Port.String(), svcPort.ID.Service, svcPort.ID.Port.String(), svcPort.ID.Service, svcPort.ID.Port.String())
+					}
+					claims[host][path] = pathClaim{backend: svcPort.ID, rule: i}
+					pathRules = append(pathRules, utils.PathRule{
+						Path:                      path,
+						Backend:                   *svcPort,
+						HeaderAction:              headerActionFor(routeConfig, rule.Host, p.Path),
+						CustomErrorResponsePolicy: customErrorResponsePolicyFor(routeConfig, rule.Host, p.Path),
+						UrlRewrite:                urlRewriteFor(routeConfig, rule.Host, p.Path),
+					})
 				}
 			}
 		}
 
-		host := rule.Host
-		if host == "" {
-			host = DefaultHost
-		}
+		// Rules for the same host are merged.
 		urlMap.PutPathRulesForHost(host, pathRules)
 	}
 
+	if routeConfig != nil {
+		routeErrs, warning := t.translateRouteConfig(routeConfig, urlMap, mirrors, params, namer)
+		warnings = warnings || warning
+		errs = append(errs, routeErrs...)
+	}
+
 	if ing.Spec.DefaultBackend != nil {
 		svcPortID, err := utils.BackendToServicePortID(*ing.Spec.DefaultBackend, ing.Namespace)
 		if err != nil {
 			errs = append(errs, err)
 			return urlMap, errs, warnings
 		}
-		svcPort, err, warning := t.getServicePort(svcPortID, params, namer)
+		svcPort, err, warning := t.getMirroredServicePort(svcPortID, mirrors, params, namer)
 		warnings = warnings || warning
-		if err == nil {
+		if svcPort != nil {
 			urlMap.DefaultBackend = svcPort
-			return urlMap, errs, warnings
 		}
-
-		errs = append(errs, err)
+		if err != nil {
+			errs = append(errs, err)
+		}
 		return urlMap, errs, warnings
 	}
 
@@ -397,6 +476,248 @@ func (t *Translator) TranslateIngress(ing *v1.Ingress, systemDefaultBackend util
 	return urlMap, errs, warnings
 }
 
+// pathClaim is the backend a host and path are routed to by an Ingress rule.
+type pathClaim struct {
+	backend utils.ServicePortID
+	rule    int
+}
+
+// validateHost returns an error if the host of an Ingress rule is neither a
+// DNS subdomain nor a wildcard host such as *.example.com. GCE URL maps only
+// support a wildcard as the first label of a host.
+func validateHost(host string) error {
+	var msgs []string
+	if strings.HasPrefix(host, "*") {
+		msgs = validation.IsWildcardDNS1123Subdomain(host)
+	} else {
+		msgs = validation.IsDNS1123Subdomain(host)
+	}
+	if len(msgs) > 0 {
+		return fmt.Errorf("invalid host %q: %s", host, strings.Join(msgs, ", "))
+	}
+	return nil
+}
+
+// getRouteConfig returns the validated RouteConfig referenced by the Ingress,
+// or nil if the Ingress does not reference one.
+func (t *Translator) getRouteConfig(ing *v1.Ingress) (*routeconfigv1beta1.RouteConfig, error) {
+	routeConfigs := typed.WrapRouteConfigStore(t.RouteConfigInformer.GetStore()).List()
+	routeConfig, err := routeconfig.RouteConfigForIngress(routeConfigs, ing)
+	if err != nil {
+		return nil, err
+	}
+	if routeConfig == nil {
+		return nil, nil
+	}
+	if err := routeconfig.Validate(routeConfig); err != nil {
+		return nil, err
+	}
+	return routeConfig, nil
+}
+
+// translateRouteConfig adds the route rules, the host header actions and the
+// host custom error response policies of the RouteConfig to the GCEURLMap.
+// Rules without a host are added to DefaultHost. Header actions and custom
+// error response policies for paths are added with the path rules in
+// TranslateIngress.
+func (t *Translator) translateRouteConfig(routeConfig *routeconfigv1beta1.RouteConfig, urlMap *utils.GCEURLMap, mirrors map[string]v1.IngressServiceBackend, params *getServicePortParams, namer namer_util.BackendNamer) ([]error, bool) {
+	var errs []error
+	var warnings bool
+	var hosts []string
+	routeRules := make(map[string][]utils.RouteRule)
+	for _, rule := range routeConfig.Spec.Rules {
+		routeRule := utils.RouteRule{Matches: rule.Matches, HeaderAction: rule.HeaderAction.DeepCopy(), UrlRewrite: rule.UrlRewrite.DeepCopy()}
+		if len(rule.WeightedBackends) > 0 {
+			for _, backend := range rule.WeightedBackends {
+				svcPort, err, warning := t.getRouteConfigServicePort(routeConfig, backend.ServiceBackend, mirrors, params, namer)
+				warnings = warnings || warning
+				if err != nil {
+					errs = append(errs, err)
+				}
+				if svcPort != nil {
+					routeRule.WeightedBackends = append(routeRule.WeightedBackends, utils.WeightedBackend{Backend: *svcPort, Weight: int64(backend.Weight)})
+				}
+			}
+			if len(routeRule.WeightedBackends) == 0 {
+				continue
+			}
+			if err := validateWeightedBackends(routeRule.WeightedBackends); err != nil {
+				errs = append(errs, fmt.Errorf("RouteConfig %s/%s: %v", routeConfig.Namespace, routeConfig.Name, err))
+				continue
+			}
+		} else {
+			svcPort, err, warning := t.getRouteConfigServicePort(routeConfig, rule.Backend, mirrors, params, namer)
+			warnings = warnings || warning
+			if err != nil {
+				errs = append(errs, err)
+			}
+			if svcPort == nil {
+				continue
+			}
+			routeRule.Backend = *svcPort
+		}
+
+		host := rule.Host
+		if host == "" {
+			host = DefaultHost
+		}
+		if _, ok := routeRules[host]; !ok {
+			hosts = append(hosts, host)
+		}
+		routeRules[host] = append(routeRules[host], routeRule)
+	}
+
+	for _, host := range hosts {
+		urlMap.PutRouteRulesForHost(host, routeRules[host])
+	}
+
+	for _, ha := range routeConfig.Spec.HeaderActions {
+		if ha.Path != "" {
+			continue
+		}
+		host := ha.Host
+		if host == "" {
+			host = DefaultHost
+		}
+		urlMap.PutHeaderActionForHost(host, ha.HeaderAction.DeepCopy())
+	}
+
+	for _, cp := range routeConfig.Spec.CustomErrorResponsePolicies {
+		if cp.Path != "" {
+			continue
+		}
+		host := cp.Host
+		if host == "" {
+			host = DefaultHost
+		}
+		urlMap.PutCustomErrorResponsePolicyForHost(host, cp.Policy.DeepCopy())
+	}
+
+	urlMap.DefaultUrlRewrite = routeConfig.Spec.DefaultUrlRewrite.DeepCopy()
+	return errs, warnings
+}
+
+// headerActionFor returns the header action of the RouteConfig for the given
+// host and path of an Ingress rule, or nil if there is none.
+func headerActionFor(routeConfig *routeconfigv1beta1.RouteConfig, host, path string) *routeconfigv1beta1.HeaderAction {
+	if routeConfig == nil || path == "" {
+		return nil
+	}
+	for _, ha := range routeConfig.Spec.HeaderActions {
+		if ha.Host == host && ha.Path == path {
+			return ha.HeaderAction.DeepCopy()
+		}
+	}
+	return nil
+}
+
+// customErrorResponsePolicyFor returns the custom error response policy of the
+// RouteConfig for the given host and path of an Ingress rule, or nil if there is none.
+func customErrorResponsePolicyFor(routeConfig *routeconfigv1beta1.RouteConfig, host, path string) *routeconfigv1beta1.CustomErrorResponsePolicy {
+	if routeConfig == nil || path == "" {
+		return nil
+	}
+	for _, cp := range routeConfig.Spec.CustomErrorResponsePolicies {
+		if cp.Host == host && cp.Path == path {
+			return cp.Policy.DeepCopy()
+		}
+	}
+	return nil
+}
+
+// urlRewriteFor returns the url rewrite of the RouteConfig for the given host
+// and path of an Ingress rule, or nil if there is none.
+func urlRewriteFor(routeConfig *routeconfigv1beta1.RouteConfig, host, path string) *routeconfigv1beta1.UrlRewrite {
+	if routeConfig == nil || path == "" {
+		return nil
+	}
+	for _, ur := range routeConfig.Spec.UrlRewrites {
+		if ur.Host == host && ur.Path == path {
+			return ur.UrlRewrite.DeepCopy()
+		}
+	}
+	return nil
+}
+
+// validateWeightedBackends returns an error if the weighted backends of a rule
+// would need different route actions. A route rule has a single route action
+// for all of its weighted backends, so the route settings of their
+// BackendConfigs and their shadow Services must be the same.
+func validateWeightedBackends(weightedBackends []utils.WeightedBackend) error {
+	first := weightedBackends[0].Backend
+	for _, wb := range weightedBackends[1:] {
+		if !equalRouteSettings(first, wb.Backend) {
+			return fmt.Errorf("weighted backends %v and %v must have the same retry policy, fault injection policy, route timeout and shadow Service", first.ID, wb.Backend.ID)
+		}
+	}
+	return nil
+}
+
+// equalRouteSettings returns true if requests sent to the service ports get the
+// same route action.
+func equalRouteSettings(a, b utils.ServicePort) bool {
+	var aSpec, bSpec backendconfigv1.BackendConfigSpec
+	if a.BackendConfig != nil {
+		aSpec = a.BackendConfig.Spec
+	}
+	if b.BackendConfig != nil {
+		bSpec = b.BackendConfig.Spec
+	}
+	if !reflect.DeepEqual(aSpec.RetryPolicy, bSpec.RetryPolicy) ||
+		!reflect.DeepEqual(aSpec.FaultInjectionPolicy, bSpec.FaultInjectionPolicy) ||
+		!reflect.DeepEqual(aSpec.RouteTimeout, bSpec.RouteTimeout) {
+		return false
+	}
+	if a.MirrorBackend == nil || b.MirrorBackend == nil {
+		return a.MirrorBackend == b.MirrorBackend
+	}
+	return a.MirrorBackend.BackendName() == b.MirrorBackend.BackendName()
+}
+
+// getRouteConfigServicePort returns the ServicePort for a backend of the given
+// RouteConfig, with the shadow Service its requests are mirrored to.
+func (t *Translator) getRouteConfigServicePort(routeConfig *routeconfigv1beta1.RouteConfig, backend routeconfigv1beta1.ServiceBackend, mirrors map[string]v1.IngressServiceBackend, params *getServicePortParams, namer namer_util.BackendNamer) (*utils.ServicePort, error, bool) {
+	svcPortID := utils.ServicePortID{
+		Service: types.NamespacedName{Namespace: routeConfig.Namespace, Name: backend.Name},
+		Port:    v1.ServiceBackendPort{Name: backend.Port.Name, Number: backend.Port.Number},
+	}
+	return t.getMirroredServicePort(svcPortID, mirrors, params, namer)
+}
+
+// getMirroredServicePort returns the ServicePort for the given ServicePortID,
+// with the shadow Service its requests are mirrored to. A ServicePort is
+// returned without shadow Service if the shadow Service cannot be found.
+func (t *Translator) getMirroredServicePort(id utils.ServicePortID, mirrors map[string]v1.IngressServiceBackend, params *getServicePortParams, namer namer_util.BackendNamer) (*utils.ServicePort, error, bool) {
+	svcPort, err, warning := t.getServicePort(id, params, namer)
+	if svcPort == nil {
+		return nil, err, warning
+	}
+	mirrorPort, mirrorErr, mirrorWarning := t.getMirrorServicePort(svcPort, mirrors, params, namer)
+	svcPort.MirrorBackend = mirrorPort
+	if err == nil {
+		err = mirrorErr
+	}
+	return svcPort, err, warning || mirrorWarning
+}
+
+// getMirrorServicePort returns the ServicePort of the shadow Service that the
+// requests sent to svcPort are mirrored to, or nil if they are not mirrored.
+func (t *Translator) getMirrorServicePort(svcPort *utils.ServicePort, mirrors map[string]v1.IngressServiceBackend, params *getServicePortParams, namer namer_util.BackendNamer) (*utils.ServicePort, error, bool) {
+	mirror, ok := mirrors[svcPort.ID.Service.Name]
+	if !ok {
+		return nil, nil, false
+	}
+	svcPortID := utils.ServicePortID{
+		Service: types.NamespacedName{Namespace: svcPort.ID.Service.Namespace, Name: mirror.Name},
+		Port:    mirror.Port,
+	}
+	mirrorPort, err, warning := t.getServicePort(svcPortID, params, namer)
+	if err != nil {
+		return nil, err, warning
+	}
+	return mirrorPort, nil, warning
+}
+
 // validateAndGetPaths will validate the path based on the specified path type and will return the
 // the path rules that should be used. If no path type is provided, the path type will be assumed
 // to be ImplementationSpecific. If a non existent path type is provided, an error will be returned.
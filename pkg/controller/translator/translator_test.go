@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -38,12 +39,16 @@ import (
 	discoveryinformer "k8s.io/client-go/informers/discovery/v1"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/ingress-gce/pkg/annotations"
 	backendconfig "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned/fake"
 	informerbackendconfig "k8s.io/ingress-gce/pkg/backendconfig/client/informers/externalversions/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/healthchecks"
+	routeconfigclient "k8s.io/ingress-gce/pkg/routeconfig/client/clientset/versioned/fake"
+	informerrouteconfig "k8s.io/ingress-gce/pkg/routeconfig/client/informers/externalversions/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/test"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/endpointslices"
@@ -73,6 +78,7 @@ func fakeTranslator() *Translator {
 func configuredFakeTranslator() *Translator {
 	client := fake.NewSimpleClientset()
 	backendConfigClient := backendconfigclient.NewSimpleClientset()
+	routeConfigClient := routeconfigclient.NewSimpleClientset()
 	namespace := apiv1.NamespaceAll
 	resyncPeriod := 1 * time.Second
 
@@ -82,12 +88,14 @@ func configuredFakeTranslator() *Translator {
 	NodeInformer := informerv1.NewNodeInformer(client, resyncPeriod, utils.NewNamespaceIndexer())
 	EndpointSliceInformer := discoveryinformer.NewEndpointSliceInformer(client, namespace, 0,
 		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, endpointslices.EndpointSlicesByServiceIndex: endpointslices.EndpointSlicesByServiceFunc})
+	RouteConfigInformer := informerrouteconfig.NewRouteConfigInformer(routeConfigClient, namespace, resyncPeriod, utils.NewNamespaceIndexer())
 	return NewTranslator(
 		ServiceInformer,
 		BackendConfigInformer,
 		NodeInformer,
 		PodInformer,
 		EndpointSliceInformer,
+		RouteConfigInformer,
 		client,
 		healthchecks.NewFakeRecorderGetter(0),
 		false,
@@ -125,6 +133,7 @@ func TestTranslateIngress(t *testing.T) {
 		ing                    *v1.Ingress
 		wantErrCount           int
 		wantGCEURLMap          *utils.GCEURLMap
+		wantEvents             []string
 		enableL7XLBGCERegional bool
 	}{
 		{
@@ -179,6 +188,31 @@ func TestTranslateIngress(t *testing.T) {
 			wantErrCount:  0,
 			wantGCEURLMap: gceURLMapFromFile(t, "ingress-multi-empty.json"),
 		},
+		{
+			desc:          "duplicate host rules are merged",
+			ing:           ingressFromFile(t, "ingress-duplicate-host.yaml"),
+			wantErrCount:  0,
+			wantGCEURLMap: gceURLMapFromFile(t, "ingress-duplicate-host.json"),
+		},
+		{
+			desc:          "conflicting host rules",
+			ing:           ingressFromFile(t, "ingress-conflicting-host.yaml"),
+			wantErrCount:  0,
+			wantGCEURLMap: gceURLMapFromFile(t, "ingress-conflicting-host.json"),
+			wantEvents:    []string{"Warning HostPathConflict"},
+		},
+		{
+			desc:          "wildcard host",
+			ing:           ingressFromFile(t, "ingress-wildcard-host.yaml"),
+			wantErrCount:  0,
+			wantGCEURLMap: gceURLMapFromFile(t, "ingress-wildcard-host.json"),
+		},
+		{
+			desc:          "invalid host",
+			ing:           ingressFromFile(t, "ingress-invalid-host.yaml"),
+			wantErrCount:  1,
+			wantGCEURLMap: gceURLMapFromFile(t, "ingress-invalid-host.json"),
+		},
 		{
 			desc:          "missing rule service",
 			ing:           ingressFromFile(t, "ingress-missing-rule-svc.yaml"),
@@ -220,10 +254,23 @@ func TestTranslateIngress(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			translator.enableL7XLBRegional = tc.enableL7XLBGCERegional
+			recorder := record.NewFakeRecorder(10)
+			translator.recorderGetter = fixedRecorderGetter{recorder}
 			gotGCEURLMap, gotErrs, _ := translator.TranslateIngress(tc.ing, defaultBackend.ID, defaultNamer)
 			if len(gotErrs) != tc.wantErrCount {
 				t.Errorf("%s: TranslateIngress() = _, %+v, want %v errs", tc.desc, gotErrs, tc.wantErrCount)
 			}
+			if len(recorder.Events) != len(tc.wantEvents) {
+				t.Errorf("%s: got %d events, want %v", tc.desc, len(recorder.Events), tc.wantEvents)
+			}
+			for _, want := range tc.wantEvents {
+				if len(recorder.Events) == 0 {
+					break
+				}
+				if got := <-recorder.Events; !strings.HasPrefix(got, want) {
+					t.Errorf("%s: got event %q, want %q", tc.desc, got, want)
+				}
+			}
 
 			// Check that the GCEURLMaps point to the same ServicePortIDs.
 			if !utils.EqualMapping(gotGCEURLMap, tc.wantGCEURLMap) {
@@ -233,6 +280,395 @@ func TestTranslateIngress(t *testing.T) {
 	}
 }
 
+func TestTranslateIngressWithRouteConfig(t *testing.T) {
+	translator := fakeTranslator()
+	svcLister := translator.ServiceInformer.GetIndexer()
+	for _, name := range []string{"first-service", "canary-service"} {
+		svc := test.NewService(types.NamespacedName{Name: name, Namespace: "default"}, apiv1.ServiceSpec{
+			Type:  apiv1.ServiceTypeNodePort,
+			Ports: []apiv1.ServicePort{{Port: 80}},
+		})
+		svcLister.Add(svc)
+	}
+
+	canaryMatches := []routeconfigv1beta1.RouteMatch{
+		{
+			PrefixMatch: "/",
+			Headers:     []routeconfigv1beta1.HeaderMatch{{Name: "x-canary", ExactMatch: "true"}},
+		},
+		{
+			PrefixMatch:     "/",
+			QueryParameters: []routeconfigv1beta1.QueryParameterMatch{{Name: "version", ExactMatch: "v2"}},
+		},
+	}
+	translator.RouteConfigInformer.GetIndexer().Add(&routeconfigv1beta1.RouteConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "canary", Namespace: "default"},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			Rules: []routeconfigv1beta1.RouteRule{
+				{
+					Host:    "foo.bar.com",
+					Matches: canaryMatches,
+					Backend: routeconfigv1beta1.ServiceBackend{Name: "canary-service", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}},
+				},
+			},
+		},
+	})
+	translator.RouteConfigInformer.GetIndexer().Add(&routeconfigv1beta1.RouteConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "blue-green", Namespace: "default"},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			Rules: []routeconfigv1beta1.RouteRule{
+				{
+					Host:    "foo.bar.com",
+					Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+					WeightedBackends: []routeconfigv1beta1.WeightedServiceBackend{
+						{ServiceBackend: routeconfigv1beta1.ServiceBackend{Name: "first-service", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}}, Weight: 90},
+						{ServiceBackend: routeconfigv1beta1.ServiceBackend{Name: "canary-service", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}}, Weight: 10},
+					},
+				},
+			},
+		},
+	})
+	hostHeaderAction := routeconfigv1beta1.HeaderAction{ResponseHeadersToRemove: []string{"server"}}
+	pathHeaderAction := routeconfigv1beta1.HeaderAction{
+		RequestHeadersToAdd: []routeconfigv1beta1.HeaderOption{{Name: "x-client-region", Value: "{client_region}"}},
+	}
+	translator.RouteConfigInformer.GetIndexer().Add(&routeconfigv1beta1.RouteConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "headers", Namespace: "default"},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			HeaderActions: []routeconfigv1beta1.HeaderActionRule{
+				{Host: "foo.bar.com", HeaderAction: hostHeaderAction},
+				{Host: "foo.bar.com", Path: "/*", HeaderAction: pathHeaderAction},
+			},
+		},
+	})
+	hostErrorPolicy := routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorService:       "error-pages",
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"5xx"}, Path: "/errors/5xx.html"}},
+	}
+	pathErrorPolicy := routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"404"}, Path: "/errors/404.html", OverrideResponseCode: 404}},
+	}
+	translator.RouteConfigInformer.GetIndexer().Add(&routeconfigv1beta1.RouteConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "error-pages", Namespace: "default"},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			CustomErrorResponsePolicies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Host: "foo.bar.com", Policy: hostErrorPolicy},
+				{Host: "foo.bar.com", Path: "/*", Policy: pathErrorPolicy},
+			},
+		},
+	})
+	pathUrlRewrite := routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/", HostRewrite: "legacy.example.com"}
+	ruleUrlRewrite := routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/v2/"}
+	defaultUrlRewrite := routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/default/"}
+	translator.RouteConfigInformer.GetIndexer().Add(&routeconfigv1beta1.RouteConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "rewrites", Namespace: "default"},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			Rules: []routeconfigv1beta1.RouteRule{
+				{
+					Host:       "foo.bar.com",
+					Matches:    canaryMatches,
+					Backend:    routeconfigv1beta1.ServiceBackend{Name: "canary-service", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}},
+					UrlRewrite: &ruleUrlRewrite,
+				},
+			},
+			UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+				{Host: "foo.bar.com", Path: "/*", UrlRewrite: pathUrlRewrite},
+				{Host: "other.com", Path: "/*", UrlRewrite: pathUrlRewrite},
+			},
+			DefaultUrlRewrite: &defaultUrlRewrite,
+		},
+	})
+	translator.RouteConfigInformer.GetIndexer().Add(&routeconfigv1beta1.RouteConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "default"},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			Rules: []routeconfigv1beta1.RouteRule{
+				{Backend: routeconfigv1beta1.ServiceBackend{Name: "canary-service", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}}},
+			},
+		},
+	})
+
+	newIngress := func(routeConfig string) *v1.Ingress {
+		ing := test.NewIngress(types.NamespacedName{Name: "my-ingress", Namespace: "default"},
+			v1.IngressSpec{
+				DefaultBackend: test.Backend("first-service", port80),
+				Rules: []v1.IngressRule{
+					{
+						Host: "foo.bar.com",
+						IngressRuleValue: v1.IngressRuleValue{
+							HTTP: &v1.HTTPIngressRuleValue{
+								Paths: []v1.HTTPIngressPath{{Path: "/*", Backend: *test.Backend("first-service", port80)}},
+							},
+						},
+					},
+				},
+			})
+		ing.Annotations = map[string]string{annotations.RouteConfigKey: routeConfig}
+		return ing
+	}
+	firstBackend := utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "first-service", Namespace: "default"}, Port: port80}}
+	canaryBackend := utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "canary-service", Namespace: "default"}, Port: port80}}
+
+	wantGCEURLMap := utils.NewGCEURLMap()
+	wantGCEURLMap.DefaultBackend = &firstBackend
+	wantGCEURLMap.PutPathRulesForHost("foo.bar.com", []utils.PathRule{{Path: "/*", Backend: firstBackend}})
+	wantGCEURLMap.PutRouteRulesForHost("foo.bar.com", []utils.RouteRule{{Matches: canaryMatches, Backend: canaryBackend}})
+
+	wantWeightedGCEURLMap := utils.NewGCEURLMap()
+	wantWeightedGCEURLMap.DefaultBackend = &firstBackend
+	wantWeightedGCEURLMap.PutPathRulesForHost("foo.bar.com", []utils.PathRule{{Path: "/*", Backend: firstBackend}})
+	wantWeightedGCEURLMap.PutRouteRulesForHost("foo.bar.com", []utils.RouteRule{
+		{
+			Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+			WeightedBackends: []utils.WeightedBackend{
+				{Backend: firstBackend, Weight: 90},
+				{Backend: canaryBackend, Weight: 10},
+			},
+		},
+	})
+
+	wantHeadersGCEURLMap := utils.NewGCEURLMap()
+	wantHeadersGCEURLMap.DefaultBackend = &firstBackend
+	wantHeadersGCEURLMap.PutPathRulesForHost("foo.bar.com", []utils.PathRule{{Path: "/*", Backend: firstBackend, HeaderAction: &pathHeaderAction}})
+	wantHeadersGCEURLMap.PutHeaderActionForHost("foo.bar.com", &hostHeaderAction)
+
+	wantErrorPagesGCEURLMap := utils.NewGCEURLMap()
+	wantErrorPagesGCEURLMap.DefaultBackend = &firstBackend
+	wantErrorPagesGCEURLMap.PutPathRulesForHost("foo.bar.com", []utils.PathRule{{Path: "/*", Backend: firstBackend, CustomErrorResponsePolicy: &pathErrorPolicy}})
+	wantErrorPagesGCEURLMap.PutCustomErrorResponsePolicyForHost("foo.bar.com", &hostErrorPolicy)
+
+	wantRewritesGCEURLMap := utils.NewGCEURLMap()
+	wantRewritesGCEURLMap.DefaultBackend = &firstBackend
+	wantRewritesGCEURLMap.DefaultUrlRewrite = &defaultUrlRewrite
+	wantRewritesGCEURLMap.PutPathRulesForHost("foo.bar.com", []utils.PathRule{{Path: "/*", Backend: firstBackend, UrlRewrite: &pathUrlRewrite}})
+	wantRewritesGCEURLMap.PutRouteRulesForHost("foo.bar.com", []utils.RouteRule{{Matches: canaryMatches, Backend: canaryBackend, UrlRewrite: &ruleUrlRewrite}})
+
+	for _, tc := range []struct {
+		desc          string
+		ing           *v1.Ingress
+		wantErrCount  int
+		wantGCEURLMap *utils.GCEURLMap
+	}{
+		{
+			desc:          "route config with header and query parameter matches",
+			ing:           newIngress("canary"),
+			wantGCEURLMap: wantGCEURLMap,
+		},
+		{
+			desc:          "route config with weighted backends",
+			ing:           newIngress("blue-green"),
+			wantGCEURLMap: wantWeightedGCEURLMap,
+		},
+		{
+			desc:          "route config with host and path header actions",
+			ing:           newIngress("headers"),
+			wantGCEURLMap: wantHeadersGCEURLMap,
+		},
+		{
+			desc:          "route config with host and path custom error response policies",
+			ing:           newIngress("error-pages"),
+			wantGCEURLMap: wantErrorPagesGCEURLMap,
+		},
+		{
+			desc:          "route config with path, rule and default backend url rewrites",
+			ing:           newIngress("rewrites"),
+			wantGCEURLMap: wantRewritesGCEURLMap,
+		},
+		{
+			desc:         "missing route config",
+			ing:          newIngress("does-not-exist"),
+			wantErrCount: 1,
+		},
+		{
+			desc:         "invalid route config",
+			ing:          newIngress("invalid"),
+			wantErrCount: 1,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			gotGCEURLMap, gotErrs, _ := translator.TranslateIngress(tc.ing, defaultBackend.ID, defaultNamer)
+			if len(gotErrs) != tc.wantErrCount {
+				t.Errorf("TranslateIngress() = _, %+v, want %v errs", gotErrs, tc.wantErrCount)
+			}
+			if tc.wantGCEURLMap != nil && !utils.EqualMapping(gotGCEURLMap, tc.wantGCEURLMap) {
+				t.Errorf("TranslateIngress() = %+v\nwant\n%+v", gotGCEURLMap.String(), tc.wantGCEURLMap.String())
+			}
+		})
+	}
+}
+
+func TestValidateWeightedBackends(t *testing.T) {
+	retryConfig := &backendconfig.BackendConfig{Spec: backendconfig.BackendConfigSpec{RetryPolicy: &backendconfig.RetryPolicyConfig{RetryConditions: []string{"5xx"}}}}
+	timeoutConfig := &backendconfig.BackendConfig{Spec: backendconfig.BackendConfigSpec{RouteTimeout: &backendconfig.DurationConfig{Seconds: 10}}}
+	newBackend := func(name string, beConfig *backendconfig.BackendConfig) utils.ServicePort {
+		return utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: name, Namespace: "default"}, Port: port80}, BackendConfig: beConfig}
+	}
+	shadowBackend := newBackend("shadow-service", nil)
+	mirroredBackend := newBackend("canary-service", nil)
+	mirroredBackend.MirrorBackend = &shadowBackend
+
+	for _, tc := range []struct {
+		desc     string
+		backends []utils.ServicePort
+		wantErr  bool
+	}{
+		{
+			desc:     "no route settings",
+			backends: []utils.ServicePort{newBackend("first-service", nil), newBackend("canary-service", nil)},
+		},
+		{
+			desc:     "same route settings",
+			backends: []utils.ServicePort{newBackend("first-service", retryConfig), newBackend("canary-service", retryConfig.DeepCopy())},
+		},
+		{
+			desc:     "different route settings",
+			backends: []utils.ServicePort{newBackend("first-service", retryConfig), newBackend("canary-service", timeoutConfig)},
+			wantErr:  true,
+		},
+		{
+			desc:     "route settings on one backend",
+			backends: []utils.ServicePort{newBackend("first-service", nil), newBackend("canary-service", timeoutConfig)},
+			wantErr:  true,
+		},
+		{
+			desc:     "shadow service on one backend",
+			backends: []utils.ServicePort{newBackend("first-service", nil), mirroredBackend},
+			wantErr:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			var weightedBackends []utils.WeightedBackend
+			for _, backend := range tc.backends {
+				weightedBackends = append(weightedBackends, utils.WeightedBackend{Backend: backend, Weight: 50})
+			}
+			err := validateWeightedBackends(weightedBackends)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("validateWeightedBackends() = %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestTranslateIngressWithRequestMirror(t *testing.T) {
+	translator := fakeTranslator()
+	svcLister := translator.ServiceInformer.GetIndexer()
+	for _, name := range []string{"first-service", "canary-service", "shadow-service"} {
+		svc := test.NewService(types.NamespacedName{Name: name, Namespace: "default"}, apiv1.ServiceSpec{
+			Type:  apiv1.ServiceTypeNodePort,
+			Ports: []apiv1.ServicePort{{Port: 80}},
+		})
+		svcLister.Add(svc)
+	}
+	translator.RouteConfigInformer.GetIndexer().Add(&routeconfigv1beta1.RouteConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "canary", Namespace: "default"},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			Rules: []routeconfigv1beta1.RouteRule{
+				{
+					Host:    "foo.bar.com",
+					Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/canary"}},
+					Backend: routeconfigv1beta1.ServiceBackend{Name: "canary-service", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}},
+				},
+			},
+		},
+	})
+
+	newIngress := func(mirror string) *v1.Ingress {
+		ing := test.NewIngress(types.NamespacedName{Name: "my-ingress", Namespace: "default"},
+			v1.IngressSpec{
+				DefaultBackend: test.Backend("first-service", port80),
+				Rules: []v1.IngressRule{
+					{
+						Host: "foo.bar.com",
+						IngressRuleValue: v1.IngressRuleValue{
+							HTTP: &v1.HTTPIngressRuleValue{
+								Paths: []v1.HTTPIngressPath{{Path: "/*", Backend: *test.Backend("first-service", port80)}},
+							},
+						},
+					},
+				},
+			})
+		ing.Annotations = map[string]string{
+			annotations.RequestMirrorKey: mirror,
+			annotations.RouteConfigKey:   "canary",
+		}
+		return ing
+	}
+	firstID := utils.ServicePortID{Service: types.NamespacedName{Name: "first-service", Namespace: "default"}, Port: port80}
+	canaryID := utils.ServicePortID{Service: types.NamespacedName{Name: "canary-service", Namespace: "default"}, Port: port80}
+	shadowID := utils.ServicePortID{Service: types.NamespacedName{Name: "shadow-service", Namespace: "default"}, Port: port80}
+
+	for _, tc := range []struct {
+		desc         string
+		ing          *v1.Ingress
+		wantErrCount int
+		wantMirror   *utils.ServicePortID
+	}{
+		{
+			desc:       "mirrored backends",
+			ing:        newIngress(`{"first-service": {"name": "shadow-service", "port": {"number": 80}}, "canary-service": {"name": "shadow-service", "port": {"number": 80}}}`),
+			wantMirror: &shadowID,
+		},
+		{
+			desc: "mirror of another service",
+			ing:  newIngress(`{"other-service": {"name": "shadow-service", "port": {"number": 80}}}`),
+		},
+		{
+			// Reported for the path and the default backend.
+			desc:         "missing shadow service",
+			ing:          newIngress(`{"first-service": {"name": "does-not-exist", "port": {"number": 80}}}`),
+			wantErrCount: 2,
+		},
+		{
+			desc:         "malformed annotation",
+			ing:          newIngress(`{"first-service": "shadow-service"}`),
+			wantErrCount: 1,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			gotGCEURLMap, gotErrs, _ := translator.TranslateIngress(tc.ing, defaultBackend.ID, defaultNamer)
+			if len(gotErrs) != tc.wantErrCount {
+				t.Errorf("TranslateIngress() = _, %+v, want %v errs", gotErrs, tc.wantErrCount)
+			}
+			pathBackend, ok := gotGCEURLMap.PathExists("foo.bar.com", "/*")
+			if !ok || pathBackend.ID != firstID {
+				t.Fatalf("PathExists(foo.bar.com, /*) = %+v, %v, want backend %v", pathBackend, ok, firstID)
+			}
+			if gotGCEURLMap.DefaultBackend == nil || gotGCEURLMap.DefaultBackend.ID != firstID {
+				t.Fatalf("DefaultBackend = %+v, want backend %v", gotGCEURLMap.DefaultBackend, firstID)
+			}
+			hostRules := gotGCEURLMap.HostRules
+			if len(hostRules) != 1 || len(hostRules[0].RouteRules) != 1 || hostRules[0].RouteRules[0].Backend.ID != canaryID {
+				t.Fatalf("HostRules = %+v, want a route rule to backend %v", hostRules, canaryID)
+			}
+
+			for desc, backend := range map[string]utils.ServicePort{
+				"path backend":       pathBackend,
+				"default backend":    *gotGCEURLMap.DefaultBackend,
+				"route rule backend": hostRules[0].RouteRules[0].Backend,
+			} {
+				if tc.wantMirror == nil {
+					if backend.MirrorBackend != nil {
+						t.Errorf("MirrorBackend of %s = %+v, want nil", desc, backend.MirrorBackend)
+					}
+					continue
+				}
+				if backend.MirrorBackend == nil || backend.MirrorBackend.ID != *tc.wantMirror {
+					t.Errorf("MirrorBackend of %s = %+v, want %v", desc, backend.MirrorBackend, *tc.wantMirror)
+				}
+			}
+			if tc.wantMirror == nil {
+				return
+			}
+			var found bool
+			for _, sp := range gotGCEURLMap.AllServicePorts() {
+				found = found || sp.ID == *tc.wantMirror
+			}
+			if !found {
+				t.Errorf("AllServicePorts() does not contain mirror backend %v", *tc.wantMirror)
+			}
+		})
+	}
+}
+
 func TestGetServicePort(t *testing.T) {
 	cases := []struct {
 		desc            string
@@ -472,6 +908,44 @@ func TestGetServicePort(t *testing.T) {
 				NEGEnabled:           true,
 			},
 		},
+		{
+			desc: "clusterIP service with standalone NEG referenced by an Ingress",
+			spec: apiv1.ServiceSpec{
+				Type:  apiv1.ServiceTypeClusterIP,
+				Ports: []apiv1.ServicePort{{Name: "http", Port: 80}},
+			},
+			annotations: map[string]string{
+				annotations.NEGAnnotationKey: `{"exposed_ports":{"80":{}}}`,
+			},
+			id:      utils.ServicePortID{Port: v1.ServiceBackendPort{Name: "http"}},
+			wantErr: true,
+		},
+		{
+			desc: "clusterIP service with standalone NEG not referenced by an Ingress",
+			spec: apiv1.ServiceSpec{
+				Type:  apiv1.ServiceTypeClusterIP,
+				Ports: []apiv1.ServicePort{{Name: "http", Port: 80}},
+			},
+			annotations: map[string]string{
+				annotations.NEGAnnotationKey: `{"exposed_ports":{"80":{}}}`,
+			},
+			id:      utils.ServicePortID{Port: v1.ServiceBackendPort{Name: "http"}},
+			params:  getServicePortParams{useStandaloneNEG: true},
+			wantErr: false,
+			wantServicePort: &utils.ServicePort{
+				ID: utils.ServicePortID{
+					Service: types.NamespacedName{
+						Namespace: "default",
+						Name:      "foo",
+					},
+					Port: v1.ServiceBackendPort{Name: "http"},
+				},
+				Port:       80,
+				PortName:   "http",
+				Protocol:   "HTTP",
+				NEGEnabled: true,
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1143,6 +1617,15 @@ func createEndpointSlice(serviceName string, sliceSuffix string, portName string
 	}
 }
 
+// fixedRecorderGetter returns the same recorder for every namespace.
+type fixedRecorderGetter struct {
+	recorder record.EventRecorder
+}
+
+func (g fixedRecorderGetter) Recorder(string) record.EventRecorder {
+	return g.recorder
+}
+
 func ingressFromFile(t *testing.T, filename string) *v1.Ingress {
 	t.Helper()
 
@@ -1286,6 +1769,69 @@ func TestSetTrafficScaling(t *testing.T) {
 	}
 }
 
+func TestSetAppProtocol(t *testing.T) {
+	t.Parallel()
+
+	newService := func(ann map[string]string) *apiv1.Service {
+		return &apiv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Annotations: ann},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		svc     *apiv1.Service
+		port    apiv1.ServicePort
+		want    annotations.AppProtocol
+		wantErr bool
+	}{
+		{
+			name: "no annotation",
+			svc:  newService(map[string]string{}),
+			port: apiv1.ServicePort{Name: "grpc"},
+			want: annotations.ProtocolHTTP,
+		},
+		{
+			name: "http2",
+			svc:  newService(map[string]string{annotations.ServiceApplicationProtocolKey: `{"grpc":"HTTP2"}`}),
+			port: apiv1.ServicePort{Name: "grpc"},
+			want: annotations.ProtocolHTTP2,
+		},
+		{
+			name: "grpc",
+			svc:  newService(map[string]string{annotations.ServiceApplicationProtocolKey: `{"grpc":"GRPC"}`}),
+			port: apiv1.ServicePort{Name: "grpc", Protocol: apiv1.ProtocolTCP},
+			want: annotations.ProtocolGRPC,
+		},
+		{
+			name:    "grpc on udp port",
+			svc:     newService(map[string]string{annotations.ServiceApplicationProtocolKey: `{"grpc":"GRPC"}`}),
+			port:    apiv1.ServicePort{Name: "grpc", Protocol: apiv1.ProtocolUDP},
+			wantErr: true,
+		},
+		{
+			name:    "invalid protocol",
+			svc:     newService(map[string]string{annotations.ServiceApplicationProtocolKey: `{"grpc":"H2C"}`}),
+			port:    apiv1.ServicePort{Name: "grpc"},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got utils.ServicePort
+			err := setAppProtocol(&got, tc.svc, &tc.port)
+			if gotErr := err != nil; tc.wantErr != gotErr {
+				t.Fatalf("setAppProtocol(_, %+v, %+v) = %v; gotErr = %t, want %t", tc.svc, tc.port, err, gotErr, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Protocol != tc.want {
+				t.Errorf("setAppProtocol(_, %+v, %+v); got protocol %q, want %q", tc.svc, tc.port, got.Protocol, tc.want)
+			}
+		})
+	}
+}
+
 func TestSetThcOptInOnSvc(t *testing.T) {
 	t.Parallel()
 
@@ -27,4 +27,7 @@ type syncState struct {
 	urlMap *utils.GCEURLMap
 	ing    *v1.Ingress
 	l7     *loadbalancers.L7
+	// members are the Ingresses of the shared load balancer group represented
+	// by ing, if any.
+	members []*v1.Ingress
 }
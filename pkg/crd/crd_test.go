@@ -194,3 +194,22 @@ func TestCreateOrUpdateCRD(t *testing.T) {
 		}
 	}
 }
+
+func TestCRDStatusSubresource(t *testing.T) {
+	for _, statusSubresource := range []bool{false, true} {
+		meta := NewCRDMeta("test.group.com", "Test", "TestList", "test", "tests", []*Version{
+			NewVersion("v1", "pkg/apis/test/v1.Test", testGetOpenAPIDefinitions, false),
+			NewVersion("v1beta1", "pkg/apis/test/v1beta1.Test", testGetOpenAPIDefinitions, false),
+		})
+		if statusSubresource {
+			meta = meta.WithStatusSubresource()
+		}
+
+		for _, version := range crd(meta, true).Spec.Versions {
+			gotStatusSubresource := version.Subresources != nil && version.Subresources.Status != nil
+			if gotStatusSubresource != statusSubresource {
+				t.Errorf("crd() version %s has status subresource %t, want %t", version.Name, gotStatusSubresource, statusSubresource)
+			}
+		}
+	}
+}
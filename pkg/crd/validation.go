@@ -67,8 +67,82 @@ var metav1OpenAPISpec = map[string]common.OpenAPIDefinition{
 			},
 		},
 	},
+	"k8s.io/apimachinery/pkg/apis/meta/v1.Condition": common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type of condition in CamelCase or in foo.example.com/CamelCase.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status of the condition, one of True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration represents the .metadata.generation that the condition was set based upon.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "lastTransitionTime is the last time the condition transitioned from one status to another.",
+							Type:        metav1.Time{}.OpenAPISchemaType(),
+							Format:      metav1.Time{}.OpenAPISchemaFormat(),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "reason contains a programmatic identifier indicating the reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message is a human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status", "lastTransitionTime", "reason", "message"},
+			},
+		},
+	},
 }
 
+// propertyValidations are constraints on properties of types which the
+// generated OpenAPI definitions cannot express, keyed by type and property.
+var propertyValidations = map[string]map[string]spec.SchemaProps{
+	"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewriteRule": {
+		"path": {Pattern: "^/"},
+	},
+	"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.UrlRewrite": {
+		"pathPrefixRewrite": {Pattern: "^/", MaxLength: int64Ptr(1024)},
+		"hostRewrite":       {Pattern: "^[^/ ]*$", MaxLength: int64Ptr(255)},
+	},
+	"k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.CustomErrorResponsePolicy": {
+		"errorServiceKind": {Enum: errorServiceKinds},
+	},
+	"k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1.CustomErrorResponsePolicy": {
+		"errorServiceKind": {Enum: errorServiceKinds},
+	},
+}
+
+var errorServiceKinds = []interface{}{"BackendBucket", "BackendService"}
+
+func int64Ptr(i int64) *int64 { return &i }
+
 // validation returns a validation specification based on OpenAPI schema's.
 func (v *Version) validation() (*apiextensionsv1.CustomResourceValidation, error) {
 	if v.typeSource == "" || v.fn == nil {
@@ -129,6 +203,28 @@ func getReferenceSchema(ref string, propertySchema spec.Schema, openapiSpec map[
 		// If ref doesn't exist in either map, this will generate standard object one
 		referencedSchema = openapiSpec[ref].Schema
 		referencedSchema.SchemaProps.Type = spec.StringOrArray{"object"}
+		addPropertyValidations(ref, referencedSchema)
 	}
 	return referencedSchema
 }
+
+// addPropertyValidations adds the propertyValidations of the referenced type
+// to the properties of its schema.
+func addPropertyValidations(ref string, schema spec.Schema) {
+	for property, validation := range propertyValidations[ref] {
+		propertySchema, ok := schema.SchemaProps.Properties[property]
+		if !ok {
+			continue
+		}
+		if validation.Pattern != "" {
+			propertySchema.SchemaProps.Pattern = validation.Pattern
+		}
+		if validation.MaxLength != nil {
+			propertySchema.SchemaProps.MaxLength = validation.MaxLength
+		}
+		if len(validation.Enum) > 0 {
+			propertySchema.SchemaProps.Enum = validation.Enum
+		}
+		schema.SchemaProps.Properties[property] = propertySchema
+	}
+}
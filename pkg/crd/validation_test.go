@@ -19,6 +19,8 @@ package crd
 import (
 	"testing"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/kube-openapi/pkg/common"
 	spec "k8s.io/kube-openapi/pkg/validation/spec"
 )
@@ -156,3 +158,35 @@ func TestCondenseSchema(t *testing.T) {
 		t.Errorf("Expected Foo's ts property to be Nullable")
 	}
 }
+
+func TestValidationPropertyValidations(t *testing.T) {
+	v := NewVersion("v1beta1", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteConfig", routeconfigv1beta1.GetOpenAPIDefinitions, false)
+	validation, err := v.validation()
+	if err != nil {
+		t.Fatalf("validation() = %v, want nil", err)
+	}
+
+	specProps := validation.OpenAPIV3Schema.Properties["spec"]
+	rewriteRuleProps := specProps.Properties["urlRewrites"].Items.Schema.Properties
+	if got := rewriteRuleProps["path"].Pattern; got != "^/" {
+		t.Errorf("Pattern of urlRewrites[].path = %q, want %q", got, "^/")
+	}
+	for _, rewriteProps := range []map[string]apiextensionsv1.JSONSchemaProps{
+		rewriteRuleProps["urlRewrite"].Properties,
+		specProps.Properties["defaultUrlRewrite"].Properties,
+		specProps.Properties["rules"].Items.Schema.Properties["urlRewrite"].Properties,
+	} {
+		pathPrefixRewrite := rewriteProps["pathPrefixRewrite"]
+		if pathPrefixRewrite.Pattern != "^/" || pathPrefixRewrite.MaxLength == nil || *pathPrefixRewrite.MaxLength != 1024 {
+			t.Errorf("pathPrefixRewrite = %+v, want pattern ^/ and max length 1024", pathPrefixRewrite)
+		}
+		hostRewrite := rewriteProps["hostRewrite"]
+		if hostRewrite.Pattern == "" || hostRewrite.MaxLength == nil || *hostRewrite.MaxLength != 255 {
+			t.Errorf("hostRewrite = %+v, want a pattern and max length 255", hostRewrite)
+		}
+	}
+	errorServiceKind := specProps.Properties["customErrorResponsePolicies"].Items.Schema.Properties["policy"].Properties["errorServiceKind"]
+	if len(errorServiceKind.Enum) != 2 {
+		t.Errorf("Enum of customErrorResponsePolicies[].policy.errorServiceKind = %v, want BackendBucket and BackendService", errorServiceKind.Enum)
+	}
+}
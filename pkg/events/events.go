@@ -27,10 +27,25 @@ const (
 
 	SyncIngress       = "Sync"
 	TranslateIngress  = "Translate"
+	HostPathConflict  = "HostPathConflict"
+	SharedLBGroup     = "SharedLBGroup"
 	IPChanged         = "IPChanged"
 	GarbageCollection = "GarbageCollection"
 
+	SSLCertRotationStarted   = "SSLCertRotationStarted"
+	SSLCertRotationCompleted = "SSLCertRotationCompleted"
+	TLSCertExpiring          = "TLSCertExpiring"
+
 	SyncService = "Sync"
+
+	SignedUrlKeyAdded   = "SignedUrlKeyAdded"
+	SignedUrlKeyRetired = "SignedUrlKeyRetired"
+	SignedUrlKeyDeleted = "SignedUrlKeyDeleted"
+
+	SyncGateway      = "Sync"
+	TranslateGateway = "Translate"
+
+	InvalidateCache = "InvalidateCache"
 )
 
 type RecorderProducer interface {
@@ -142,7 +142,7 @@ func NewFirewallController(
 	ctx.ServiceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			svc := obj.(*apiv1.Service)
-			ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesService(svc).AsList()
+			ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesService(svc, operator.RouteConfigs(ctx.RouteConfigs().List())).AsList()
 			if len(ings) > 0 {
 				fwc.queue.Enqueue(queueKey)
 			}
@@ -150,7 +150,7 @@ func NewFirewallController(
 		UpdateFunc: func(old, cur interface{}) {
 			if !reflect.DeepEqual(old, cur) {
 				svc := cur.(*apiv1.Service)
-				ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesService(svc).AsList()
+				ings := operator.Ingresses(ctx.Ingresses().List()).ReferencesService(svc, operator.RouteConfigs(ctx.RouteConfigs().List())).AsList()
 				if len(ings) > 0 {
 					fwc.queue.Enqueue(queueKey)
 				}
@@ -75,6 +75,7 @@ var (
 		GKEClusterName                   string
 		GKEClusterHash                   string
 		GKEClusterType                   string
+		GatewayClassName                 string
 		HealthCheckPath                  string
 		HealthzPort                      int
 		THCPort                          int
@@ -97,13 +98,17 @@ var (
 		LeaderElection                   LeaderElectionConfiguration
 		MetricsExportInterval            time.Duration
 		NegMetricsExportInterval         time.Duration
+		TLSCertExpiryWarningThresholds   Durations
 
 		// Feature flags should be named Enablexxx.
 		EnableASMConfigMapBasedConfig            bool
+		EnableCacheInvalidation                  bool
 		EnableDeleteUnusedFrontends              bool
 		EnableFrontendConfig                     bool
+		EnableGatewayAPI                         bool
 		EnableNonGCPMode                         bool
 		EnableReadinessReflector                 bool
+		EnableRouteConfig                        bool
 		EnableV2FrontendNamer                    bool
 		FinalizerAdd                             bool // Should have been named Enablexxx.
 		FinalizerRemove                          bool // Should have been named Enablexxx.
@@ -162,6 +167,7 @@ func init() {
 	F.NodePortRanges.ports = []string{DefaultNodePortRange}
 	F.GCERateLimit.specs = []string{"alpha.Operations.Get,qps,10,10", "beta.Operations.Get,qps,10,10", "ga.Operations.Get,qps,10,10"}
 	F.LeaderElection = defaultLeaderElectionConfiguration()
+	F.TLSCertExpiryWarningThresholds.durations = []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour}
 }
 
 // Register flags with the command line parser.
@@ -199,6 +205,16 @@ normal environments the controller should only delete a loadbalancer if the
 associated Ingress is deleted.`)
 	flag.BoolVar(&F.EnableFrontendConfig, "enable-frontend-config", false,
 		`Optional, whether or not to enable FrontendConfig.`)
+	flag.BoolVar(&F.EnableRouteConfig, "enable-route-config", false,
+		`Optional, whether or not to enable RouteConfig.`)
+	flag.BoolVar(&F.EnableCacheInvalidation, "enable-cache-invalidation", false,
+		`Optional, whether or not to enable CacheInvalidation, which invalidates
+the CDN cache of Ingresses.`)
+	flag.BoolVar(&F.EnableGatewayAPI, "enable-gateway-api", false,
+		`Optional, whether or not to run the controller for Gateway and HTTPRoute resources.
+The Gateway API CRDs have to be installed in the cluster.`)
+	flag.StringVar(&F.GatewayClassName, "gateway-class-name", "gce",
+		`The GatewayClass of the Gateways managed by this controller.`)
 	flag.Var(&F.GCERateLimit, "gce-ratelimit",
 		`Optional, can be used to rate limit certain GCE API calls. Example usage:
 --gce-ratelimit=ga.Addresses.Get,qps,1.5,5
@@ -255,6 +271,9 @@ the pod secrets for creating a Kubernetes client.`)
 		`If set, overrides what ingress classes are managed by the controller.`)
 	flag.Var(&F.NodePortRanges, "node-port-ranges", `Node port/port-ranges whitelisted for the
 L7 load balancing. CSV values accepted. Example: -node-port-ranges=80,8080,400-500`)
+	flag.Var(&F.TLSCertExpiryWarningThresholds, "tls-cert-expiry-warning-thresholds", `Durations before the expiry of
+a TLS certificate of an Ingress at which warning events are emitted on the Ingress. CSV values accepted.
+Example: -tls-cert-expiry-warning-thresholds=720h,168h,24h`)
 
 	leaderelectionconfig.BindLeaderElectionFlags(&F.LeaderElection.LeaderElectionConfiguration, flag.CommandLine)
 	flag.StringVar(&F.LeaderElection.LockObjectNamespace, "lock-object-namespace", F.LeaderElection.LockObjectNamespace, "Define the namespace of the lock object.")
@@ -382,3 +401,48 @@ func (c *PortRanges) Values() []string {
 func (c *PortRanges) Type() string {
 	return "portRanges"
 }
+
+type Durations struct {
+	durations []time.Duration
+	isSet     bool
+}
+
+// String is the method to format the flag's value, part of the flag.Value interface.
+func (d *Durations) String() string {
+	var values []string
+	for _, duration := range d.durations {
+		values = append(values, duration.String())
+	}
+	return strings.Join(values, ",")
+}
+
+// Set supports a value of CSV. The durations are sorted from the longest to
+// the shortest.
+func (d *Durations) Set(value string) error {
+	if d.isSet {
+		return fmt.Errorf("durations have already been set")
+	}
+	d.isSet = true
+
+	d.durations = nil
+	for _, v := range strings.Split(value, ",") {
+		duration, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return err
+		}
+		if duration <= 0 {
+			return fmt.Errorf("duration %v is not positive", duration)
+		}
+		d.durations = append(d.durations, duration)
+	}
+	sort.Slice(d.durations, func(i, j int) bool { return d.durations[i] > d.durations[j] })
+	return nil
+}
+
+func (d *Durations) Values() []time.Duration {
+	return d.durations
+}
+
+func (d *Durations) Type() string {
+	return "durations"
+}
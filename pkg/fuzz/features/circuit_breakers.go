@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	compute "google.golang.org/api/compute/v1"
+	v1 "k8s.io/api/networking/v1"
+	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfig "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/fuzz"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+// CircuitBreakers is a feature in BackendConfig that supports configuring the
+// circuit breakers and the outlier detection of backend services.
+var CircuitBreakers = &CircuitBreakersFeature{}
+
+// CircuitBreakersFeature implements the associated feature.
+type CircuitBreakersFeature struct{}
+
+// NewValidator implements fuzz.Feature.
+func (CircuitBreakersFeature) NewValidator() fuzz.FeatureValidator {
+	return &circuitBreakersValidator{}
+}
+
+// Name implements fuzz.Feature.
+func (*CircuitBreakersFeature) Name() string {
+	return "CircuitBreakers"
+}
+
+// circuitBreakersValidator is a validator for the CircuitBreakers feature.
+type circuitBreakersValidator struct {
+	fuzz.NullValidator
+
+	env    fuzz.ValidatorEnv
+	ing    *v1.Ingress
+	region string
+}
+
+// Name implements fuzz.FeatureValidator.
+func (*circuitBreakersValidator) Name() string {
+	return "CircuitBreakers"
+}
+
+// ConfigureAttributes implements fuzz.FeatureValidator.
+func (v *circuitBreakersValidator) ConfigureAttributes(env fuzz.ValidatorEnv, ing *v1.Ingress, a *fuzz.IngressValidatorAttributes) error {
+	// Capture the env for use later in CheckResponse.
+	v.ing = ing
+	v.env = env
+	v.region = a.Region
+	return nil
+}
+
+// CheckResponse implements fuzz.FeatureValidator. It checks that the backend
+// service serving the path has the settings of the BackendConfig.
+func (v *circuitBreakersValidator) CheckResponse(host, path string, resp *http.Response, body []byte) (fuzz.CheckResponseAction, error) {
+	backendConfig, err := fuzz.BackendConfigForPath(host, path, v.ing, v.env)
+	if err != nil {
+		if err == annotations.ErrBackendConfigAnnotationMissing {
+			// Don't fail this test if the service associated
+			// with the host + path has no BackendConfig annotation.
+			return fuzz.CheckResponseContinue, nil
+		}
+		return fuzz.CheckResponseContinue, err
+	}
+	if backendConfig.Spec.CircuitBreakers == nil && backendConfig.Spec.OutlierDetection == nil {
+		return fuzz.CheckResponseContinue, nil
+	}
+
+	beService, err := v.backendServiceForPath(host, path)
+	if err != nil {
+		return fuzz.CheckResponseContinue, err
+	}
+	if err := checkCircuitBreakers(backendConfig.Spec.CircuitBreakers, beService.CircuitBreakers); err != nil {
+		return fuzz.CheckResponseContinue, fmt.Errorf("backend service %q: %v", beService.Name, err)
+	}
+	if err := checkOutlierDetection(backendConfig.Spec.OutlierDetection, beService.OutlierDetection); err != nil {
+		return fuzz.CheckResponseContinue, fmt.Errorf("backend service %q: %v", beService.Name, err)
+	}
+	return fuzz.CheckResponseContinue, nil
+}
+
+// backendServiceForPath returns the backend service serving the given path.
+func (v *circuitBreakersValidator) backendServiceForPath(host, path string) (*compute.BackendService, error) {
+	svc, svcPort, err := fuzz.ServiceForPath(host, path, v.ing, v.env)
+	if err != nil {
+		return nil, err
+	}
+	negEnabled, negName, err := (&negValidator{}).getNegNameForServicePort(svc, svcPort)
+	if err != nil {
+		return nil, err
+	}
+	bsName := v.env.BackendNamer().IGBackend(int64(svcPort.NodePort))
+	if negEnabled {
+		bsName = negName
+	}
+
+	ctx := context.Background()
+	if utils.IsGCEL7ILBIngress(v.ing) || utils.IsGCEL7XLBRegionalIngress(v.ing) {
+		return v.env.Cloud().RegionBackendServices().Get(ctx, meta.RegionalKey(bsName, v.region))
+	}
+	return v.env.Cloud().BackendServices().Get(ctx, meta.GlobalKey(bsName))
+}
+
+// checkCircuitBreakers checks that the limits set in want are set in got.
+func checkCircuitBreakers(want *backendconfig.CircuitBreakersConfig, got *compute.CircuitBreakers) error {
+	if want == nil {
+		return nil
+	}
+	if got == nil {
+		return fmt.Errorf("circuit breakers are configured but missing")
+	}
+	return checkInt64Settings("circuit breakers", []int64Setting{
+		{"maxConnections", want.MaxConnections, got.MaxConnections},
+		{"maxPendingRequests", want.MaxPendingRequests, got.MaxPendingRequests},
+		{"maxRequests", want.MaxRequests, got.MaxRequests},
+		{"maxRequestsPerConnection", want.MaxRequestsPerConnection, got.MaxRequestsPerConnection},
+		{"maxRetries", want.MaxRetries, got.MaxRetries},
+	})
+}
+
+// checkOutlierDetection checks that the settings set in want are set in got.
+func checkOutlierDetection(want *backendconfig.OutlierDetectionConfig, got *compute.OutlierDetection) error {
+	if want == nil {
+		return nil
+	}
+	if got == nil {
+		return fmt.Errorf("outlier detection is configured but missing")
+	}
+	if err := checkDuration("outlier detection baseEjectionTime", want.BaseEjectionTime, got.BaseEjectionTime); err != nil {
+		return err
+	}
+	if err := checkDuration("outlier detection interval", want.Interval, got.Interval); err != nil {
+		return err
+	}
+	return checkInt64Settings("outlier detection", []int64Setting{
+		{"consecutiveErrors", want.ConsecutiveErrors, got.ConsecutiveErrors},
+		{"consecutiveGatewayFailure", want.ConsecutiveGatewayFailure, got.ConsecutiveGatewayFailure},
+		{"enforcingConsecutiveErrors", want.EnforcingConsecutiveErrors, got.EnforcingConsecutiveErrors},
+		{"enforcingConsecutiveGatewayFailure", want.EnforcingConsecutiveGatewayFailure, got.EnforcingConsecutiveGatewayFailure},
+		{"enforcingSuccessRate", want.EnforcingSuccessRate, got.EnforcingSuccessRate},
+		{"maxEjectionPercent", want.MaxEjectionPercent, got.MaxEjectionPercent},
+		{"successRateMinimumHosts", want.SuccessRateMinimumHosts, got.SuccessRateMinimumHosts},
+		{"successRateRequestVolume", want.SuccessRateRequestVolume, got.SuccessRateRequestVolume},
+		{"successRateStdevFactor", want.SuccessRateStdevFactor, got.SuccessRateStdevFactor},
+	})
+}
+
+// int64Setting is a setting of a backend service. A setting which is not
+// configured is 0 and left to the GCE default.
+type int64Setting struct {
+	name      string
+	want, got int64
+}
+
+func checkInt64Settings(kind string, settings []int64Setting) error {
+	for _, s := range settings {
+		if s.want != 0 && s.want != s.got {
+			return fmt.Errorf("%s %s is %d, want %d", kind, s.name, s.got, s.want)
+		}
+	}
+	return nil
+}
+
+func checkDuration(name string, want *backendconfig.DurationConfig, got *compute.Duration) error {
+	if want == nil {
+		return nil
+	}
+	if got == nil || want.Seconds != got.Seconds || want.Nanos != got.Nanos {
+		return fmt.Errorf("%s is %+v, want %+v", name, got, *want)
+	}
+	return nil
+}
@@ -33,4 +33,5 @@ var All = []fuzz.Feature{
 	AppProtocol,
 	ILB,
 	HTTPSRedirects,
+	CircuitBreakers,
 }
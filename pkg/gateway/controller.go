@@ -0,0 +1,518 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	context2 "context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	apiv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/ingress-gce/pkg/annotations"
+	gatewayv1beta1 "k8s.io/ingress-gce/pkg/apis/gateway/v1beta1"
+	"k8s.io/ingress-gce/pkg/backends"
+	"k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/flags"
+	gatewayclient "k8s.io/ingress-gce/pkg/gateway/client/clientset/versioned"
+	"k8s.io/ingress-gce/pkg/healthchecks"
+	"k8s.io/ingress-gce/pkg/instancegroups"
+	"k8s.io/ingress-gce/pkg/loadbalancers"
+	negtypes "k8s.io/ingress-gce/pkg/neg/types"
+	"k8s.io/ingress-gce/pkg/translator"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/common"
+	"k8s.io/ingress-gce/pkg/utils/namer"
+	"k8s.io/ingress-gce/pkg/utils/patch"
+	"k8s.io/ingress-gce/pkg/utils/slice"
+	"k8s.io/klog/v2"
+)
+
+// ControllerName is the name of this controller in the status of HTTPRoutes.
+const ControllerName = "networking.gke.io/gateway"
+
+// Controller watches Gateways of the configured GatewayClass and the
+// HTTPRoutes attached to them, and programs a GCE L7 load balancer for each
+// Gateway. Backend services of Gateways are shared with Ingresses and garbage
+// collected by the Ingress controller, see ServicePorts.
+type Controller struct {
+	ctx           *context.ControllerContext
+	gatewayClient gatewayclient.Interface
+
+	gatewayLister   cache.Indexer
+	httpRouteLister cache.Indexer
+	nodeLister      cache.Indexer
+	queue           utils.TaskQueue
+	hasSynced       func() bool
+
+	instancePool  instancegroups.Manager
+	l7Pool        loadbalancers.LoadBalancerPool
+	backendSyncer backends.Syncer
+	negLinker     backends.Linker
+	igLinker      backends.Linker
+}
+
+// NewController returns a Gateway controller.
+func NewController(ctx *context.ControllerContext) *Controller {
+	healthChecker := healthchecks.NewHealthChecker(ctx.Cloud, ctx.HealthCheckPath, ctx.DefaultBackendSvcPort.ID.Service, ctx, ctx.Translator, healthchecks.HealthcheckFlags{
+		EnableTHC: flags.F.EnableTransparentHealthChecks,
+		EnableRecalculationOnBackendConfigRemoval: flags.F.EnableRecalculateUHCOnBCRemoval,
+		THCPort: int64(flags.F.THCPort),
+	})
+	backendPool := backends.NewPool(ctx.Cloud, ctx.ClusterNamer)
+
+	c := &Controller{
+		ctx:             ctx,
+		gatewayClient:   ctx.GatewayClient,
+		gatewayLister:   ctx.GatewayInformer.GetIndexer(),
+		httpRouteLister: ctx.HTTPRouteInformer.GetIndexer(),
+		nodeLister:      ctx.NodeInformer.GetIndexer(),
+		hasSynced:       ctx.HasSynced,
+		instancePool:    ctx.InstancePool,
+		l7Pool:          loadbalancers.NewLoadBalancerPool(ctx.Cloud, ctx.ClusterNamer, ctx, namer.NewFrontendNamerFactory(ctx.ClusterNamer, ctx.KubeSystemUID)),
+		backendSyncer:   backends.NewBackendSyncer(backendPool, healthChecker, ctx.Cloud, ctx.BackendConfigClient, ctx),
+		negLinker:       backends.NewNEGLinker(backendPool, negtypes.NewAdapter(ctx.Cloud), ctx.Cloud, ctx.SvcNegInformer.GetIndexer()),
+		igLinker:        backends.NewInstanceGroupLinker(ctx.InstancePool, backendPool),
+	}
+	c.queue = utils.NewPeriodicTaskQueue("gateway", "gateways", c.sync)
+	c.backendSyncer.Init(ctx.Translator)
+
+	ctx.GatewayInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.queue.Enqueue(obj)
+		},
+		UpdateFunc: func(old, cur interface{}) {
+			oldGw := old.(*gatewayv1beta1.Gateway)
+			curGw := cur.(*gatewayv1beta1.Gateway)
+			// Skip updates of the status and the finalizers, but not periodic
+			// resyncs which pick up changes of Services and Secrets.
+			if oldGw.ResourceVersion != curGw.ResourceVersion && reflect.DeepEqual(oldGw.Spec, curGw.Spec) && oldGw.DeletionTimestamp.Equal(curGw.DeletionTimestamp) {
+				return
+			}
+			c.queue.Enqueue(cur)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.queue.Enqueue(obj)
+		},
+	})
+
+	ctx.HTTPRouteInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueueParents(obj)
+		},
+		UpdateFunc: func(old, cur interface{}) {
+			oldRoute := old.(*gatewayv1beta1.HTTPRoute)
+			curRoute := cur.(*gatewayv1beta1.HTTPRoute)
+			if reflect.DeepEqual(oldRoute.Spec, curRoute.Spec) {
+				return
+			}
+			c.enqueueParents(old)
+			c.enqueueParents(cur)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.enqueueParents(obj)
+		},
+	})
+	return c
+}
+
+// enqueueParents enqueues the Gateways referenced by an HTTPRoute.
+func (c *Controller) enqueueParents(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	route, ok := obj.(*gatewayv1beta1.HTTPRoute)
+	if !ok {
+		return
+	}
+	for _, ref := range route.Spec.ParentRefs {
+		if ref.Kind != nil && *ref.Kind != "Gateway" {
+			continue
+		}
+		namespace := route.Namespace
+		if ref.Namespace != nil {
+			namespace = *ref.Namespace
+		}
+		c.queue.Enqueue(cache.ExplicitKey(types.NamespacedName{Namespace: namespace, Name: ref.Name}.String()))
+	}
+}
+
+// Run waits for the initial sync and processes Gateways until signaled.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	wait.PollUntil(5*time.Second, func() (bool, error) {
+		klog.V(2).Infof("Waiting for initial sync")
+		return c.hasSynced(), nil
+	}, stopCh)
+
+	klog.V(2).Infof("Starting Gateway controller")
+	defer func() {
+		klog.V(2).Infof("Shutting down Gateway controller")
+		c.queue.Shutdown()
+	}()
+
+	go c.queue.Run()
+	<-stopCh
+}
+
+// ServicePorts returns the service ports used by the Gateways. The Ingress
+// controller keeps their backend services during garbage collection. The
+// service ports are translated from the listers rather than taken from the
+// last sync, so that they are known right after a restart of the controller.
+func (c *Controller) ServicePorts() []utils.ServicePort {
+	routes := c.routes()
+	var svcPorts []utils.ServicePort
+	for _, obj := range c.gatewayLister.List() {
+		gw := obj.(*gatewayv1beta1.Gateway)
+		// The load balancer of a Gateway exists until its finalizer is removed.
+		if !c.isManaged(gw) && !common.HasGivenFinalizer(gw.ObjectMeta, common.GatewayFinalizerKey) {
+			continue
+		}
+		urlMap, _, err := translateRoutes(c.ctx.Translator, gw, routes, c.ctx.DefaultBackendSvcPort.ID, c.ctx.ClusterNamer)
+		if err != nil {
+			klog.Errorf("Failed to translate routes of Gateway %s: %v", namespacedName(gw), err)
+			continue
+		}
+		svcPorts = append(svcPorts, urlMap.AllServicePorts()...)
+	}
+	return svcPorts
+}
+
+// isManaged returns true if the Gateway is of the GatewayClass of this
+// controller and is not being deleted.
+func (c *Controller) isManaged(gw *gatewayv1beta1.Gateway) bool {
+	return string(gw.Spec.GatewayClassName) == flags.F.GatewayClassName && gw.DeletionTimestamp == nil
+}
+
+// sync reconciles the load balancer of the Gateway with the given key.
+func (c *Controller) sync(key string) error {
+	obj, exists, err := c.gatewayLister.GetByKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to lookup Gateway %q: %v", key, err)
+	}
+	if !exists {
+		// The finalizer ensures the load balancer was deleted.
+		return nil
+	}
+	gw := obj.(*gatewayv1beta1.Gateway).DeepCopy()
+
+	if !c.isManaged(gw) {
+		if !common.HasGivenFinalizer(gw.ObjectMeta, common.GatewayFinalizerKey) {
+			return nil
+		}
+		return c.deleteLoadBalancer(gw)
+	}
+
+	if gw, err = c.ensureFinalizer(gw); err != nil {
+		return err
+	}
+	routes := c.routes()
+
+	ing, err := toIngress(gw)
+	if err != nil {
+		c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeWarning, events.TranslateGateway, "Error: %v", err)
+		reason := gatewayv1beta1.GatewayReasonInvalid
+		var gwErr *gatewayError
+		if errors.As(err, &gwErr) {
+			reason = gwErr.reason
+		}
+		return c.updateGatewayStatus(gw, string(reason), err, "")
+	}
+
+	urlMap, results, err := translateRoutes(c.ctx.Translator, gw, routes, c.ctx.DefaultBackendSvcPort.ID, c.ctx.ClusterNamer)
+	if err != nil {
+		c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeWarning, events.TranslateGateway, "Error: %v", err)
+		return err
+	}
+	if err := c.updateRouteStatuses(gw, routes, results); err != nil {
+		klog.Errorf("Failed to update status of HTTPRoutes of Gateway %s: %v", key, err)
+	}
+
+	svcPorts := urlMap.AllServicePorts()
+	if err := c.syncBackends(gw, svcPorts); err != nil {
+		c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeWarning, events.SyncGateway, "Error syncing backends: %v", err)
+		c.updateGatewayStatus(gw, string(gatewayv1beta1.GatewayReasonPending), err, "")
+		return err
+	}
+
+	ri, err := c.toRuntimeInfo(gw, ing, urlMap)
+	if err != nil {
+		c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeWarning, events.SyncGateway, "Error: %v", err)
+		c.updateGatewayStatus(gw, string(gatewayv1beta1.GatewayReasonPending), err, "")
+		return err
+	}
+	l7, err := c.l7Pool.Ensure(ri)
+	if err != nil {
+		c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeWarning, events.SyncGateway, "Error syncing load balancer: %v", err)
+		c.updateGatewayStatus(gw, string(gatewayv1beta1.GatewayReasonPending), err, "")
+		return err
+	}
+	return c.updateGatewayStatus(gw, string(gatewayv1beta1.GatewayReasonProgrammed), nil, l7.GetIP())
+}
+
+// routes returns the HTTPRoutes sorted by namespace and name.
+func (c *Controller) routes() []*gatewayv1beta1.HTTPRoute {
+	var routes []*gatewayv1beta1.HTTPRoute
+	for _, obj := range c.httpRouteLister.List() {
+		routes = append(routes, obj.(*gatewayv1beta1.HTTPRoute))
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return namespacedName(routes[i]) < namespacedName(routes[j])
+	})
+	return routes
+}
+
+// syncBackends ensures the backend services of the Gateway and links them to
+// their NEGs or instance groups.
+func (c *Controller) syncBackends(gw *gatewayv1beta1.Gateway, svcPorts []utils.ServicePort) error {
+	var nodePorts []int64
+	for _, sp := range svcPorts {
+		if !sp.NEGEnabled {
+			nodePorts = append(nodePorts, sp.NodePort)
+		}
+	}
+	if len(nodePorts) > 0 {
+		klog.V(2).Infof("Syncing Instance Group for Gateway %s with nodeports %v", namespacedName(gw), nodePorts)
+		if _, err := c.instancePool.EnsureInstanceGroupsAndPorts(c.ctx.ClusterNamer.InstanceGroup(), nodePorts); err != nil {
+			return err
+		}
+		nodeNames, err := utils.GetReadyNodeNames(listers.NewNodeLister(c.nodeLister))
+		if err != nil {
+			return err
+		}
+		if err := c.instancePool.Sync(nodeNames); err != nil {
+			return err
+		}
+	}
+
+	if err := c.backendSyncer.Sync(svcPorts); err != nil {
+		return err
+	}
+
+	zones, err := c.ctx.Translator.ListZones(utils.CandidateNodesPredicate)
+	if err != nil {
+		return err
+	}
+	var groupKeys []backends.GroupKey
+	for _, zone := range zones {
+		groupKeys = append(groupKeys, backends.GroupKey{Zone: zone})
+	}
+	for _, sp := range svcPorts {
+		var linkErr error
+		if sp.NEGEnabled {
+			linkErr = c.negLinker.Link(sp, groupKeys)
+		} else {
+			linkErr = c.igLinker.Link(sp, groupKeys)
+		}
+		if linkErr != nil {
+			return linkErr
+		}
+	}
+	return nil
+}
+
+// toRuntimeInfo returns the L7RuntimeInfo of the Ingress representing the Gateway.
+func (c *Controller) toRuntimeInfo(gw *gatewayv1beta1.Gateway, ing *v1.Ingress, urlMap *utils.GCEURLMap) (*loadbalancers.L7RuntimeInfo, error) {
+	ingAnnotations := annotations.FromIngress(ing)
+	env, err := translator.NewEnv(ing, c.ctx.KubeClient, "", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("error initializing translator env: %v", err)
+	}
+	tls, errs := translator.ToTLSCerts(env)
+	for _, err := range errs {
+		if _, ok := err.(*translator.InvalidCertError); ok {
+			c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeWarning, events.SyncGateway, "Ignoring TLS certificate: %v", err)
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeWarning, events.SyncGateway, "Could not find TLS certificate: %v", err)
+	}
+
+	staticIPName, err := ingAnnotations.StaticIPName()
+	if err != nil {
+		return nil, err
+	}
+	return &loadbalancers.L7RuntimeInfo{
+		TLS:          tls,
+		TLSName:      ingAnnotations.UseNamedTLS(),
+		Ingress:      ing,
+		AllowHTTP:    ingAnnotations.AllowHTTP(),
+		StaticIPName: staticIPName,
+		UrlMap:       urlMap,
+	}, nil
+}
+
+// deleteLoadBalancer deletes the load balancer of the Gateway, detaches the
+// HTTPRoutes from it and removes the finalizer of the Gateway.
+func (c *Controller) deleteLoadBalancer(gw *gatewayv1beta1.Gateway) error {
+	ing := &v1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  gw.Namespace,
+			Name:       gw.Name,
+			UID:        gw.UID,
+			Finalizers: []string{common.GatewayFinalizerKey},
+		},
+	}
+	if err := c.l7Pool.GCv2(ing, meta.Global); err != nil {
+		c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeWarning, events.GarbageCollection, "Error deleting load balancer: %v", err)
+		return err
+	}
+
+	if err := c.updateRouteStatuses(gw, c.routes(), nil); err != nil {
+		return err
+	}
+
+	updated := gw.DeepCopy()
+	updated.Finalizers = slice.RemoveString(updated.Finalizers, common.GatewayFinalizerKey, nil)
+	_, err := c.patchGateway(gw, updated)
+	return err
+}
+
+// ensureFinalizer adds the Gateway finalizer to the Gateway.
+func (c *Controller) ensureFinalizer(gw *gatewayv1beta1.Gateway) (*gatewayv1beta1.Gateway, error) {
+	if common.HasGivenFinalizer(gw.ObjectMeta, common.GatewayFinalizerKey) {
+		return gw, nil
+	}
+	updated := gw.DeepCopy()
+	updated.Finalizers = append(updated.Finalizers, common.GatewayFinalizerKey)
+	return c.patchGateway(gw, updated)
+}
+
+func (c *Controller) patchGateway(original, updated *gatewayv1beta1.Gateway) (*gatewayv1beta1.Gateway, error) {
+	patchBytes, err := patch.MergePatchBytes(original, updated)
+	if err != nil {
+		return original, err
+	}
+	return c.gatewayClient.GatewayV1beta1().Gateways(original.Namespace).Patch(context2.Background(), original.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+}
+
+// updateGatewayStatus sets the Accepted and Programmed conditions and the
+// address of the Gateway. A Gateway that failed validation is not accepted,
+// a Gateway that failed to sync is accepted but not programmed.
+func (c *Controller) updateGatewayStatus(gw *gatewayv1beta1.Gateway, reason string, syncErr error, ip string) error {
+	updated := gw.DeepCopy()
+	accepted := metav1.Condition{
+		Type:               string(gatewayv1beta1.GatewayConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayv1beta1.GatewayReasonAccepted),
+		ObservedGeneration: gw.Generation,
+	}
+	programmed := metav1.Condition{
+		Type:               string(gatewayv1beta1.GatewayConditionProgrammed),
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		ObservedGeneration: gw.Generation,
+	}
+	if syncErr != nil {
+		programmed.Status = metav1.ConditionFalse
+		programmed.Message = syncErr.Error()
+		if reason != string(gatewayv1beta1.GatewayReasonPending) {
+			accepted.Status = metav1.ConditionFalse
+			accepted.Reason = reason
+			accepted.Message = syncErr.Error()
+			programmed.Reason = string(gatewayv1beta1.GatewayReasonInvalid)
+		}
+	}
+	apimeta.SetStatusCondition(&updated.Status.Conditions, accepted)
+	apimeta.SetStatusCondition(&updated.Status.Conditions, programmed)
+	if ip != "" {
+		addrType := gatewayv1beta1.IPAddressType
+		updated.Status.Addresses = []gatewayv1beta1.GatewayAddress{{Type: &addrType, Value: ip}}
+	}
+
+	if reflect.DeepEqual(gw.Status, updated.Status) {
+		return nil
+	}
+	if _, err := c.gatewayClient.GatewayV1beta1().Gateways(gw.Namespace).UpdateStatus(context2.Background(), updated, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update status of Gateway %s: %v", namespacedName(gw), err)
+	}
+	if syncErr == nil && programmed.Status != findStatus(gw.Status.Conditions, programmed.Type) {
+		c.ctx.Recorder(gw.Namespace).Eventf(gw, apiv1.EventTypeNormal, events.SyncGateway, "Programmed load balancer with IP %s", ip)
+	}
+	return nil
+}
+
+func findStatus(conditions []metav1.Condition, conditionType string) metav1.ConditionStatus {
+	if cond := apimeta.FindStatusCondition(conditions, conditionType); cond != nil {
+		return cond.Status
+	}
+	return metav1.ConditionUnknown
+}
+
+// updateRouteStatuses sets the status of the HTTPRoutes for the Gateway from
+// the results of their translation. The status for the Gateway is removed
+// from routes without a result.
+func (c *Controller) updateRouteStatuses(gw *gatewayv1beta1.Gateway, routes []*gatewayv1beta1.HTTPRoute, results map[types.NamespacedName]*routeResult) error {
+	var errs []error
+	for _, route := range routes {
+		result := results[types.NamespacedName{Namespace: route.Namespace, Name: route.Name}]
+		updated := route.DeepCopy()
+		var parents []gatewayv1beta1.RouteParentStatus
+		var current *gatewayv1beta1.RouteParentStatus
+		for i, parent := range updated.Status.Parents {
+			if parent.ControllerName == ControllerName && isGatewayRef(parent.ParentRef, route, gw) {
+				current = &updated.Status.Parents[i]
+				continue
+			}
+			parents = append(parents, parent)
+		}
+		if result != nil {
+			status := gatewayv1beta1.RouteParentStatus{ParentRef: result.parentRef, ControllerName: ControllerName}
+			if current != nil {
+				status.Conditions = current.Conditions
+			}
+			for _, cond := range result.conditions(route.Generation) {
+				apimeta.SetStatusCondition(&status.Conditions, cond)
+			}
+			parents = append(parents, status)
+		}
+		updated.Status.Parents = parents
+
+		if reflect.DeepEqual(route.Status, updated.Status) {
+			continue
+		}
+		if _, err := c.gatewayClient.GatewayV1beta1().HTTPRoutes(route.Namespace).UpdateStatus(context2.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update status of HTTPRoute %s: %v", namespacedName(route), err))
+		}
+	}
+	if len(errs) > 0 {
+		return utils.JoinErrs(errs)
+	}
+	return nil
+}
+
+// isGatewayRef returns true if the parentRef of the route references the Gateway.
+func isGatewayRef(ref gatewayv1beta1.ParentReference, route *gatewayv1beta1.HTTPRoute, gw *gatewayv1beta1.Gateway) bool {
+	namespace := route.Namespace
+	if ref.Namespace != nil {
+		namespace = *ref.Namespace
+	}
+	return namespace == gw.Namespace && ref.Name == gw.Name
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	context2 "context"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	apiv1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+	gatewayv1beta1 "k8s.io/ingress-gce/pkg/apis/gateway/v1beta1"
+	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned/fake"
+	"k8s.io/ingress-gce/pkg/backends"
+	"k8s.io/ingress-gce/pkg/context"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/flags"
+	gatewayclient "k8s.io/ingress-gce/pkg/gateway/client/clientset/versioned/fake"
+	"k8s.io/ingress-gce/pkg/instancegroups"
+	"k8s.io/ingress-gce/pkg/loadbalancers"
+	"k8s.io/ingress-gce/pkg/test"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/common"
+	namer_util "k8s.io/ingress-gce/pkg/utils/namer"
+)
+
+// newTestController returns a Gateway controller backed by the fake GCE cloud
+// with the system default backend and the Service "ns/foo" on node port 30001.
+func newTestController(t *testing.T, fakeGCE *gce.Cloud) *Controller {
+	t.Helper()
+	flags.F.GatewayClassName = "gce"
+
+	kubeClient := fake.NewSimpleClientset()
+	ctxConfig := context.ControllerContextConfig{
+		Namespace:             apiv1.NamespaceAll,
+		ResyncPeriod:          1 * time.Minute,
+		DefaultBackendSvcPort: test.DefaultBeSvcPort,
+		HealthCheckPath:       "/",
+	}
+	ctx := context.NewControllerContext(nil, kubeClient, backendconfigclient.NewSimpleClientset(), nil, nil, nil, nil, nil, nil, gatewayclient.NewSimpleClientset(), nil, nil, fakeGCE, defaultNamer, "" /*kubeSystemUID*/, ctxConfig)
+	c := NewController(ctx)
+	c.instancePool = instancegroups.NewManager(&instancegroups.ManagerConfig{
+		Cloud:      instancegroups.NewEmptyFakeInstanceGroups(),
+		Namer:      defaultNamer,
+		Recorders:  &test.FakeRecorderSource{},
+		BasePath:   utils.GetBasePath(fakeGCE),
+		ZoneLister: &instancegroups.FakeZoneLister{Zones: []string{"zone-a"}},
+		MaxIGSize:  1000,
+	})
+	c.igLinker = backends.NewInstanceGroupLinker(c.instancePool, backends.NewPool(fakeGCE, defaultNamer))
+	c.l7Pool = loadbalancers.NewLoadBalancerPool(fakeGCE, defaultNamer, events.RecorderProducerMock{}, namer_util.NewFrontendNamerFactory(defaultNamer, ""))
+
+	for _, svc := range []*apiv1.Service{
+		test.NewService(test.DefaultBeSvcPort.ID.Service, apiv1.ServiceSpec{
+			Type:  apiv1.ServiceTypeNodePort,
+			Ports: []apiv1.ServicePort{{Name: "http", Port: 80, NodePort: 30000}},
+		}),
+		test.NewService(types.NamespacedName{Namespace: "ns", Name: "foo"}, apiv1.ServiceSpec{
+			Type:  apiv1.ServiceTypeNodePort,
+			Ports: []apiv1.ServicePort{{Port: 80, NodePort: 30001}},
+		}),
+	} {
+		ctx.ServiceInformer.GetIndexer().Add(svc)
+	}
+	return c
+}
+
+// addGateway adds the Gateway to the fake client and the lister of the controller.
+func addGateway(t *testing.T, c *Controller, gw *gatewayv1beta1.Gateway) {
+	t.Helper()
+	if _, err := c.gatewayClient.GatewayV1beta1().Gateways(gw.Namespace).Create(context2.TODO(), gw, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create(%s) = %v", namespacedName(gw), err)
+	}
+	c.gatewayLister.Add(gw)
+}
+
+// addHTTPRoute adds an HTTPRoute of the Gateway to the Service "ns/foo" to
+// the fake client and the lister of the controller.
+func addHTTPRoute(t *testing.T, c *Controller, gw *gatewayv1beta1.Gateway) {
+	t.Helper()
+	route := &gatewayv1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gw.Namespace, Name: "route"},
+		Spec: gatewayv1beta1.HTTPRouteSpec{
+			ParentRefs: []gatewayv1beta1.ParentReference{{Name: gw.Name}},
+			Hostnames:  []string{"foo.example.com"},
+			Rules:      []gatewayv1beta1.HTTPRouteRule{{BackendRefs: []gatewayv1beta1.HTTPBackendRef{{Name: "foo", Port: int32Ptr(80)}}}},
+		},
+	}
+	if _, err := c.gatewayClient.GatewayV1beta1().HTTPRoutes(route.Namespace).Create(context2.TODO(), route, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Create(%s) = %v", namespacedName(route), err)
+	}
+	c.httpRouteLister.Add(route)
+}
+
+func listURLMaps(t *testing.T, fakeGCE *gce.Cloud) int {
+	t.Helper()
+	urlMaps, err := fakeGCE.Compute().UrlMaps().List(context2.TODO(), filter.None)
+	if err != nil {
+		t.Fatalf("UrlMaps().List() = %v", err)
+	}
+	return len(urlMaps)
+}
+
+func TestSync(t *testing.T) {
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	(fakeGCE.Compute().(*cloud.MockGCE)).MockGlobalForwardingRules.InsertHook = loadbalancers.InsertGlobalForwardingRuleHook
+	c := newTestController(t, fakeGCE)
+
+	gw := newGateway(httpListener("http", nil))
+	addGateway(t, c, gw)
+	addHTTPRoute(t, c, gw)
+
+	key := namespacedName(gw)
+	if err := c.sync(key); err != nil {
+		t.Fatalf("sync(%q) = %v", key, err)
+	}
+
+	if got := listURLMaps(t, fakeGCE); got != 1 {
+		t.Errorf("got %d url maps, want 1", got)
+	}
+	for _, nodePort := range []int64{30000, 30001} {
+		beName := defaultNamer.IGBackend(nodePort)
+		if _, err := fakeGCE.Compute().BackendServices().Get(context2.TODO(), meta.GlobalKey(beName)); err != nil {
+			t.Errorf("BackendServices().Get(%q) = %v, want backend service", beName, err)
+		}
+	}
+	got, err := c.gatewayClient.GatewayV1beta1().Gateways(gw.Namespace).Get(context2.TODO(), gw.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get(%s) = %v", key, err)
+	}
+	if !common.HasGivenFinalizer(got.ObjectMeta, common.GatewayFinalizerKey) {
+		t.Errorf("Finalizers = %v, want %q", got.Finalizers, common.GatewayFinalizerKey)
+	}
+	if !apimeta.IsStatusConditionTrue(got.Status.Conditions, string(gatewayv1beta1.GatewayConditionProgrammed)) {
+		t.Errorf("Status.Conditions = %+v, want Programmed", got.Status.Conditions)
+	}
+	if len(got.Status.Addresses) != 1 {
+		t.Errorf("Status.Addresses = %+v, want the IP of the load balancer", got.Status.Addresses)
+	}
+
+	// Deleting the Gateway deletes its load balancer and removes the finalizer.
+	got.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	c.gatewayLister.Update(got)
+	if err := c.sync(key); err != nil {
+		t.Fatalf("sync(%q) = %v", key, err)
+	}
+	if got := listURLMaps(t, fakeGCE); got != 0 {
+		t.Errorf("got %d url maps after deletion, want 0", got)
+	}
+	deleted, err := c.gatewayClient.GatewayV1beta1().Gateways(gw.Namespace).Get(context2.TODO(), gw.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get(%s) = %v", key, err)
+	}
+	if common.HasGivenFinalizer(deleted.ObjectMeta, common.GatewayFinalizerKey) {
+		t.Errorf("Finalizers = %v, want no %q", deleted.Finalizers, common.GatewayFinalizerKey)
+	}
+}
+
+func TestServicePorts(t *testing.T) {
+	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
+	(fakeGCE.Compute().(*cloud.MockGCE)).MockGlobalForwardingRules.InsertHook = loadbalancers.InsertGlobalForwardingRuleHook
+	c := newTestController(t, fakeGCE)
+
+	gw := newGateway(httpListener("http", nil))
+	addGateway(t, c, gw)
+	addHTTPRoute(t, c, gw)
+	key := namespacedName(gw)
+	if err := c.sync(key); err != nil {
+		t.Fatalf("sync(%q) = %v", key, err)
+	}
+	synced, err := c.gatewayClient.GatewayV1beta1().Gateways(gw.Namespace).Get(context2.TODO(), gw.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get(%s) = %v", key, err)
+	}
+	// A Gateway of another class without load balancer.
+	other := newGateway(httpListener("http", nil))
+	other.Name = "other"
+	other.Spec.GatewayClassName = "other"
+
+	// A restarted controller has not synced the Gateway yet, but must report
+	// the service ports of its load balancer before the Ingress controller
+	// garbage collects backend services.
+	restarted := newTestController(t, fakeGCE)
+	addGateway(t, restarted, synced)
+	addGateway(t, restarted, other)
+	addHTTPRoute(t, restarted, gw)
+
+	nodePorts := map[int64]bool{}
+	for _, sp := range restarted.ServicePorts() {
+		nodePorts[sp.NodePort] = true
+	}
+	if !nodePorts[30000] || !nodePorts[30001] || len(nodePorts) != 2 {
+		t.Fatalf("ServicePorts() has node ports %v, want 30000 and 30001", nodePorts)
+	}
+
+	// Garbage collection with the service ports of the Gateway keeps its
+	// backend services.
+	if err := restarted.backendSyncer.GC(restarted.ServicePorts()); err != nil {
+		t.Fatalf("GC() = %v", err)
+	}
+	for _, nodePort := range []int64{30000, 30001} {
+		beName := defaultNamer.IGBackend(nodePort)
+		if _, err := fakeGCE.Compute().BackendServices().Get(context2.TODO(), meta.GlobalKey(beName)); err != nil {
+			t.Errorf("BackendServices().Get(%q) = %v after GC, want backend service", beName, err)
+		}
+	}
+}
@@ -0,0 +1,520 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/ingress-gce/pkg/annotations"
+	"k8s.io/ingress-gce/pkg/apis/gateway"
+	gatewayv1beta1 "k8s.io/ingress-gce/pkg/apis/gateway/v1beta1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+	"k8s.io/ingress-gce/pkg/controller/translator"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/common"
+	namer_util "k8s.io/ingress-gce/pkg/utils/namer"
+)
+
+const (
+	// httpPort and httpsPort are the ports GCE L7 load balancers listen on.
+	httpPort  = 80
+	httpsPort = 443
+	// maxBackendWeight is the largest weight GCE accepts for a weighted backend service.
+	maxBackendWeight = 1000
+	// preSharedCertsOption is the TLS option of a Listener naming the GCE
+	// SSL certificates to use, as a comma-separated list.
+	preSharedCertsOption = "networking.gke.io/pre-shared-certs"
+)
+
+// gatewayError is an error in the spec of a Gateway.
+type gatewayError struct {
+	reason gatewayv1beta1.GatewayConditionReason
+	err    error
+}
+
+func (e *gatewayError) Error() string {
+	return e.err.Error()
+}
+
+// toIngress returns the Ingress that represents the Gateway for the load
+// balancer pool. The Ingress has the namespace, name and UID of the Gateway
+// and uses the Gateway frontend naming scheme. HTTPS Listeners are converted
+// to TLS secrets and pre-shared certificates of the Ingress, a named address
+// to its static IP.
+func toIngress(gw *gatewayv1beta1.Gateway) (*v1.Ingress, error) {
+	ing := &v1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   gw.Namespace,
+			Name:        gw.Name,
+			UID:         gw.UID,
+			Finalizers:  []string{common.GatewayFinalizerKey},
+			Annotations: map[string]string{},
+		},
+	}
+
+	allowHTTP := false
+	var preSharedCerts []string
+	seenSecrets := map[string]bool{}
+	for _, l := range gw.Spec.Listeners {
+		switch {
+		case l.Protocol == gatewayv1beta1.HTTPProtocolType && l.Port == httpPort:
+			allowHTTP = true
+		case l.Protocol == gatewayv1beta1.HTTPSProtocolType && l.Port == httpsPort:
+			if l.TLS == nil {
+				return nil, &gatewayError{gatewayv1beta1.GatewayReasonListenersNotValid, fmt.Errorf("listener %q has no TLS configuration", l.Name)}
+			}
+			for _, ref := range l.TLS.CertificateRefs {
+				if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Secret") {
+					return nil, &gatewayError{gatewayv1beta1.GatewayReasonListenersNotValid, fmt.Errorf("listener %q references certificate %q which is not a Secret", l.Name, ref.Name)}
+				}
+				if ref.Namespace != nil && *ref.Namespace != gw.Namespace {
+					return nil, &gatewayError{gatewayv1beta1.GatewayReasonListenersNotValid, fmt.Errorf("listener %q references Secret %s/%s in another namespace", l.Name, *ref.Namespace, ref.Name)}
+				}
+				if !seenSecrets[ref.Name] {
+					seenSecrets[ref.Name] = true
+					ing.Spec.TLS = append(ing.Spec.TLS, v1.IngressTLS{SecretName: ref.Name})
+				}
+			}
+			if certs := l.TLS.Options[preSharedCertsOption]; certs != "" {
+				preSharedCerts = append(preSharedCerts, certs)
+			}
+			if len(l.TLS.CertificateRefs) == 0 && len(l.TLS.Options[preSharedCertsOption]) == 0 {
+				return nil, &gatewayError{gatewayv1beta1.GatewayReasonListenersNotValid, fmt.Errorf("listener %q has no certificate", l.Name)}
+			}
+		default:
+			return nil, &gatewayError{gatewayv1beta1.GatewayReasonListenersNotValid, fmt.Errorf("listener %q: protocol %s on port %d is not supported, only HTTP on port %d and HTTPS on port %d are", l.Name, l.Protocol, l.Port, httpPort, httpsPort)}
+		}
+	}
+	if !allowHTTP {
+		ing.Annotations[annotations.AllowHTTPKey] = "false"
+	}
+	if len(preSharedCerts) > 0 {
+		ing.Annotations[annotations.PreSharedCertKey] = strings.Join(preSharedCerts, ",")
+	}
+
+	for _, addr := range gw.Spec.Addresses {
+		if addr.Type == nil || *addr.Type != gatewayv1beta1.NamedAddressType {
+			return nil, &gatewayError{gatewayv1beta1.GatewayReasonUnsupportedAddress, fmt.Errorf("address %q: only addresses of type %s are supported", addr.Value, gatewayv1beta1.NamedAddressType)}
+		}
+		if _, ok := ing.Annotations[annotations.GlobalStaticIPNameKey]; ok {
+			return nil, &gatewayError{gatewayv1beta1.GatewayReasonUnsupportedAddress, fmt.Errorf("only one address can be requested")}
+		}
+		ing.Annotations[annotations.GlobalStaticIPNameKey] = addr.Value
+	}
+	return ing, nil
+}
+
+// routeResult is the outcome of the translation of an HTTPRoute for a Gateway.
+type routeResult struct {
+	parentRef gatewayv1beta1.ParentReference
+	// acceptedReason and acceptedErr are set if the route is not attached
+	// to the Gateway.
+	acceptedReason gatewayv1beta1.RouteConditionReason
+	acceptedErr    error
+	// refsReason and refsErrs are set if some backendRefs of the route could
+	// not be resolved. The rules are translated without these backends.
+	refsReason gatewayv1beta1.RouteConditionReason
+	refsErrs   []error
+}
+
+// conditions returns the status conditions of the route for the Gateway.
+func (r *routeResult) conditions(generation int64) []metav1.Condition {
+	accepted := metav1.Condition{
+		Type:               string(gatewayv1beta1.RouteConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayv1beta1.RouteReasonAccepted),
+		ObservedGeneration: generation,
+	}
+	if r.acceptedErr != nil {
+		accepted.Status = metav1.ConditionFalse
+		accepted.Reason = string(r.acceptedReason)
+		accepted.Message = r.acceptedErr.Error()
+	}
+	resolvedRefs := metav1.Condition{
+		Type:               string(gatewayv1beta1.RouteConditionResolvedRefs),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayv1beta1.RouteReasonResolvedRefs),
+		ObservedGeneration: generation,
+	}
+	if len(r.refsErrs) > 0 {
+		resolvedRefs.Status = metav1.ConditionFalse
+		resolvedRefs.Reason = string(r.refsReason)
+		resolvedRefs.Message = utils.JoinErrs(r.refsErrs).Error()
+	}
+	return []metav1.Condition{accepted, resolvedRefs}
+}
+
+// refError records a backendRef that could not be resolved.
+func (r *routeResult) refError(reason gatewayv1beta1.RouteConditionReason, err error) {
+	if len(r.refsErrs) == 0 {
+		r.refsReason = reason
+	}
+	r.refsErrs = append(r.refsErrs, err)
+}
+
+// routeCandidate is a RouteRule of a host along with the information needed
+// to order it by the precedence rules of the Gateway API.
+type routeCandidate struct {
+	rule       utils.RouteRule
+	exact      bool
+	pathLength int
+	headers    int
+	queries    int
+	route      *gatewayv1beta1.HTTPRoute
+	index      int
+}
+
+// translateRoutes converts the HTTPRoutes attached to the Gateway into a
+// GCEURLMap whose default backend is the system default backend. It returns
+// the result of the translation for each route.
+func translateRoutes(t *translator.Translator, gw *gatewayv1beta1.Gateway, routes []*gatewayv1beta1.HTTPRoute, defaultBackend utils.ServicePortID, namer namer_util.BackendNamer) (*utils.GCEURLMap, map[types.NamespacedName]*routeResult, error) {
+	urlMap := utils.NewGCEURLMap()
+	results := map[types.NamespacedName]*routeResult{}
+	candidates := map[string][]routeCandidate{}
+	var hosts []string
+
+	for _, route := range routes {
+		parentRef, ok := parentRefForGateway(route, gw)
+		if !ok {
+			continue
+		}
+		result := &routeResult{parentRef: parentRef}
+		results[types.NamespacedName{Namespace: route.Namespace, Name: route.Name}] = result
+
+		routeHosts, reason, err := routeHostnames(route, gw, parentRef)
+		if err != nil {
+			result.acceptedReason, result.acceptedErr = reason, err
+			continue
+		}
+		routeCandidates, err := translateRules(t, route, namer, result)
+		if err != nil {
+			result.acceptedReason, result.acceptedErr = gatewayv1beta1.RouteReasonUnsupportedValue, err
+			continue
+		}
+		for _, host := range routeHosts {
+			if _, ok := candidates[host]; !ok {
+				hosts = append(hosts, host)
+			}
+			candidates[host] = append(candidates[host], routeCandidates...)
+		}
+	}
+
+	for _, host := range hosts {
+		hostCandidates := candidates[host]
+		sort.SliceStable(hostCandidates, func(i, j int) bool {
+			return higherPrecedence(hostCandidates[i], hostCandidates[j])
+		})
+		var routeRules []utils.RouteRule
+		for _, c := range hostCandidates {
+			routeRules = append(routeRules, c.rule)
+		}
+		urlMap.PutRouteRulesForHost(host, routeRules)
+	}
+
+	svcPort, err, _ := t.GetServicePort(defaultBackend, namer)
+	if err != nil {
+		return urlMap, results, fmt.Errorf("failed to retrieve the system default backend service %q with port %q: %v", defaultBackend.Service.String(), defaultBackend.Port.String(), err)
+	}
+	urlMap.DefaultBackend = svcPort
+	return urlMap, results, nil
+}
+
+// higherPrecedence returns true if a has to be evaluated before b. Exact path
+// matches come first, then longer paths, then the matches with more header
+// and query parameter matches. Ties are broken by the age and the name of the
+// routes, and the order of the rules within a route.
+func higherPrecedence(a, b routeCandidate) bool {
+	if a.exact != b.exact {
+		return a.exact
+	}
+	if a.pathLength != b.pathLength {
+		return a.pathLength > b.pathLength
+	}
+	if a.headers != b.headers {
+		return a.headers > b.headers
+	}
+	if a.queries != b.queries {
+		return a.queries > b.queries
+	}
+	if a.route != b.route {
+		if !a.route.CreationTimestamp.Equal(&b.route.CreationTimestamp) {
+			return a.route.CreationTimestamp.Before(&b.route.CreationTimestamp)
+		}
+		return namespacedName(a.route) < namespacedName(b.route)
+	}
+	return a.index < b.index
+}
+
+// parentRefForGateway returns the parentRef of the route that references the
+// Gateway, if any.
+func parentRefForGateway(route *gatewayv1beta1.HTTPRoute, gw *gatewayv1beta1.Gateway) (gatewayv1beta1.ParentReference, bool) {
+	for _, ref := range route.Spec.ParentRefs {
+		if ref.Group != nil && *ref.Group != gateway.GroupName {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != "Gateway" {
+			continue
+		}
+		namespace := route.Namespace
+		if ref.Namespace != nil {
+			namespace = *ref.Namespace
+		}
+		if namespace == gw.Namespace && ref.Name == gw.Name {
+			return ref, true
+		}
+	}
+	return gatewayv1beta1.ParentReference{}, false
+}
+
+// routeHostnames returns the hosts of the GCEURLMap the route applies to, based
+// on the hostnames of the route and of the Listeners it attaches to. A host
+// without hostname restriction is represented by DefaultHost.
+func routeHostnames(route *gatewayv1beta1.HTTPRoute, gw *gatewayv1beta1.Gateway, parentRef gatewayv1beta1.ParentReference) ([]string, gatewayv1beta1.RouteConditionReason, error) {
+	if route.Namespace != gw.Namespace {
+		return nil, gatewayv1beta1.RouteReasonNotAllowedByListeners, fmt.Errorf("routes from namespace %q are not allowed by Gateway %s", route.Namespace, namespacedName(gw))
+	}
+
+	var listeners []gatewayv1beta1.Listener
+	for _, l := range gw.Spec.Listeners {
+		if parentRef.SectionName == nil || *parentRef.SectionName == l.Name {
+			listeners = append(listeners, l)
+		}
+	}
+	if len(listeners) == 0 {
+		return nil, gatewayv1beta1.RouteReasonNoMatchingParent, fmt.Errorf("Gateway %s has no listener %q", namespacedName(gw), *parentRef.SectionName)
+	}
+
+	var hosts []string
+	seen := map[string]bool{}
+	add := func(host string) {
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	for _, l := range listeners {
+		listenerHost := ""
+		if l.Hostname != nil {
+			listenerHost = *l.Hostname
+		}
+		if len(route.Spec.Hostnames) == 0 {
+			if listenerHost == "" {
+				listenerHost = translator.DefaultHost
+			}
+			add(listenerHost)
+			continue
+		}
+		for _, routeHost := range route.Spec.Hostnames {
+			if host, ok := intersectHostnames(listenerHost, routeHost); ok {
+				add(host)
+			}
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, gatewayv1beta1.RouteReasonNoMatchingListenerHostname, fmt.Errorf("no hostname of the route matches the listeners of Gateway %s", namespacedName(gw))
+	}
+	return hosts, "", nil
+}
+
+// intersectHostnames returns the most specific of the hostnames of a Listener
+// and of a route if they match each other. An empty listener hostname matches
+// all hostnames. Either hostname can be prefixed with a wildcard label.
+func intersectHostnames(listenerHost, routeHost string) (string, bool) {
+	switch {
+	case listenerHost == "" || listenerHost == routeHost:
+		return routeHost, true
+	case wildcardMatches(listenerHost, routeHost):
+		return routeHost, true
+	case wildcardMatches(routeHost, listenerHost):
+		return listenerHost, true
+	}
+	return "", false
+}
+
+// wildcardMatches returns true if the wildcard hostname matches the hostname.
+// A wildcard only matches hostnames with at least one more label.
+func wildcardMatches(wildcard, hostname string) bool {
+	if !strings.HasPrefix(wildcard, "*.") {
+		return false
+	}
+	suffix := strings.TrimPrefix(wildcard, "*")
+	return strings.HasSuffix(hostname, suffix) && len(hostname) > len(suffix)
+}
+
+// translateRules converts the rules of the route into route candidates, one
+// per match. Backends that can not be resolved are recorded in the result and
+// left out; rules without any backend are left out as well.
+func translateRules(t *translator.Translator, route *gatewayv1beta1.HTTPRoute, namer namer_util.BackendNamer, result *routeResult) ([]routeCandidate, error) {
+	var candidates []routeCandidate
+	for i, rule := range route.Spec.Rules {
+		backend, weighted, err := translateBackendRefs(t, route, rule.BackendRefs, namer, result)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %v", i, err)
+		}
+		if backend == nil && len(weighted) == 0 {
+			continue
+		}
+
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []gatewayv1beta1.HTTPRouteMatch{{}}
+		}
+		for _, m := range matches {
+			routeMatches, exact, pathLength, err := toRouteMatches(m)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: %v", i, err)
+			}
+			routeRule := utils.RouteRule{Matches: routeMatches, WeightedBackends: weighted}
+			if backend != nil {
+				routeRule.Backend = *backend
+			}
+			candidates = append(candidates, routeCandidate{
+				rule:       routeRule,
+				exact:      exact,
+				pathLength: pathLength,
+				headers:    len(m.Headers),
+				queries:    len(m.QueryParams),
+				route:      route,
+				index:      len(candidates),
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// translateBackendRefs resolves the backendRefs of a rule. It returns the
+// single backend of the rule, or its weighted backends if there are several.
+func translateBackendRefs(t *translator.Translator, route *gatewayv1beta1.HTTPRoute, refs []gatewayv1beta1.HTTPBackendRef, namer namer_util.BackendNamer, result *routeResult) (*utils.ServicePort, []utils.WeightedBackend, error) {
+	var weighted []utils.WeightedBackend
+	for _, ref := range refs {
+		weight := int32(1)
+		if ref.Weight != nil {
+			weight = *ref.Weight
+		}
+		if weight < 0 || weight > maxBackendWeight {
+			return nil, nil, fmt.Errorf("weight %d of backend %q must be between 0 and %d", weight, ref.Name, maxBackendWeight)
+		}
+		if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Service") {
+			result.refError(gatewayv1beta1.RouteReasonInvalidKind, fmt.Errorf("backend %q is not a Service", ref.Name))
+			continue
+		}
+		if ref.Namespace != nil && *ref.Namespace != route.Namespace {
+			result.refError(gatewayv1beta1.RouteReasonRefNotPermitted, fmt.Errorf("backend %s/%s is in another namespace", *ref.Namespace, ref.Name))
+			continue
+		}
+		if ref.Port == nil {
+			return nil, nil, fmt.Errorf("backend %q has no port", ref.Name)
+		}
+		if weight == 0 {
+			continue
+		}
+
+		id := utils.ServicePortID{
+			Service: types.NamespacedName{Namespace: route.Namespace, Name: ref.Name},
+			Port:    v1.ServiceBackendPort{Number: *ref.Port},
+		}
+		svcPort, err, _ := t.GetServicePort(id, namer)
+		if err != nil {
+			result.refError(gatewayv1beta1.RouteReasonBackendNotFound, err)
+			continue
+		}
+		weighted = append(weighted, utils.WeightedBackend{Backend: *svcPort, Weight: int64(weight)})
+	}
+
+	if len(weighted) == 1 {
+		return &weighted[0].Backend, nil, nil
+	}
+	return nil, weighted, nil
+}
+
+// toRouteMatches converts an HTTPRouteMatch into RouteMatches, which are
+// satisfied if any of them matches. It also returns whether the path is an
+// exact match and the length of the path, used to order the rules.
+// A PathPrefix match is split into an exact match of the prefix and a prefix
+// match of the prefix followed by "/", since the Gateway API matches prefixes
+// by path elements.
+func toRouteMatches(m gatewayv1beta1.HTTPRouteMatch) ([]routeconfigv1beta1.RouteMatch, bool, int, error) {
+	pathType := gatewayv1beta1.PathMatchPathPrefix
+	path := "/"
+	if m.Path != nil {
+		if m.Path.Type != nil {
+			pathType = *m.Path.Type
+		}
+		if m.Path.Value != nil {
+			path = *m.Path.Value
+		}
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, false, 0, fmt.Errorf("path %q must start with \"/\"", path)
+	}
+
+	base := routeconfigv1beta1.RouteMatch{}
+	for _, h := range m.Headers {
+		headerMatch := routeconfigv1beta1.HeaderMatch{Name: h.Name}
+		switch {
+		case h.Type == nil || *h.Type == gatewayv1beta1.HeaderMatchExact:
+			headerMatch.ExactMatch = h.Value
+		case *h.Type == gatewayv1beta1.HeaderMatchRegularExpression:
+			headerMatch.RegexMatch = h.Value
+		default:
+			return nil, false, 0, fmt.Errorf("header match type %q is not supported", *h.Type)
+		}
+		base.Headers = append(base.Headers, headerMatch)
+	}
+	for _, q := range m.QueryParams {
+		queryMatch := routeconfigv1beta1.QueryParameterMatch{Name: q.Name}
+		switch {
+		case q.Type == nil || *q.Type == gatewayv1beta1.HeaderMatchExact:
+			queryMatch.ExactMatch = q.Value
+		case *q.Type == gatewayv1beta1.HeaderMatchRegularExpression:
+			queryMatch.RegexMatch = q.Value
+		default:
+			return nil, false, 0, fmt.Errorf("query parameter match type %q is not supported", *q.Type)
+		}
+		base.QueryParameters = append(base.QueryParameters, queryMatch)
+	}
+
+	switch pathType {
+	case gatewayv1beta1.PathMatchExact:
+		match := base
+		match.FullPathMatch = path
+		return []routeconfigv1beta1.RouteMatch{match}, true, len(path), nil
+	case gatewayv1beta1.PathMatchPathPrefix:
+		prefix := strings.TrimSuffix(path, "/")
+		if prefix == "" {
+			match := base
+			match.PrefixMatch = "/"
+			return []routeconfigv1beta1.RouteMatch{match}, false, 1, nil
+		}
+		exactMatch, prefixMatch := base, base
+		exactMatch.FullPathMatch = prefix
+		prefixMatch.PrefixMatch = prefix + "/"
+		return []routeconfigv1beta1.RouteMatch{exactMatch, prefixMatch}, false, len(prefix), nil
+	default:
+		return nil, false, 0, fmt.Errorf("path match type %q is not supported", pathType)
+	}
+}
+
+// namespacedName returns the namespace/name key of the object.
+func namespacedName(obj metav1.Object) string {
+	return types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}.String()
+}
@@ -0,0 +1,430 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apiv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	informerv1 "k8s.io/client-go/informers/core/v1"
+	discoveryinformer "k8s.io/client-go/informers/discovery/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/ingress-gce/pkg/annotations"
+	gatewayv1beta1 "k8s.io/ingress-gce/pkg/apis/gateway/v1beta1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+	backendconfigclient "k8s.io/ingress-gce/pkg/backendconfig/client/clientset/versioned/fake"
+	informerbackendconfig "k8s.io/ingress-gce/pkg/backendconfig/client/informers/externalversions/backendconfig/v1"
+	"k8s.io/ingress-gce/pkg/controller/translator"
+	"k8s.io/ingress-gce/pkg/healthchecks"
+	routeconfigclient "k8s.io/ingress-gce/pkg/routeconfig/client/clientset/versioned/fake"
+	informerrouteconfig "k8s.io/ingress-gce/pkg/routeconfig/client/informers/externalversions/routeconfig/v1beta1"
+	"k8s.io/ingress-gce/pkg/test"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/ingress-gce/pkg/utils/endpointslices"
+	namer_util "k8s.io/ingress-gce/pkg/utils/namer"
+)
+
+var defaultNamer = namer_util.NewNamer("uid1", "")
+
+func fakeTranslator() *translator.Translator {
+	client := fake.NewSimpleClientset()
+	backendConfigClient := backendconfigclient.NewSimpleClientset()
+	routeConfigClient := routeconfigclient.NewSimpleClientset()
+	namespace := apiv1.NamespaceAll
+	resyncPeriod := 1 * time.Second
+
+	return translator.NewTranslator(
+		informerv1.NewServiceInformer(client, namespace, resyncPeriod, utils.NewNamespaceIndexer()),
+		informerbackendconfig.NewBackendConfigInformer(backendConfigClient, namespace, resyncPeriod, utils.NewNamespaceIndexer()),
+		informerv1.NewNodeInformer(client, resyncPeriod, utils.NewNamespaceIndexer()),
+		informerv1.NewPodInformer(client, namespace, resyncPeriod, utils.NewNamespaceIndexer()),
+		discoveryinformer.NewEndpointSliceInformer(client, namespace, 0,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, endpointslices.EndpointSlicesByServiceIndex: endpointslices.EndpointSlicesByServiceFunc}),
+		informerrouteconfig.NewRouteConfigInformer(routeConfigClient, namespace, resyncPeriod, utils.NewNamespaceIndexer()),
+		client,
+		healthchecks.NewFakeRecorderGetter(0),
+		false,
+		false,
+	)
+}
+
+func strPtr(s string) *string { return &s }
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func newGateway(listeners ...gatewayv1beta1.Listener) *gatewayv1beta1.Gateway {
+	return &gatewayv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw", UID: "gw-uid"},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: "gce",
+			Listeners:        listeners,
+		},
+	}
+}
+
+func httpListener(name string, hostname *string) gatewayv1beta1.Listener {
+	return gatewayv1beta1.Listener{Name: name, Hostname: hostname, Port: 80, Protocol: gatewayv1beta1.HTTPProtocolType}
+}
+
+func TestToIngress(t *testing.T) {
+	named := gatewayv1beta1.NamedAddressType
+	ip := gatewayv1beta1.IPAddressType
+	https := gatewayv1beta1.Listener{
+		Name:     "https",
+		Port:     443,
+		Protocol: gatewayv1beta1.HTTPSProtocolType,
+		TLS: &gatewayv1beta1.GatewayTLSConfig{
+			CertificateRefs: []gatewayv1beta1.SecretObjectReference{{Name: "cert"}},
+			Options:         map[string]string{preSharedCertsOption: "managed-cert"},
+		},
+	}
+
+	for _, tc := range []struct {
+		desc            string
+		gw              *gatewayv1beta1.Gateway
+		wantAnnotations map[string]string
+		wantTLS         []v1.IngressTLS
+		wantReason      gatewayv1beta1.GatewayConditionReason
+	}{
+		{
+			desc:            "http listener",
+			gw:              newGateway(httpListener("http", nil)),
+			wantAnnotations: map[string]string{},
+		},
+		{
+			desc: "https listener only",
+			gw:   newGateway(https),
+			wantAnnotations: map[string]string{
+				annotations.AllowHTTPKey:     "false",
+				annotations.PreSharedCertKey: "managed-cert",
+			},
+			wantTLS: []v1.IngressTLS{{SecretName: "cert"}},
+		},
+		{
+			desc: "named address",
+			gw: func() *gatewayv1beta1.Gateway {
+				gw := newGateway(httpListener("http", nil))
+				gw.Spec.Addresses = []gatewayv1beta1.GatewayAddress{{Type: &named, Value: "my-ip"}}
+				return gw
+			}(),
+			wantAnnotations: map[string]string{annotations.GlobalStaticIPNameKey: "my-ip"},
+		},
+		{
+			desc: "ip address",
+			gw: func() *gatewayv1beta1.Gateway {
+				gw := newGateway(httpListener("http", nil))
+				gw.Spec.Addresses = []gatewayv1beta1.GatewayAddress{{Type: &ip, Value: "1.2.3.4"}}
+				return gw
+			}(),
+			wantReason: gatewayv1beta1.GatewayReasonUnsupportedAddress,
+		},
+		{
+			desc:       "unsupported port",
+			gw:         newGateway(gatewayv1beta1.Listener{Name: "http", Port: 8080, Protocol: gatewayv1beta1.HTTPProtocolType}),
+			wantReason: gatewayv1beta1.GatewayReasonListenersNotValid,
+		},
+		{
+			desc: "https listener without certificate",
+			gw: newGateway(gatewayv1beta1.Listener{
+				Name:     "https",
+				Port:     443,
+				Protocol: gatewayv1beta1.HTTPSProtocolType,
+				TLS:      &gatewayv1beta1.GatewayTLSConfig{},
+			}),
+			wantReason: gatewayv1beta1.GatewayReasonListenersNotValid,
+		},
+		{
+			desc: "certificate in another namespace",
+			gw: newGateway(gatewayv1beta1.Listener{
+				Name:     "https",
+				Port:     443,
+				Protocol: gatewayv1beta1.HTTPSProtocolType,
+				TLS: &gatewayv1beta1.GatewayTLSConfig{
+					CertificateRefs: []gatewayv1beta1.SecretObjectReference{{Name: "cert", Namespace: strPtr("other")}},
+				},
+			}),
+			wantReason: gatewayv1beta1.GatewayReasonListenersNotValid,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ing, err := toIngress(tc.gw)
+			if tc.wantReason != "" {
+				gwErr, ok := err.(*gatewayError)
+				if !ok {
+					t.Fatalf("toIngress() = %v, want gatewayError", err)
+				}
+				if gwErr.reason != tc.wantReason {
+					t.Errorf("toIngress() reason = %q, want %q", gwErr.reason, tc.wantReason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("toIngress() = %v", err)
+			}
+			if ing.Name != tc.gw.Name || ing.Namespace != tc.gw.Namespace || ing.UID != tc.gw.UID {
+				t.Errorf("toIngress() = %s/%s (%s), want the metadata of the Gateway", ing.Namespace, ing.Name, ing.UID)
+			}
+			if scheme := namer_util.FrontendNamingScheme(ing); scheme != namer_util.GatewayNamingScheme {
+				t.Errorf("FrontendNamingScheme() = %q, want %q", scheme, namer_util.GatewayNamingScheme)
+			}
+			if diff := cmp.Diff(tc.wantAnnotations, ing.Annotations); diff != "" {
+				t.Errorf("toIngress() annotations mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantTLS, ing.Spec.TLS); diff != "" {
+				t.Errorf("toIngress() TLS mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntersectHostnames(t *testing.T) {
+	for _, tc := range []struct {
+		listener string
+		route    string
+		want     string
+		wantOK   bool
+	}{
+		{"", "foo.com", "foo.com", true},
+		{"foo.com", "foo.com", "foo.com", true},
+		{"foo.com", "bar.com", "", false},
+		{"*.foo.com", "a.foo.com", "a.foo.com", true},
+		{"*.foo.com", "a.b.foo.com", "a.b.foo.com", true},
+		{"*.foo.com", "foo.com", "", false},
+		{"a.foo.com", "*.foo.com", "a.foo.com", true},
+		{"*.foo.com", "*.foo.com", "*.foo.com", true},
+		{"*.foo.com", "a.bar.com", "", false},
+	} {
+		got, ok := intersectHostnames(tc.listener, tc.route)
+		if got != tc.want || ok != tc.wantOK {
+			t.Errorf("intersectHostnames(%q, %q) = (%q, %t), want (%q, %t)", tc.listener, tc.route, got, ok, tc.want, tc.wantOK)
+		}
+	}
+}
+
+func TestToRouteMatches(t *testing.T) {
+	exact := gatewayv1beta1.PathMatchExact
+	prefix := gatewayv1beta1.PathMatchPathPrefix
+	regex := gatewayv1beta1.PathMatchRegularExpression
+	headerRegex := gatewayv1beta1.HeaderMatchRegularExpression
+
+	for _, tc := range []struct {
+		desc           string
+		match          gatewayv1beta1.HTTPRouteMatch
+		want           []routeconfigv1beta1.RouteMatch
+		wantExact      bool
+		wantPathLength int
+		wantErr        bool
+	}{
+		{
+			desc:           "no path",
+			match:          gatewayv1beta1.HTTPRouteMatch{},
+			want:           []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+			wantPathLength: 1,
+		},
+		{
+			desc:           "exact path",
+			match:          gatewayv1beta1.HTTPRouteMatch{Path: &gatewayv1beta1.HTTPPathMatch{Type: &exact, Value: strPtr("/foo")}},
+			want:           []routeconfigv1beta1.RouteMatch{{FullPathMatch: "/foo"}},
+			wantExact:      true,
+			wantPathLength: 4,
+		},
+		{
+			desc:           "path prefix matches path elements",
+			match:          gatewayv1beta1.HTTPRouteMatch{Path: &gatewayv1beta1.HTTPPathMatch{Type: &prefix, Value: strPtr("/foo/")}},
+			want:           []routeconfigv1beta1.RouteMatch{{FullPathMatch: "/foo"}, {PrefixMatch: "/foo/"}},
+			wantPathLength: 4,
+		},
+		{
+			desc: "headers and query parameters",
+			match: gatewayv1beta1.HTTPRouteMatch{
+				Path:        &gatewayv1beta1.HTTPPathMatch{Type: &prefix, Value: strPtr("/foo")},
+				Headers:     []gatewayv1beta1.HTTPHeaderMatch{{Name: "version", Value: "v2"}},
+				QueryParams: []gatewayv1beta1.HTTPQueryParamMatch{{Type: &headerRegex, Name: "id", Value: "[0-9]+"}},
+			},
+			want: []routeconfigv1beta1.RouteMatch{
+				{
+					FullPathMatch:   "/foo",
+					Headers:         []routeconfigv1beta1.HeaderMatch{{Name: "version", ExactMatch: "v2"}},
+					QueryParameters: []routeconfigv1beta1.QueryParameterMatch{{Name: "id", RegexMatch: "[0-9]+"}},
+				},
+				{
+					PrefixMatch:     "/foo/",
+					Headers:         []routeconfigv1beta1.HeaderMatch{{Name: "version", ExactMatch: "v2"}},
+					QueryParameters: []routeconfigv1beta1.QueryParameterMatch{{Name: "id", RegexMatch: "[0-9]+"}},
+				},
+			},
+			wantPathLength: 4,
+		},
+		{
+			desc:    "regular expression path",
+			match:   gatewayv1beta1.HTTPRouteMatch{Path: &gatewayv1beta1.HTTPPathMatch{Type: &regex, Value: strPtr("/foo.*")}},
+			wantErr: true,
+		},
+		{
+			desc:    "relative path",
+			match:   gatewayv1beta1.HTTPRouteMatch{Path: &gatewayv1beta1.HTTPPathMatch{Type: &exact, Value: strPtr("foo")}},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, gotExact, gotPathLength, err := toRouteMatches(tc.match)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("toRouteMatches() = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("toRouteMatches() mismatch (-want +got):\n%s", diff)
+			}
+			if gotExact != tc.wantExact || gotPathLength != tc.wantPathLength {
+				t.Errorf("toRouteMatches() = (exact %t, length %d), want (exact %t, length %d)", gotExact, gotPathLength, tc.wantExact, tc.wantPathLength)
+			}
+		})
+	}
+}
+
+func TestTranslateRoutes(t *testing.T) {
+	tr := fakeTranslator()
+	svcLister := tr.ServiceInformer.GetIndexer()
+	defaultSvc := test.NewService(types.NamespacedName{Name: "default-http-backend", Namespace: "kube-system"}, apiv1.ServiceSpec{
+		Type:  apiv1.ServiceTypeNodePort,
+		Ports: []apiv1.ServicePort{{Name: "http", Port: 80, NodePort: 30000}},
+	})
+	svcLister.Add(defaultSvc)
+	for i, name := range []string{"foo", "bar"} {
+		svcLister.Add(test.NewService(types.NamespacedName{Name: name, Namespace: "ns"}, apiv1.ServiceSpec{
+			Type:  apiv1.ServiceTypeNodePort,
+			Ports: []apiv1.ServicePort{{Port: 80, NodePort: int32(30001 + i)}},
+		}))
+	}
+	defaultBackend := utils.ServicePortID{Service: types.NamespacedName{Name: "default-http-backend", Namespace: "kube-system"}, Port: v1.ServiceBackendPort{Name: "http"}}
+
+	exact := gatewayv1beta1.PathMatchExact
+	gw := newGateway(httpListener("http", strPtr("*.example.com")), httpListener("other", strPtr("other.com")))
+	older := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	newer := metav1.NewTime(older.Add(time.Hour))
+
+	routes := []*gatewayv1beta1.HTTPRoute{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "prefix", CreationTimestamp: newer},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				ParentRefs: []gatewayv1beta1.ParentReference{{Name: "gw", SectionName: strPtr("http")}},
+				Hostnames:  []string{"a.example.com"},
+				Rules: []gatewayv1beta1.HTTPRouteRule{{
+					BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+						{Name: "foo", Port: int32Ptr(80), Weight: int32Ptr(3)},
+						{Name: "bar", Port: int32Ptr(80)},
+					},
+				}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "exact", CreationTimestamp: older},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				ParentRefs: []gatewayv1beta1.ParentReference{{Name: "gw"}},
+				Hostnames:  []string{"a.example.com"},
+				Rules: []gatewayv1beta1.HTTPRouteRule{{
+					Matches: []gatewayv1beta1.HTTPRouteMatch{{Path: &gatewayv1beta1.HTTPPathMatch{Type: &exact, Value: strPtr("/foo")}}},
+					BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+						{Name: "foo", Port: int32Ptr(80)},
+						{Name: "missing", Port: int32Ptr(80)},
+					},
+				}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "no-match"},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				ParentRefs: []gatewayv1beta1.ParentReference{{Name: "gw"}},
+				Hostnames:  []string{"b.com"},
+				Rules:      []gatewayv1beta1.HTTPRouteRule{{BackendRefs: []gatewayv1beta1.HTTPBackendRef{{Name: "foo", Port: int32Ptr(80)}}}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "other-gateway"},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				ParentRefs: []gatewayv1beta1.ParentReference{{Name: "other"}},
+				Rules:      []gatewayv1beta1.HTTPRouteRule{{BackendRefs: []gatewayv1beta1.HTTPBackendRef{{Name: "foo", Port: int32Ptr(80)}}}},
+			},
+		},
+	}
+
+	urlMap, results, err := translateRoutes(tr, gw, routes, defaultBackend, defaultNamer)
+	if err != nil {
+		t.Fatalf("translateRoutes() = %v", err)
+	}
+
+	if _, ok := results[types.NamespacedName{Namespace: "ns", Name: "other-gateway"}]; ok {
+		t.Errorf("translateRoutes() returned a result for a route of another Gateway")
+	}
+	for name, want := range map[string]struct {
+		accepted     metav1.ConditionStatus
+		resolvedRefs metav1.ConditionStatus
+		reason       string
+	}{
+		"prefix":   {metav1.ConditionTrue, metav1.ConditionTrue, string(gatewayv1beta1.RouteReasonResolvedRefs)},
+		"exact":    {metav1.ConditionTrue, metav1.ConditionFalse, string(gatewayv1beta1.RouteReasonBackendNotFound)},
+		"no-match": {metav1.ConditionFalse, metav1.ConditionTrue, string(gatewayv1beta1.RouteReasonResolvedRefs)},
+	} {
+		result, ok := results[types.NamespacedName{Namespace: "ns", Name: name}]
+		if !ok {
+			t.Errorf("translateRoutes() returned no result for route %q", name)
+			continue
+		}
+		conditions := result.conditions(1)
+		if conditions[0].Status != want.accepted || conditions[1].Status != want.resolvedRefs || conditions[1].Reason != want.reason {
+			t.Errorf("conditions of route %q = %+v, want Accepted %s, ResolvedRefs %s (%s)", name, conditions, want.accepted, want.resolvedRefs, want.reason)
+		}
+	}
+
+	if urlMap.DefaultBackend == nil || urlMap.DefaultBackend.NodePort != 30000 {
+		t.Errorf("urlMap.DefaultBackend = %+v, want the system default backend", urlMap.DefaultBackend)
+	}
+	rules := urlMap.HostRules
+	if len(rules) != 1 || rules[0].Hostname != "a.example.com" {
+		t.Fatalf("urlMap.HostRules = %+v, want a single rule for a.example.com", rules)
+	}
+	routeRules := rules[0].RouteRules
+	if len(routeRules) != 2 {
+		t.Fatalf("route rules = %+v, want 2 rules", routeRules)
+	}
+	// The exact match of the older route takes precedence over the prefix match.
+	if got := routeRules[0].Matches[0].FullPathMatch; got != "/foo" {
+		t.Errorf("first rule matches %q, want the exact match /foo", got)
+	}
+	if routeRules[0].Backend.NodePort != 30001 || len(routeRules[0].WeightedBackends) != 0 {
+		t.Errorf("first rule backend = %+v, want the single resolved backend foo", routeRules[0])
+	}
+	if got := routeRules[1].Matches[0].PrefixMatch; got != "/" {
+		t.Errorf("second rule matches %q, want the prefix match /", got)
+	}
+	wantWeights := map[int64]int64{30001: 3, 30002: 1}
+	gotWeights := map[int64]int64{}
+	for _, wb := range routeRules[1].WeightedBackends {
+		gotWeights[wb.Backend.NodePort] = wb.Weight
+	}
+	if diff := cmp.Diff(wantWeights, gotWeights); diff != "" {
+		t.Errorf("weighted backends mismatch (-want +got):\n%s", diff)
+	}
+}
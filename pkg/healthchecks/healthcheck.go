@@ -81,11 +81,23 @@ func calculateDiff(old, new *translator.HealthCheck, c *backendconfigv1.HealthCh
 	if (fullDiffOnRecalculation || c.Port != nil) && old.Port != new.Port {
 		changes.add("Port", strconv.FormatInt(old.Port, 10), strconv.FormatInt(new.Port, 10))
 	}
+	if (fullDiffOnRecalculation || c.Host != nil) && old.Host != new.Host {
+		changes.add("Host", old.Host, new.Host)
+	}
+	if (fullDiffOnRecalculation || c.Response != nil) && old.Response != new.Response {
+		changes.add("Response", old.Response, new.Response)
+	}
+	if (fullDiffOnRecalculation || c.ProxyHeader != nil) && old.ProxyHeader != new.ProxyHeader {
+		changes.add("ProxyHeader", old.ProxyHeader, new.ProxyHeader)
+	}
+	if (fullDiffOnRecalculation || c.GrpcServiceName != nil) && old.GrpcServiceName != new.GrpcServiceName {
+		changes.add("GrpcServiceName", old.GrpcServiceName, new.GrpcServiceName)
+	}
 	if old.Description != new.Description {
 		changes.add("Description", old.Description, new.Description)
 	}
 
-	// TODO(bowei): Host seems to be missing.
+	// TODO(bowei): Host from the readiness probe is not diffed.
 
 	return &changes
 }
@@ -106,6 +118,7 @@ func mergeUserSettings(existing, newHC *translator.HealthCheck) *translator.Heal
 	hc := *newHC // return a copy
 
 	hc.HTTPHealthCheck = existing.HTTPHealthCheck
+	hc.GrpcServiceName = existing.GrpcServiceName
 	hc.HealthCheck.CheckIntervalSec = existing.HealthCheck.CheckIntervalSec
 	hc.HealthCheck.HealthyThreshold = existing.HealthCheck.HealthyThreshold
 	hc.HealthCheck.TimeoutSec = existing.HealthCheck.TimeoutSec
@@ -616,5 +616,18 @@ func formatBackendConfigHC(b *backendconfigv1.HealthCheckConfig) string {
 	if b.RequestPath != nil {
 		ret = append(ret, fmt.Sprintf("requestPath=%q", *b.RequestPath))
 	}
+	for _, e := range []struct {
+		v *string
+		k string
+	}{
+		{k: "host", v: b.Host},
+		{k: "response", v: b.Response},
+		{k: "proxyHeader", v: b.ProxyHeader},
+		{k: "grpcServiceName", v: b.GrpcServiceName},
+	} {
+		if e.v != nil {
+			ret = append(ret, fmt.Sprintf("%s=%q", e.k, *e.v))
+		}
+	}
 	return strings.Join(ret, ", ")
 }
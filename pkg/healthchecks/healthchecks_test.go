@@ -72,6 +72,7 @@ func init() {
 		annotations.ProtocolHTTP,
 		annotations.ProtocolHTTPS,
 		annotations.ProtocolHTTP2,
+		annotations.ProtocolGRPC,
 	} {
 		path := "/foo"
 		num := int64(1234)
@@ -1077,6 +1078,56 @@ func TestCalculateDiff(t *testing.T) {
 		hasDiff: true,
 	})
 
+	newHC = translator.DefaultHealthCheck(8080, annotations.ProtocolHTTP)
+	newHC.Host = "foo.com"
+	cases = append(cases, tc{
+		desc:    "Host",
+		old:     translator.DefaultHealthCheck(8080, annotations.ProtocolHTTP),
+		new:     newHC,
+		c:       &backendconfigv1.HealthCheckConfig{Host: s("foo.com")},
+		hasDiff: true,
+	})
+
+	newHC = translator.DefaultHealthCheck(8080, annotations.ProtocolHTTP)
+	newHC.Response = "OK"
+	cases = append(cases, tc{
+		desc:    "Response",
+		old:     translator.DefaultHealthCheck(8080, annotations.ProtocolHTTP),
+		new:     newHC,
+		c:       &backendconfigv1.HealthCheckConfig{Response: s("OK")},
+		hasDiff: true,
+	})
+
+	newHC = translator.DefaultHealthCheck(8080, annotations.ProtocolHTTP)
+	newHC.ProxyHeader = "PROXY_V1"
+	cases = append(cases, tc{
+		desc:    "ProxyHeader",
+		old:     translator.DefaultHealthCheck(8080, annotations.ProtocolHTTP),
+		new:     newHC,
+		c:       &backendconfigv1.HealthCheckConfig{ProxyHeader: s("PROXY_V1")},
+		hasDiff: true,
+	})
+
+	newHC = translator.DefaultHealthCheck(8080, annotations.ProtocolGRPC)
+	newHC.GrpcServiceName = "foo.Service"
+	cases = append(cases, tc{
+		desc:     "GrpcServiceName",
+		old:      translator.DefaultHealthCheck(8080, annotations.ProtocolGRPC),
+		new:      newHC,
+		c:        &backendconfigv1.HealthCheckConfig{GrpcServiceName: s("foo.Service")},
+		hasDiff:  true,
+		diffSize: i64(1),
+	})
+
+	newHC = translator.DefaultHealthCheck(8080, annotations.ProtocolHTTP)
+	newHC.Host = "foo.com"
+	cases = append(cases, tc{
+		desc: "Host without backendconfig host is not a diff",
+		old:  translator.DefaultHealthCheck(8080, annotations.ProtocolHTTP),
+		new:  newHC,
+		c:    &backendconfigv1.HealthCheckConfig{},
+	})
+
 	newHC = translator.DefaultHealthCheck(500, annotations.ProtocolHTTP)
 	newHC.Port = 500
 	cases = append(cases, tc{
@@ -1203,6 +1254,8 @@ func (f *syncSPFixture) hcs() *compute.HealthCheck  { return f.toS(f.hc()) }
 func (f *syncSPFixture) hc2() *compute.HealthCheck  { return f.to2(f.hc()) }
 func (f *syncSPFixture) negs() *compute.HealthCheck { return f.toS(f.neg()) }
 func (f *syncSPFixture) neg2() *compute.HealthCheck { return f.to2(f.neg()) }
+func (f *syncSPFixture) hcg() *compute.HealthCheck  { return f.toG(f.hc()) }
+func (f *syncSPFixture) negg() *compute.HealthCheck { return f.toG(f.neg()) }
 func (f *syncSPFixture) ilbs() *compute.HealthCheck { return f.toS(f.ilb()) }
 func (f *syncSPFixture) ilb2() *compute.HealthCheck { return f.to2(f.ilb()) }
 func (f *syncSPFixture) thcs() *compute.HealthCheck { panic("no such thing exists") }
@@ -1229,6 +1282,16 @@ func (f *syncSPFixture) to2(h *compute.HealthCheck) *compute.HealthCheck {
 	return h
 }
 
+func (f *syncSPFixture) toG(h *compute.HealthCheck) *compute.HealthCheck {
+	h.Type = "GRPC"
+	h.GrpcHealthCheck = &compute.GRPCHealthCheck{
+		Port:              h.HttpHealthCheck.Port,
+		PortSpecification: h.HttpHealthCheck.PortSpecification,
+	}
+	h.HttpHealthCheck = nil
+	return h
+}
+
 func (*syncSPFixture) neg() *compute.HealthCheck {
 	return &compute.HealthCheck{
 		Name:               "k8s1-uid1---0-56ff9a48",
@@ -1330,6 +1393,8 @@ func TestSyncServicePort(t *testing.T) {
 	cases = append(cases, &tc{desc: "create http", sp: testSPs["HTTP-80-reg-nil-nothc"], wantComputeHC: fixture.hc()})
 	cases = append(cases, &tc{desc: "create https", sp: testSPs["HTTPS-80-reg-nil-nothc"], wantComputeHC: fixture.hcs()})
 	cases = append(cases, &tc{desc: "create http2", sp: testSPs["HTTP2-80-reg-nil-nothc"], wantComputeHC: fixture.hc2()})
+	cases = append(cases, &tc{desc: "create grpc", sp: testSPs["GRPC-80-reg-nil-nothc"], wantComputeHC: fixture.hcg()})
+	cases = append(cases, &tc{desc: "create neg grpc", sp: testSPs["GRPC-80-neg-nil-nothc"], wantComputeHC: fixture.negg()})
 	cases = append(cases, &tc{desc: "create neg", sp: testSPs["HTTP-80-neg-nil-nothc"], wantComputeHC: fixture.neg()})
 	cases = append(cases, &tc{desc: "create ilb", sp: testSPs["HTTP-80-ilb-nil-nothc"], regional: true, wantComputeHC: fixture.ilb()})
 
@@ -1462,6 +1527,7 @@ func TestSyncServicePort(t *testing.T) {
 	cases = append(cases, &tc{desc: "create backendconfig all", sp: testSPs["HTTP-80-reg-bcall-nothc"], wantComputeHC: chc})
 
 	i64 := func(i int64) *int64 { return &i }
+	s := func(s string) *string { return &s }
 
 	// BackendConfig port
 	chc = fixture.hc()
@@ -1477,6 +1543,41 @@ func TestSyncServicePort(t *testing.T) {
 	}
 	cases = append(cases, &tc{desc: "create backendconfig port", sp: &sp, wantComputeHC: chc})
 
+	// BackendConfig host, response and proxy header
+	chc = fixture.hc()
+	chc.HttpHealthCheck.Host = "foo.com"
+	chc.HttpHealthCheck.Response = "OK"
+	chc.HttpHealthCheck.ProxyHeader = "PROXY_V1"
+	chc.Description = translator.DescriptionForHealthChecksFromBackendConfig
+	headerSP := utils.ServicePort{
+		NodePort:     80,
+		Protocol:     annotations.ProtocolHTTP,
+		BackendNamer: testNamer,
+		BackendConfig: &backendconfigv1.BackendConfig{Spec: backendconfigv1.BackendConfigSpec{HealthCheck: &backendconfigv1.HealthCheckConfig{
+			Host:        s("foo.com"),
+			Response:    s("OK"),
+			ProxyHeader: s("PROXY_V1"),
+		}}},
+	}
+	cases = append(cases, &tc{desc: "create backendconfig host response proxy header", sp: &headerSP, wantComputeHC: chc})
+
+	// BackendConfig grpc
+	chc = fixture.hc()
+	chc.Type = "GRPC"
+	chc.HttpHealthCheck = nil
+	chc.GrpcHealthCheck = &compute.GRPCHealthCheck{Port: 80, GrpcServiceName: "foo.Service"}
+	chc.Description = translator.DescriptionForHealthChecksFromBackendConfig
+	grpcSP := utils.ServicePort{
+		NodePort:     80,
+		Protocol:     annotations.ProtocolHTTP2,
+		BackendNamer: testNamer,
+		BackendConfig: &backendconfigv1.BackendConfig{Spec: backendconfigv1.BackendConfigSpec{HealthCheck: &backendconfigv1.HealthCheckConfig{
+			Type:            s("GRPC"),
+			GrpcServiceName: s("foo.Service"),
+		}}},
+	}
+	cases = append(cases, &tc{desc: "create backendconfig grpc", sp: &grpcSP, wantComputeHC: chc})
+
 	// BackendConfig neg
 	chc = fixture.neg()
 	chc.HttpHealthCheck.RequestPath = "/foo"
@@ -1717,6 +1818,50 @@ func TestSyncServicePort(t *testing.T) {
 		wantComputeHC: wantCHC,
 	})
 
+	// Update the host and the gRPC service name from backendconfig.
+	chc = fixture.hc()
+	chc.HttpHealthCheck.Host = "user.com"
+	wantCHC = fixture.hc()
+	wantCHC.HttpHealthCheck.Host = "foo.com"
+	wantCHC.Description = translator.DescriptionForHealthChecksFromBackendConfig
+	updateHostSP := utils.ServicePort{
+		NodePort:      80,
+		Protocol:      annotations.ProtocolHTTP,
+		BackendNamer:  testNamer,
+		BackendConfig: &backendconfigv1.BackendConfig{Spec: backendconfigv1.BackendConfigSpec{HealthCheck: &backendconfigv1.HealthCheckConfig{Host: s("foo.com")}}},
+	}
+	cases = append(cases, &tc{
+		desc:          "update backendconfig host",
+		setup:         fixture.setupExistingHCFunc(chc),
+		sp:            &updateHostSP,
+		wantComputeHC: wantCHC,
+	})
+
+	chc = fixture.hc()
+	chc.Type = "GRPC"
+	chc.HttpHealthCheck = nil
+	chc.GrpcHealthCheck = &compute.GRPCHealthCheck{Port: 80, GrpcServiceName: "foo.Service"}
+	wantCHC = fixture.hc()
+	wantCHC.Type = "GRPC"
+	wantCHC.HttpHealthCheck = nil
+	wantCHC.GrpcHealthCheck = &compute.GRPCHealthCheck{Port: 80, GrpcServiceName: "bar.Service"}
+	wantCHC.Description = translator.DescriptionForHealthChecksFromBackendConfig
+	updateGrpcSP := utils.ServicePort{
+		NodePort:     80,
+		Protocol:     annotations.ProtocolHTTP2,
+		BackendNamer: testNamer,
+		BackendConfig: &backendconfigv1.BackendConfig{Spec: backendconfigv1.BackendConfigSpec{HealthCheck: &backendconfigv1.HealthCheckConfig{
+			Type:            s("GRPC"),
+			GrpcServiceName: s("bar.Service"),
+		}}},
+	}
+	cases = append(cases, &tc{
+		desc:          "update backendconfig grpc service name",
+		setup:         fixture.setupExistingHCFunc(chc),
+		sp:            &updateGrpcSP,
+		wantComputeHC: wantCHC,
+	})
+
 	// Override all settings from thc.
 	chc = fixture.neg()
 	chc.HttpHealthCheck.RequestPath = "/user-path"
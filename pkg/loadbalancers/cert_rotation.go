@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancers
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/ingress-gce/pkg/annotations"
+	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/loadbalancers/metrics"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// certRotationOverlapping is the phase of a rotation in which the new
+	// certs are attached to the target proxy together with the old certs.
+	certRotationOverlapping = "Overlapping"
+	// certRotationDetaching is the phase of a rotation in which the old
+	// certs are detached from the target proxy and deleted.
+	certRotationDetaching = "Detaching"
+)
+
+// certRotation is the progress of a rotation of the certs of a load balancer,
+// recorded in the annotations.SSLCertRotationKey annotation of the Ingress.
+//
+// A rotation replaces certs created from secrets without a moment in which
+// the target proxy serves neither the old nor the new certs. The new certs are
+// attached together with the old certs first. Once that target proxy update
+// completed, a later sync detaches and deletes the old certs.
+type certRotation struct {
+	// Phase is the phase of the rotation.
+	Phase string `json:"phase"`
+	// OldCerts are the names of the certs which are replaced.
+	OldCerts []string `json:"oldCerts"`
+	// NewCerts are the names of the certs which replace them.
+	NewCerts []string `json:"newCerts"`
+	// StartTime is the time the rotation started, in RFC 3339 format.
+	StartTime string `json:"startTime"`
+}
+
+// recordedCertRotation returns the cert rotation recorded in the annotations
+// of the Ingress, or nil if there is none.
+func (l7 *L7) recordedCertRotation() *certRotation {
+	if l7.runtimeInfo.Ingress == nil {
+		return nil
+	}
+	val, ok := l7.runtimeInfo.Ingress.Annotations[annotations.SSLCertRotationKey]
+	if !ok {
+		return nil
+	}
+	rotation := &certRotation{}
+	if err := json.Unmarshal([]byte(val), rotation); err != nil {
+		klog.Warningf("Ignoring invalid annotation %s=%q of %s: %v", annotations.SSLCertRotationKey, val, l7, err)
+		return nil
+	}
+	return rotation
+}
+
+// rotateSslCerts advances the rotation of the certs of the load balancer. It
+// adds the certs which are replaced to l7.sslCerts while the new certs are
+// not attached to the target proxy yet.
+func (l7 *L7) rotateSslCerts() error {
+	l7.certRotation = nil
+	attachedLinks, err := l7.getSslCertLinkInUse()
+	if err != nil {
+		// There is nothing to rotate without a target proxy.
+		return utils.IgnoreHTTPNotFound(err)
+	}
+	desired := sets.NewString(toCertNames(l7.sslCerts)...)
+	attached := sets.NewString()
+	var oldCerts []*composite.SslCertificate
+	for _, link := range attachedLinks {
+		name, err := utils.KeyName(link)
+		if err != nil {
+			klog.Warningf("error parsing cert name: %v", err)
+			continue
+		}
+		attached.Insert(name)
+		if !desired.Has(name) && (l7.namer.IsCertNameForLB(name) || l7.namer.IsLegacySSLCert(name)) {
+			oldCerts = append(oldCerts, &composite.SslCertificate{Name: name, SelfLink: link})
+		}
+	}
+	// Old certs are deleted once they are detached. Legacy certs are not
+	// listed once certs following the new naming scheme exist.
+	oldSSLCerts := getMapFromCertList(l7.oldSSLCerts)
+	for _, cert := range oldCerts {
+		if _, ok := oldSSLCerts[cert.Name]; !ok {
+			l7.oldSSLCerts = append(l7.oldSSLCerts, cert)
+		}
+	}
+
+	rotation := l7.recordedCertRotation()
+	if rotation != nil && rotation.Phase == certRotationOverlapping &&
+		desired.HasAll(rotation.NewCerts...) && attached.HasAll(rotation.NewCerts...) {
+		klog.V(2).Infof("Detaching ssl certs %v of %s, replaced by %v", rotation.OldCerts, l7, rotation.NewCerts)
+		rotation.Phase = certRotationDetaching
+		l7.certRotation = rotation
+		return nil
+	}
+
+	var newCerts []string
+	for _, cert := range l7.sslCerts {
+		if !attached.Has(cert.Name) && (l7.namer.IsCertNameForLB(cert.Name) || l7.namer.IsLegacySSLCert(cert.Name)) {
+			newCerts = append(newCerts, cert.Name)
+		}
+	}
+	if len(oldCerts) == 0 || len(newCerts) == 0 {
+		return nil
+	}
+	if len(l7.sslCerts)+len(oldCerts) > TargetProxyCertLimit {
+		klog.Warningf("Replacing ssl certs %v of %s without overlap, the target proxy cannot hold both old and new certs", toCertNames(oldCerts), l7)
+		return nil
+	}
+
+	startTime := time.Now().UTC().Format(time.RFC3339)
+	if rotation != nil && rotation.Phase == certRotationOverlapping {
+		// The rotation changed before the new certs were attached.
+		startTime = rotation.StartTime
+	}
+	klog.V(2).Infof("Attaching ssl certs %v of %s together with %v", newCerts, l7, toCertNames(oldCerts))
+	l7.certRotation = &certRotation{
+		Phase:     certRotationOverlapping,
+		OldCerts:  toCertNames(oldCerts),
+		NewCerts:  newCerts,
+		StartTime: startTime,
+	}
+	l7.sslCerts = append(l7.sslCerts, oldCerts...)
+	return nil
+}
+
+// recordCertRotation reports the progress of the cert rotation once the target
+// proxy was updated.
+func (l7 *L7) recordCertRotation() {
+	if l7.certRotation == nil {
+		return
+	}
+	switch l7.certRotation.Phase {
+	case certRotationOverlapping:
+		if recorded := l7.recordedCertRotation(); recorded == nil || recorded.Phase != certRotationOverlapping {
+			l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SSLCertRotationStarted,
+				"SslCertificates %s attached, replacing %s", strings.Join(l7.certRotation.NewCerts, ","), strings.Join(l7.certRotation.OldCerts, ","))
+		}
+	case certRotationDetaching:
+		if startTime, err := time.Parse(time.RFC3339, l7.certRotation.StartTime); err == nil {
+			metrics.PublishSSLCertRotationMetrics(time.Since(startTime))
+		}
+		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SSLCertRotationCompleted,
+			"SslCertificates %s detached, replaced by %s", strings.Join(l7.certRotation.OldCerts, ","), strings.Join(l7.certRotation.NewCerts, ","))
+		l7.certRotation = nil
+	}
+}
@@ -19,8 +19,12 @@ package loadbalancers
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/translator"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/klog/v2"
@@ -28,12 +32,72 @@ import (
 
 const SslCertificateMissing = "SslCertificateMissing"
 
+// usesCertificateMap returns true if the FrontendConfig of the load balancer
+// references a Certificate Manager certificate map.
+func (l7 *L7) usesCertificateMap() bool {
+	fc := l7.runtimeInfo.FrontendConfig
+	return flags.F.EnableFrontendConfig && fc != nil && fc.Spec.CertificateMap != nil && *fc.Spec.CertificateMap != ""
+}
+
+// usesManagedCertificates returns true if the FrontendConfig of the load
+// balancer requests a Google-managed certificate.
+func (l7 *L7) usesManagedCertificates() bool {
+	fc := l7.runtimeInfo.FrontendConfig
+	return flags.F.EnableFrontendConfig && fc != nil && fc.Spec.ManagedCertificates != nil && len(fc.Spec.ManagedCertificates.Domains) > 0
+}
+
+// usesServerTlsPolicy returns true if the FrontendConfig of the load balancer
+// configures a server TLS policy, which may be empty to remove the policy.
+func (l7 *L7) usesServerTlsPolicy() bool {
+	fc := l7.runtimeInfo.FrontendConfig
+	return flags.F.EnableFrontendConfig && fc != nil && fc.Spec.ServerTlsPolicy != nil
+}
+
+// checkCertificateConfig returns an error if the FrontendConfig of the load
+// balancer requests certificates the load balancer does not support.
+func (l7 *L7) checkCertificateConfig() error {
+	if !l7.usesCertificateMap() && !l7.usesManagedCertificates() {
+		return nil
+	}
+	if utils.IsGCEL7ILBIngress(&l7.ingress) || utils.IsGCEL7XLBRegionalIngress(&l7.ingress) {
+		return fmt.Errorf("error: cannot use certificate maps or managed certificates with L7 ILB or regional external load balancers")
+	}
+	if l7.usesCertificateMap() && l7.usesManagedCertificates() {
+		return fmt.Errorf("error: cannot use both a certificate map and managed certificates")
+	}
+	return nil
+}
+
+// checkServerTlsPolicyConfig returns an error if the FrontendConfig of the load
+// balancer configures mTLS for a load balancer which does not support it.
+func (l7 *L7) checkServerTlsPolicyConfig() error {
+	if !l7.usesServerTlsPolicy() || *l7.runtimeInfo.FrontendConfig.Spec.ServerTlsPolicy == "" {
+		return nil
+	}
+	if utils.IsGCEL7ILBIngress(&l7.ingress) || utils.IsGCEL7XLBRegionalIngress(&l7.ingress) {
+		return fmt.Errorf("error: cannot use a server TLS policy with L7 ILB or regional external load balancers")
+	}
+	return nil
+}
+
 func (l7 *L7) checkSSLCert() error {
+	if l7.usesCertificateMap() {
+		// Certificates are served from the certificate map. The certs of the
+		// target proxy are left unchanged, GCE ignores them while a
+		// certificate map is attached, and they are cleaned up once the
+		// certificate map is removed.
+		if l7.runtimeInfo.TLSName != "" || len(l7.runtimeInfo.TLS) > 0 {
+			l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeWarning, events.SyncIngress, "Ignoring pre-shared certificates %q and %d TLS secrets, certificate map %q is used instead", l7.runtimeInfo.TLSName, len(l7.runtimeInfo.TLS), *l7.runtimeInfo.FrontendConfig.Spec.CertificateMap)
+		}
+		l7.oldSSLCerts = nil
+		l7.sslCerts = nil
+		return nil
+	}
+
 	isL7ILB := utils.IsGCEL7ILBIngress(l7.runtimeInfo.Ingress)
 	isL7XLBRegional := utils.IsGCEL7XLBRegionalIngress(l7.runtimeInfo.Ingress)
 	tr := translator.NewTranslator(isL7ILB, isL7XLBRegional, l7.namer)
-	env := &translator.Env{Region: l7.cloud.Region(), Project: l7.cloud.ProjectID()}
-	translatorCerts := tr.ToCompositeSSLCertificates(env, l7.runtimeInfo.TLSName, l7.runtimeInfo.TLS, l7.Versions().SslCertificate)
+	env := &translator.Env{Region: l7.cloud.Region(), Project: l7.cloud.ProjectID(), FrontendConfig: l7.runtimeInfo.FrontendConfig}
 
 	// Use both pre-shared and secret-based certs if available,
 	// combining encountered errors.
@@ -46,6 +110,8 @@ func (l7 *L7) checkSSLCert() error {
 		// Do not continue if getIngressManagedSslCerts() failed.
 		return utils.JoinErrs(errs)
 	}
+	tls := l7.withoutExpiredNewTLSCerts(existingSecretsSslCerts)
+	translatorCerts := tr.ToCompositeSSLCertificates(env, l7.runtimeInfo.TLSName, tls, l7.Versions().SslCertificate)
 
 	l7.oldSSLCerts = existingSecretsSslCerts
 	sslCerts, err := l7.createSslCertificates(existingSecretsSslCerts, translatorCerts)
@@ -53,12 +119,43 @@ func (l7 *L7) checkSSLCert() error {
 		errs = append(errs, err)
 	}
 	l7.sslCerts = sslCerts
+	if retained := retainedSslCerts(existingSecretsSslCerts, sslCerts); len(retained) > 0 {
+		l7.sslCerts = append(l7.sslCerts, retained...)
+	} else if len(errs) == 0 {
+		if err := l7.rotateSslCerts(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	if len(errs) > 0 {
 		return utils.JoinErrs(errs)
 	}
 	return nil
 }
 
+// withoutExpiredNewTLSCerts returns the TLS certificates of the load balancer
+// without the expired certificates which are not uploaded yet. An expired
+// certificate which is already uploaded is kept until its secret is renewed,
+// so that the HTTPS frontend keeps serving it instead of being torn down.
+func (l7 *L7) withoutExpiredNewTLSCerts(existingCerts []*composite.SslCertificate) []*translator.TLSCerts {
+	existingCertsMap := getMapFromCertList(existingCerts)
+	now := time.Now()
+	var result []*translator.TLSCerts
+	for _, cert := range l7.runtimeInfo.TLS {
+		if cert.NotAfter.IsZero() || now.Before(cert.NotAfter) {
+			result = append(result, cert)
+			continue
+		}
+		expiredAt := cert.NotAfter.UTC().Format(time.RFC3339)
+		if _, ok := existingCertsMap[l7.namer.SSLCertName(cert.CertHash)]; ok {
+			l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeWarning, events.SyncIngress, "Certificate of secret %q expired at %v, it is kept on the load balancer until the secret is renewed", cert.Name, expiredAt)
+			result = append(result, cert)
+			continue
+		}
+		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeWarning, events.SyncIngress, "Ignoring TLS certificate: certificate of secret %q expired at %v", cert.Name, expiredAt)
+	}
+	return result
+}
+
 // createSslCertificates creates SslCertificates based on kubernetes secrets in Ingress configuration.
 func (l7 *L7) createSslCertificates(existingCerts, translatorCerts []*composite.SslCertificate) ([]*composite.SslCertificate, error) {
 	var result []*composite.SslCertificate
@@ -71,7 +168,7 @@ func (l7 *L7) createSslCertificates(existingCerts, translatorCerts []*composite.
 
 	for _, translatorCert := range translatorCerts {
 		// Ignore pre-shared certs here
-		if translatorCert.Certificate == "" {
+		if translatorCert.Certificate == "" && translatorCert.Managed == nil {
 			result = append(result, translatorCert)
 			continue
 		}
@@ -109,7 +206,11 @@ func (l7 *L7) createSslCertificates(existingCerts, translatorCerts []*composite.
 			failedCerts = append(failedCerts, translatorCert.Name+" Error:"+err.Error())
 			continue
 		}
-		visitedCertMap[translatorCert.Name] = fmt.Sprintf("secret cert:%q", translatorCert.Certificate)
+		if translatorCert.Managed != nil {
+			visitedCertMap[translatorCert.Name] = fmt.Sprintf("managed cert:%q", strings.Join(translatorCert.Managed.Domains, ","))
+		} else {
+			visitedCertMap[translatorCert.Name] = fmt.Sprintf("secret cert:%q", translatorCert.Certificate)
+		}
 
 		// Get SSLCert
 		cert, err := composite.GetSslCertificate(l7.cloud, key, translatorCert.Version, klog.TODO())
@@ -127,6 +228,32 @@ func (l7 *L7) createSslCertificates(existingCerts, translatorCerts []*composite.
 	return result, nil
 }
 
+// retainedSslCerts returns the existing certs which are kept on the target
+// proxy although they are no longer configured. A Google-managed cert serves
+// no traffic until it is provisioned, so the certs it replaces are retained
+// until then.
+func retainedSslCerts(existingCerts, certs []*composite.SslCertificate) []*composite.SslCertificate {
+	provisioning := false
+	for _, cert := range certs {
+		if cert.Managed != nil && cert.Managed.Status != "ACTIVE" {
+			provisioning = true
+			break
+		}
+	}
+	if !provisioning {
+		return nil
+	}
+	var result []*composite.SslCertificate
+	certsMap := getMapFromCertList(certs)
+	for _, cert := range existingCerts {
+		if _, ok := certsMap[cert.Name]; !ok {
+			klog.V(3).Infof("Retaining ssl cert %s until the managed certs are provisioned", cert.Name)
+			result = append(result, cert)
+		}
+	}
+	return result
+}
+
 func getMapFromCertList(certs []*composite.SslCertificate) map[string]*composite.SslCertificate {
 	if len(certs) == 0 {
 		return nil
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functionality and constants for the custom error response
+// policies that are configured through the RouteConfig and the FrontendConfig
+// of an Ingress and programmed on the UrlMap.
+package features
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	v1 "k8s.io/api/networking/v1"
+	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+const (
+	FeatureCustomErrorResponsePolicy = "CustomErrorResponsePolicy"
+)
+
+var (
+	// Custom error response policies are not part of the GA compute API.
+	customErrorResponsePolicyVersions = ResourceVersions{UrlMap: meta.VersionBeta}
+)
+
+// featuresFromCustomErrorResponsePolicies returns the custom error response
+// policy feature if any level of the GCEURLMap or the FrontendConfig sets a
+// custom error response policy.
+func featuresFromCustomErrorResponsePolicies(g *utils.GCEURLMap, fc *frontendconfigv1beta1.FrontendConfig) []string {
+	if hasCustomErrorResponsePolicy(g, fc) {
+		return []string{FeatureCustomErrorResponsePolicy}
+	}
+	return nil
+}
+
+func hasCustomErrorResponsePolicy(g *utils.GCEURLMap, fc *frontendconfigv1beta1.FrontendConfig) bool {
+	if fc != nil && fc.Spec.DefaultCustomErrorResponsePolicy != nil {
+		return true
+	}
+	if g == nil {
+		return false
+	}
+	for _, hostRule := range g.HostRules {
+		if hostRule.CustomErrorResponsePolicy != nil {
+			return true
+		}
+		for _, pathRule := range hostRule.Paths {
+			if pathRule.CustomErrorResponsePolicy != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateCustomErrorResponsePolicies returns an error if the GCEURLMap or the
+// FrontendConfig of an Ingress set custom error response policies. They are
+// only supported by the global external Application Load Balancer with the
+// EXTERNAL_MANAGED scheme, which none of the Ingress classes provisions: the
+// classic external HTTP(S) load balancer rejects them, and the internal and
+// regional external load balancers do not support their error service.
+func ValidateCustomErrorResponsePolicies(ing *v1.Ingress, g *utils.GCEURLMap, fc *frontendconfigv1beta1.FrontendConfig) error {
+	if hasCustomErrorResponsePolicy(g, fc) {
+		return fmt.Errorf("custom error response policies not supported by the load balancer of Ingress %s/%s, they require a global external Application Load Balancer", ing.Namespace, ing.Name)
+	}
+	return nil
+}
@@ -52,8 +52,15 @@ var (
 	// require using different versions for each resource.
 	// must not be nil
 	featureToVersions = map[string]*ResourceVersions{
-		FeatureL7ILB:         &l7IlbVersions,
-		FeatureL7XLBRegional: &l7XLBRegionalVersions,
+		FeatureL7ILB:                     &l7IlbVersions,
+		FeatureL7XLBRegional:             &l7XLBRegionalVersions,
+		FeatureRetryPolicy:               &retryPolicyVersions,
+		FeatureFaultInjection:            &faultInjectionVersions,
+		FeatureRouteTimeout:              &routeTimeoutVersions,
+		FeatureRequestMirrorPolicy:       &requestMirrorPolicyVersions,
+		FeatureWeightedBackendServices:   &weightedBackendServicesVersions,
+		FeatureQuicOverride:              &quicOverrideVersions,
+		FeatureCustomErrorResponsePolicy: &customErrorResponsePolicyVersions,
 	}
 
 	// scopeToFeatures stores the mapping from the required resource type
@@ -17,12 +17,19 @@ limitations under the License.
 package features
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	networkingv1 "k8s.io/api/networking/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
+	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+	"k8s.io/ingress-gce/pkg/utils"
 )
 
 const (
@@ -235,6 +242,7 @@ func TestVersionsFromFeatures(t *testing.T) {
 	}
 
 	// Override features with fakes
+	defer func(original map[string]*ResourceVersions) { featureToVersions = original }(featureToVersions)
 	featureToVersions = fakeFeatureToVersions
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -288,3 +296,311 @@ func TestScopeFromFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestFeaturesFromURLMap(t *testing.T) {
+	retryConfig := &backendconfigv1.BackendConfig{
+		Spec: backendconfigv1.BackendConfigSpec{
+			RetryPolicy:  &backendconfigv1.RetryPolicyConfig{RetryConditions: []string{"5xx"}},
+			RouteTimeout: &backendconfigv1.DurationConfig{Seconds: 30},
+		},
+	}
+	faultConfig := &backendconfigv1.BackendConfig{
+		Spec: backendconfigv1.BackendConfigSpec{
+			FaultInjectionPolicy: &backendconfigv1.FaultInjectionPolicyConfig{
+				Abort: &backendconfigv1.FaultAbortConfig{HttpStatus: 503, Percentage: 10},
+			},
+		},
+	}
+	newURLMap := func(backendConfigs ...*backendconfigv1.BackendConfig) *utils.GCEURLMap {
+		g := utils.NewGCEURLMap()
+		g.DefaultBackend = &utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "default"}}}
+		var pathRules []utils.PathRule
+		for i, bc := range backendConfigs {
+			pathRules = append(pathRules, utils.PathRule{
+				Path:    fmt.Sprintf("/path%d", i),
+				Backend: utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: fmt.Sprintf("svc%d", i)}}, BackendConfig: bc},
+			})
+		}
+		g.PutPathRulesForHost("foo.com", pathRules)
+		return g
+	}
+
+	testCases := []struct {
+		desc     string
+		urlMap   *utils.GCEURLMap
+		expected []string
+	}{
+		{
+			desc: "nil url map",
+		},
+		{
+			desc:   "no backend config",
+			urlMap: newURLMap(nil),
+		},
+		{
+			desc:     "retry policy and route timeout",
+			urlMap:   newURLMap(nil, retryConfig),
+			expected: []string{FeatureRetryPolicy, FeatureRouteTimeout},
+		},
+		{
+			desc:     "all BackendConfig route action features",
+			urlMap:   newURLMap(faultConfig, retryConfig),
+			expected: []string{FeatureRetryPolicy, FeatureFaultInjection, FeatureRouteTimeout},
+		},
+		{
+			desc:     "mirrored default backend",
+			urlMap:   newMirroredURLMap(),
+			expected: []string{FeatureRequestMirrorPolicy},
+		},
+		{
+			desc:     "weighted backend services",
+			urlMap:   newWeightedURLMap(),
+			expected: []string{FeatureWeightedBackendServices},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result := featuresFromURLMap(tc.urlMap)
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Fatalf("want %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+// newMirroredURLMap returns a GCEURLMap whose default backend is mirrored.
+func newMirroredURLMap() *utils.GCEURLMap {
+	g := utils.NewGCEURLMap()
+	g.DefaultBackend = &utils.ServicePort{
+		ID:            utils.ServicePortID{Service: types.NamespacedName{Name: "default"}},
+		MirrorBackend: &utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "default-shadow"}}},
+	}
+	return g
+}
+
+// newWeightedURLMap returns a GCEURLMap with a route rule that splits the
+// traffic between two backends.
+func newWeightedURLMap() *utils.GCEURLMap {
+	g := utils.NewGCEURLMap()
+	g.DefaultBackend = &utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "default"}}}
+	g.PutRouteRulesForHost("foo.com", []utils.RouteRule{{
+		WeightedBackends: []utils.WeightedBackend{
+			{Backend: utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "blue"}}}, Weight: 90},
+			{Backend: utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "green"}}}, Weight: 10},
+		},
+	}})
+	return g
+}
+
+func TestValidateRouteActionFeatures(t *testing.T) {
+	retryConfig := &backendconfigv1.BackendConfig{
+		Spec: backendconfigv1.BackendConfigSpec{
+			RetryPolicy: &backendconfigv1.RetryPolicyConfig{RetryConditions: []string{"5xx"}},
+		},
+	}
+	newURLMap := func(bc *backendconfigv1.BackendConfig) *utils.GCEURLMap {
+		g := utils.NewGCEURLMap()
+		g.DefaultBackend = &utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "default"}}}
+		g.PutPathRulesForHost("foo.com", []utils.PathRule{{
+			Path:    "/path",
+			Backend: utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "svc"}}, BackendConfig: bc},
+		}})
+		return g
+	}
+	newIngress := func(class string) *networkingv1.Ingress {
+		ing := &networkingv1.Ingress{ObjectMeta: v1.ObjectMeta{Name: "ing", Namespace: "default"}}
+		if class != "" {
+			ing.Annotations = map[string]string{annotations.IngressClassKey: class}
+		}
+		return ing
+	}
+
+	testCases := []struct {
+		desc    string
+		ing     *networkingv1.Ingress
+		urlMap  *utils.GCEURLMap
+		wantErr bool
+	}{
+		{
+			desc:   "external ingress without route action features",
+			ing:    newIngress(""),
+			urlMap: newURLMap(nil),
+		},
+		{
+			desc:    "external ingress with retry policy",
+			ing:     newIngress(""),
+			urlMap:  newURLMap(retryConfig),
+			wantErr: true,
+		},
+		{
+			desc:    "gce ingress with retry policy",
+			ing:     newIngress(annotations.GceIngressClass),
+			urlMap:  newURLMap(retryConfig),
+			wantErr: true,
+		},
+		{
+			desc:   "internal ingress with retry policy",
+			ing:    newIngress(annotations.GceL7ILBIngressClass),
+			urlMap: newURLMap(retryConfig),
+		},
+		{
+			desc:   "regional external ingress with retry policy",
+			ing:    newIngress(annotations.GceL7XLBRegionalIngressClass),
+			urlMap: newURLMap(retryConfig),
+		},
+		{
+			desc:    "gce ingress with request mirror policy",
+			ing:     newIngress(annotations.GceIngressClass),
+			urlMap:  newMirroredURLMap(),
+			wantErr: true,
+		},
+		{
+			desc:   "internal ingress with request mirror policy",
+			ing:    newIngress(annotations.GceL7ILBIngressClass),
+			urlMap: newMirroredURLMap(),
+		},
+		{
+			desc:    "gce ingress with weighted backend services",
+			ing:     newIngress(annotations.GceIngressClass),
+			urlMap:  newWeightedURLMap(),
+			wantErr: true,
+		},
+		{
+			desc:   "regional external ingress with weighted backend services",
+			ing:    newIngress(annotations.GceL7XLBRegionalIngressClass),
+			urlMap: newWeightedURLMap(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ValidateRouteActionFeatures(tc.ing, tc.urlMap)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("ValidateRouteActionFeatures() = %v, want error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestVersionsFromFrontendConfig(t *testing.T) {
+	quicOverride := "ENABLE"
+
+	testCases := []struct {
+		desc         string
+		fc           *frontendconfigv1beta1.FrontendConfig
+		wantFeatures []string
+		wantVersion  meta.Version
+	}{
+		{
+			desc:        "nil frontend config",
+			wantVersion: meta.VersionGA,
+		},
+		{
+			desc:        "no target https proxy features",
+			fc:          &frontendconfigv1beta1.FrontendConfig{},
+			wantVersion: meta.VersionGA,
+		},
+		{
+			desc:         "quic override",
+			fc:           &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{QuicOverride: &quicOverride}},
+			wantFeatures: []string{FeatureQuicOverride},
+			wantVersion:  meta.VersionGA,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if result := featuresFromFrontendConfig(tc.fc); !reflect.DeepEqual(result, tc.wantFeatures) {
+				t.Errorf("featuresFromFrontendConfig() = %v, want %v", result, tc.wantFeatures)
+			}
+			versions := VersionsFromIngressURLMapAndFrontendConfig(&networkingv1.Ingress{}, nil, tc.fc)
+			if versions.TargetHttpsProxy != tc.wantVersion {
+				t.Errorf("VersionsFromIngressURLMapAndFrontendConfig().TargetHttpsProxy = %v, want %v", versions.TargetHttpsProxy, tc.wantVersion)
+			}
+			if versions.UrlMap != meta.VersionGA {
+				t.Errorf("VersionsFromIngressURLMapAndFrontendConfig().UrlMap = %v, want %v", versions.UrlMap, meta.VersionGA)
+			}
+		})
+	}
+}
+
+func TestCustomErrorResponsePolicies(t *testing.T) {
+	policy := &routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorService:       "error-pages",
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"}},
+	}
+	newURLMap := func(hostPolicy, pathPolicy *routeconfigv1beta1.CustomErrorResponsePolicy) *utils.GCEURLMap {
+		g := utils.NewGCEURLMap()
+		g.DefaultBackend = &utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "default"}}}
+		g.PutPathRulesForHost("foo.com", []utils.PathRule{{
+			Path:                      "/path",
+			Backend:                   utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Name: "svc"}}},
+			CustomErrorResponsePolicy: pathPolicy,
+		}})
+		g.HostRules[0].CustomErrorResponsePolicy = hostPolicy
+		return g
+	}
+	defaultPolicyConfig := &frontendconfigv1beta1.FrontendConfig{
+		Spec: frontendconfigv1beta1.FrontendConfigSpec{
+			DefaultCustomErrorResponsePolicy: &frontendconfigv1beta1.CustomErrorResponsePolicy{
+				ErrorService:       "error-pages",
+				ErrorResponseRules: []frontendconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc        string
+		urlMap      *utils.GCEURLMap
+		fc          *frontendconfigv1beta1.FrontendConfig
+		wantVersion meta.Version
+		wantErr     bool
+	}{
+		{
+			desc:        "no policies",
+			urlMap:      newURLMap(nil, nil),
+			fc:          &frontendconfigv1beta1.FrontendConfig{},
+			wantVersion: meta.VersionGA,
+		},
+		{
+			desc:        "host policy",
+			urlMap:      newURLMap(policy, nil),
+			wantVersion: meta.VersionBeta,
+			wantErr:     true,
+		},
+		{
+			desc:        "path policy",
+			urlMap:      newURLMap(nil, policy),
+			wantVersion: meta.VersionBeta,
+			wantErr:     true,
+		},
+		{
+			desc:        "default policy of the frontend config",
+			urlMap:      newURLMap(nil, nil),
+			fc:          defaultPolicyConfig,
+			wantVersion: meta.VersionBeta,
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			versions := VersionsFromIngressURLMapAndFrontendConfig(&networkingv1.Ingress{}, tc.urlMap, tc.fc)
+			if versions.UrlMap != tc.wantVersion {
+				t.Errorf("VersionsFromIngressURLMapAndFrontendConfig().UrlMap = %v, want %v", versions.UrlMap, tc.wantVersion)
+			}
+			for _, class := range []string{"", annotations.GceIngressClass, annotations.GceL7ILBIngressClass, annotations.GceL7XLBRegionalIngressClass} {
+				ing := &networkingv1.Ingress{ObjectMeta: v1.ObjectMeta{Name: "ing", Namespace: "default"}}
+				if class != "" {
+					ing.Annotations = map[string]string{annotations.IngressClassKey: class}
+				}
+				err := ValidateCustomErrorResponsePolicies(ing, tc.urlMap, tc.fc)
+				if gotErr := err != nil; gotErr != tc.wantErr {
+					t.Errorf("ValidateCustomErrorResponsePolicies() for class %q = %v, want error: %v", class, err, tc.wantErr)
+				}
+			}
+		})
+	}
+}
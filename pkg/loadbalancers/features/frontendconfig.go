@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functionality and constants for the features that are
+// configured through the FrontendConfig of an Ingress and programmed on the
+// target https proxy: QUIC override.
+package features
+
+import (
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	v1 "k8s.io/api/networking/v1"
+	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+const (
+	FeatureQuicOverride = "QuicOverride"
+)
+
+var (
+	// Empty fields are considered meta.VersionGA
+	quicOverrideVersions = ResourceVersions{TargetHttpsProxy: meta.VersionGA}
+)
+
+// featuresFromFrontendConfig returns the target https proxy features used by a
+// FrontendConfig.
+func featuresFromFrontendConfig(fc *frontendconfigv1beta1.FrontendConfig) []string {
+	if fc == nil {
+		return nil
+	}
+	var result []string
+	if fc.Spec.QuicOverride != nil {
+		result = append(result, FeatureQuicOverride)
+	}
+	return result
+}
+
+// VersionsFromIngressURLMapAndFrontendConfig returns a ResourceVersions struct
+// containing the versions required by the features of an Ingress, of its
+// GCEURLMap and of its FrontendConfig.
+func VersionsFromIngressURLMapAndFrontendConfig(ing *v1.Ingress, g *utils.GCEURLMap, fc *frontendconfigv1beta1.FrontendConfig) *ResourceVersions {
+	features := append(featuresFromIngress(ing), featuresFromURLMap(g)...)
+	features = append(features, featuresFromCustomErrorResponsePolicies(g, fc)...)
+	return versionsFromFeatures(append(features, featuresFromFrontendConfig(fc)...))
+}
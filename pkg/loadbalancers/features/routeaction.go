@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functionality and constants for the route action features
+// that are programmed on the UrlMap: retry policy, fault injection and route
+// timeout, configured through the BackendConfig of a backend, request mirroring,
+// configured through an Ingress annotation, and weighted backend services,
+// configured through a RouteConfig.
+package features
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	v1 "k8s.io/api/networking/v1"
+	"k8s.io/ingress-gce/pkg/annotations"
+	"k8s.io/ingress-gce/pkg/utils"
+)
+
+const (
+	FeatureRetryPolicy    = "RetryPolicy"
+	FeatureFaultInjection = "FaultInjection"
+	FeatureRouteTimeout   = "RouteTimeout"
+
+	FeatureRequestMirrorPolicy     = "RequestMirrorPolicy"
+	FeatureWeightedBackendServices = "WeightedBackendServices"
+)
+
+var (
+	// Empty fields are considered meta.VersionGA
+	retryPolicyVersions    = ResourceVersions{UrlMap: meta.VersionGA}
+	faultInjectionVersions = ResourceVersions{UrlMap: meta.VersionBeta}
+	routeTimeoutVersions   = ResourceVersions{UrlMap: meta.VersionGA}
+
+	requestMirrorPolicyVersions     = ResourceVersions{UrlMap: meta.VersionGA}
+	weightedBackendServicesVersions = ResourceVersions{UrlMap: meta.VersionGA}
+)
+
+// featuresFromURLMap returns the route action features used by the backends
+// and the route rules of a GCEURLMap.
+func featuresFromURLMap(g *utils.GCEURLMap) []string {
+	if g == nil {
+		return nil
+	}
+	var retryPolicy, faultInjection, routeTimeout bool
+	for _, sp := range g.AllServicePorts() {
+		if sp.BackendConfig == nil {
+			continue
+		}
+		spec := sp.BackendConfig.Spec
+		retryPolicy = retryPolicy || spec.RetryPolicy != nil
+		faultInjection = faultInjection || spec.FaultInjectionPolicy != nil
+		routeTimeout = routeTimeout || spec.RouteTimeout != nil
+	}
+
+	var requestMirrorPolicy, weightedBackendServices bool
+	if g.DefaultBackend != nil {
+		requestMirrorPolicy = g.DefaultBackend.MirrorBackend != nil
+	}
+	for _, hostRule := range g.HostRules {
+		for _, pathRule := range hostRule.Paths {
+			requestMirrorPolicy = requestMirrorPolicy || pathRule.Backend.MirrorBackend != nil
+		}
+		for _, routeRule := range hostRule.RouteRules {
+			weightedBackendServices = weightedBackendServices || len(routeRule.WeightedBackends) > 0
+			for _, sp := range routeRule.ServicePorts() {
+				requestMirrorPolicy = requestMirrorPolicy || sp.MirrorBackend != nil
+			}
+		}
+	}
+
+	var result []string
+	if retryPolicy {
+		result = append(result, FeatureRetryPolicy)
+	}
+	if faultInjection {
+		result = append(result, FeatureFaultInjection)
+	}
+	if routeTimeout {
+		result = append(result, FeatureRouteTimeout)
+	}
+	if requestMirrorPolicy {
+		result = append(result, FeatureRequestMirrorPolicy)
+	}
+	if weightedBackendServices {
+		result = append(result, FeatureWeightedBackendServices)
+	}
+	return result
+}
+
+// ValidateRouteActionFeatures returns an error if the backends of a GCEURLMap
+// use route action features that the load balancer of the Ingress does not
+// support. The classic external HTTP(S) load balancer rejects url maps with a
+// retry policy, fault injection, route timeout, request mirror policy or
+// weighted backend services, these are only supported by the internal and the
+// regional external HTTP(S) load balancers.
+func ValidateRouteActionFeatures(ing *v1.Ingress, g *utils.GCEURLMap) error {
+	if utils.IsGCEL7ILBIngress(ing) || utils.IsGCEL7XLBRegionalIngress(ing) {
+		return nil
+	}
+	if features := featuresFromURLMap(g); len(features) > 0 {
+		return fmt.Errorf("%s not supported by the external HTTP(S) load balancer of Ingress %s/%s, use Ingress class %q or %q", strings.Join(features, ", "), ing.Namespace, ing.Name, annotations.GceL7ILBIngressClass, annotations.GceL7XLBRegionalIngressClass)
+	}
+	return nil
+}
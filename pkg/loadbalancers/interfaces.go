@@ -28,6 +28,7 @@ type LoadBalancerPool interface {
 	// Ensure ensures a loadbalancer and its resources given the RuntimeInfo.
 	Ensure(ri *L7RuntimeInfo) (*L7, error)
 	// GCv2 garbage collects loadbalancer associated with given ingress using v2 naming scheme.
+	// This also applies to the Ingresses representing Gateways and shared load balancer groups.
 	GCv2(ing *v1.Ingress, scope meta.KeyType) error
 	// GCv1 garbage collects loadbalancers not in the input list using v1 naming scheme.
 	GCv1(names []string) error
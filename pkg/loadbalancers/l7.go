@@ -99,6 +99,9 @@ type L7 struct {
 	// to create - update - delete and storing the old certs in a list
 	// prevents leakage if there's a failure along the way.
 	oldSSLCerts []*composite.SslCertificate
+	// certRotation is the progress of the rotation of the ssl certs, nil if
+	// no rotation is in progress.
+	certRotation *certRotation
 	// namer is used to compute names of the various sub-components of an L7.
 	namer namer.IngressFrontendNamer
 	// recorder is used to generate k8s Events.
@@ -116,7 +119,10 @@ func (l7 *L7) String() string {
 
 // Versions returns the struct listing the versions for every resource
 func (l7 *L7) Versions() *features.ResourceVersions {
-	return features.VersionsFromIngress(&l7.ingress)
+	if l7.runtimeInfo == nil {
+		return features.VersionsFromIngress(&l7.ingress)
+	}
+	return features.VersionsFromIngressURLMapAndFrontendConfig(&l7.ingress, l7.runtimeInfo.UrlMap, l7.runtimeInfo.FrontendConfig)
 }
 
 // CreateKey creates a meta.Key for use with composite types
@@ -140,7 +146,16 @@ func (l7 *L7) UrlMap() *composite.UrlMap {
 }
 
 func (l7 *L7) edgeHop() error {
-	sslConfigured := l7.runtimeInfo.TLS != nil || l7.runtimeInfo.TLSName != ""
+	if err := l7.checkCertificateConfig(); err != nil {
+		return err
+	}
+	if err := l7.checkServerTlsPolicyConfig(); err != nil {
+		return err
+	}
+	if err := l7.checkQuicOverrideConfig(); err != nil {
+		return err
+	}
+	sslConfigured := l7.runtimeInfo.TLS != nil || l7.runtimeInfo.TLSName != "" || l7.usesCertificateMap() || l7.usesManagedCertificates()
 	// Return an error if user configuration species that both HTTP & HTTPS are not to be configured.
 	if !l7.runtimeInfo.AllowHTTP && !sslConfigured {
 		return errAllProtocolsDisabled
@@ -212,6 +227,7 @@ func (l7 *L7) edgeHopHttps() error {
 	if err := l7.checkHttpsProxy(); err != nil {
 		return err
 	}
+	l7.recordCertRotation()
 	return l7.checkHttpsForwardingRule()
 }
 
@@ -457,6 +473,15 @@ func (l7 *L7) getFrontendAnnotations(existing map[string]string) map[string]stri
 	} else {
 		delete(existing, annotations.SSLCertKey)
 	}
+	if l7.certRotation != nil {
+		if rotation, err := json.Marshal(l7.certRotation); err == nil {
+			existing[annotations.SSLCertRotationKey] = string(rotation)
+		} else {
+			klog.Errorf("Failed to marshal ssl cert rotation of %s: %v", l7, err)
+		}
+	} else {
+		delete(existing, annotations.SSLCertRotationKey)
+	}
 	return existing
 }
 
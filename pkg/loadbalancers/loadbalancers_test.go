@@ -18,11 +18,13 @@ package loadbalancers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
@@ -32,13 +34,17 @@ import (
 	"google.golang.org/api/googleapi"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/loadbalancers/features"
+	"k8s.io/ingress-gce/pkg/test"
 	"k8s.io/ingress-gce/pkg/translator"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/common"
@@ -156,6 +162,15 @@ func newFakeLoadBalancerPool(cloud *gce.Cloud, t *testing.T, namer *namer_util.N
 	return L7s{cloud, namer, events.RecorderProducerMock{}, namer_util.NewFrontendNamerFactory(namer, "")}
 }
 
+// fakeRecorderProducer returns the same recorder for every namespace.
+type fakeRecorderProducer struct {
+	recorder record.EventRecorder
+}
+
+func (f fakeRecorderProducer) Recorder(ns string) record.EventRecorder {
+	return f.recorder
+}
+
 func newILBIngress() *networkingv1.Ingress {
 	return &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
@@ -439,13 +454,36 @@ func TestCertUpdate(t *testing.T) {
 	expectCerts := map[string]string{certName1: lbInfo.TLS[0].Cert}
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 
-	// Sync with different cert
+	// Sync with different cert, both certs are attached while the old cert
+	// is rotated out.
 	lbInfo.TLS = []*translator.TLSCerts{createCert("key2", "cert2", "name")}
-	if _, err := j.pool.Ensure(lbInfo); err != nil {
+	l7, err := j.pool.Ensure(lbInfo)
+	if err != nil {
+		t.Fatalf("pool.Ensure() = err %v", err)
+	}
+	expectCerts = map[string]string{certName1: "cert", certName2: lbInfo.TLS[0].Cert}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+	ing.Annotations = l7.getFrontendAnnotations(ing.Annotations)
+	rotation := &certRotation{}
+	if err := json.Unmarshal([]byte(ing.Annotations[annotations.SSLCertRotationKey]), rotation); err != nil {
+		t.Fatalf("json.Unmarshal(%q) = %v", ing.Annotations[annotations.SSLCertRotationKey], err)
+	}
+	wantRotation := &certRotation{Phase: certRotationOverlapping, OldCerts: []string{certName1}, NewCerts: []string{certName2}, StartTime: rotation.StartTime}
+	if diff := cmp.Diff(wantRotation, rotation); diff != "" {
+		t.Errorf("Unexpected cert rotation (-want +got):\n%s", diff)
+	}
+
+	// The old cert is detached and deleted by the next sync.
+	l7, err = j.pool.Ensure(lbInfo)
+	if err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	expectCerts = map[string]string{certName2: lbInfo.TLS[0].Cert}
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+	ing.Annotations = l7.getFrontendAnnotations(ing.Annotations)
+	if val, ok := ing.Annotations[annotations.SSLCertRotationKey]; ok {
+		t.Errorf("Annotation %s = %q, want none after the rotation completed", annotations.SSLCertRotationKey, val)
+	}
 }
 
 // Test that multiple secrets with the same certificate value don't cause a sync error.
@@ -478,6 +516,54 @@ func TestMultipleSecretsWithSameCert(t *testing.T) {
 }
 
 // Tests that controller can overwrite existing, unused certificates
+// TestExpiredCert asserts that an expired cert is kept on the target proxy if it
+// is already uploaded, and that it is not uploaded otherwise.
+func TestExpiredCert(t *testing.T) {
+	j := newTestJig(t)
+	recorder := record.NewFakeRecorder(100)
+	j.pool.recorderProducer = fakeRecorderProducer{recorder}
+
+	gceUrlMap := utils.NewGCEURLMap()
+	gceUrlMap.DefaultBackend = &utils.ServicePort{NodePort: 31234, BackendNamer: j.namer}
+	gceUrlMap.PutPathRulesForHost("bar.example.com", []utils.PathRule{{Path: "/bar", Backend: utils.ServicePort{NodePort: 30000, BackendNamer: j.namer}}})
+	ing := newIngress()
+	certName := j.feNamer.SSLCertName(translator.GetCertHash("cert"))
+
+	cert := createCert("key", "cert", "name")
+	cert.NotAfter = time.Now().Add(time.Hour)
+	lbInfo := &L7RuntimeInfo{
+		AllowHTTP: false,
+		TLS:       []*translator.TLSCerts{cert},
+		UrlMap:    gceUrlMap,
+		Ingress:   ing,
+	}
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("pool.Ensure() = err %v", err)
+	}
+	expectCerts := map[string]string{certName: "cert"}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+
+	// The uploaded cert expires, and an expired cert is added.
+	cert.NotAfter = time.Now().Add(-time.Hour)
+	expiredCert := createCert("key2", "cert2", "name2")
+	expiredCert.NotAfter = time.Now().Add(-time.Hour)
+	lbInfo.TLS = append(lbInfo.TLS, expiredCert)
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("pool.Ensure() = err %v", err)
+	}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+
+	var kept, ignored bool
+	for len(recorder.Events) > 0 {
+		event := <-recorder.Events
+		kept = kept || strings.Contains(event, `secret "name" expired`)
+		ignored = ignored || strings.Contains(event, `secret "name2" expired`)
+	}
+	if !kept || !ignored {
+		t.Errorf("Expected warning events for both expired certs, got kept=%v, ignored=%v", kept, ignored)
+	}
+}
+
 func TestCertCreationWithCollision(t *testing.T) {
 	j := newTestJig(t)
 
@@ -531,6 +617,13 @@ func TestCertCreationWithCollision(t *testing.T) {
 	if _, err := j.pool.Ensure(lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
+	expectCerts = map[string]string{certName1: "cert", certName2: "xyz"}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+
+	// The old cert is rotated out by the next sync.
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("pool.Ensure() = err %v", err)
+	}
 	expectCerts = map[string]string{certName2: "xyz"}
 	// xyz instead of cert2 because the name collided and cert did not get updated.
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
@@ -579,6 +672,11 @@ func TestMultipleCertRetentionAfterRestart(t *testing.T) {
 
 	// Replace the 2 certs with a different, single cert
 	lbInfo.TLS = []*translator.TLSCerts{cert3}
+	expectCerts[certName3] = cert3.Cert
+	secondPool.Ensure(lbInfo)
+	// The old certs are retained until the new cert is attached
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+
 	expectCerts = map[string]string{certName3: cert3.Cert}
 	secondPool.Ensure(lbInfo)
 	// Only the new cert should be present
@@ -637,11 +735,18 @@ func TestUpgradeToNewCertNames(t *testing.T) {
 		t.Fatalf("Expected cert with name %s, Got %s", oldCertName, proxyCerts[0].Name)
 	}
 	// Sync should replace this oldCert with one following the new naming scheme
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("pool.Ensure() = err %v", err)
+	}
+	// Both certs are attached while the old cert is rotated out.
+	expectCerts := map[string]string{oldCertName: "cert", newCertName: tlsCert.Cert}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+
 	if _, err := j.pool.Ensure(lbInfo); err != nil {
 		t.Fatalf("pool.Ensure() = err %v", err)
 	}
 	// We expectEqual to see only the new cert linked to the proxy and available in the load balancer.
-	expectCerts := map[string]string{newCertName: tlsCert.Cert}
+	expectCerts = map[string]string{newCertName: tlsCert.Cert}
 	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
 }
 
@@ -867,9 +972,10 @@ func TestPreSharedToSecretBasedCertUpdate(t *testing.T) {
 	expectCertsProxy := map[string]string{certName1: lbInfo.TLS[0].Cert}
 	verifyCertAndProxyLink(expectCerts, expectCertsProxy, j, t)
 
-	// Sync a different cert.
+	// Sync a different cert, the old cert is rotated out by the second sync.
 	lbInfo.TLS = []*translator.TLSCerts{createCert("key2", "cert2", "name")}
 	j.pool.Ensure(lbInfo)
+	j.pool.Ensure(lbInfo)
 	delete(expectCerts, certName1)
 	expectCerts[certName2] = lbInfo.TLS[0].Cert
 	expectCertsProxy = map[string]string{certName2: lbInfo.TLS[0].Cert}
@@ -1188,6 +1294,196 @@ func TestFrontendConfigRedirects(t *testing.T) {
 	}
 }
 
+func TestFrontendConfigCertificateMap(t *testing.T) {
+	flags.F.EnableFrontendConfig = true
+	defer func() { flags.F.EnableFrontendConfig = false }()
+
+	j := newTestJig(t)
+	recorder := record.NewFakeRecorder(100)
+	j.pool.recorderProducer = fakeRecorderProducer{recorder}
+	// Fail any update that would leave the target proxy without certificates.
+	setSslCertificatesHook := j.mock.MockTargetHttpsProxies.SetSslCertificatesHook
+	j.mock.MockTargetHttpsProxies.SetSslCertificatesHook = func(ctx context.Context, key *meta.Key, request *compute.TargetHttpsProxiesSetSslCertificatesRequest, proxies *cloud.MockTargetHttpsProxies) error {
+		tp, err := proxies.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if len(request.SslCertificates) == 0 && tp.CertificateMap == "" {
+			return fmt.Errorf("target proxy %s would have no certificates", key.Name)
+		}
+		return setSslCertificatesHook(ctx, key, request, proxies)
+	}
+	j.mock.MockTargetHttpsProxies.SetCertificateMapHook = func(ctx context.Context, key *meta.Key, request *compute.TargetHttpsProxiesSetCertificateMapRequest, proxies *cloud.MockTargetHttpsProxies) error {
+		tp, err := proxies.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if request.CertificateMap == "" && len(tp.SslCertificates) == 0 {
+			return fmt.Errorf("target proxy %s would have no certificates", key.Name)
+		}
+		tp.CertificateMap = request.CertificateMap
+		return nil
+	}
+
+	gceUrlMap := utils.NewGCEURLMap()
+	gceUrlMap.DefaultBackend = &utils.ServicePort{NodePort: 31234, BackendNamer: j.namer}
+	gceUrlMap.PutPathRulesForHost("bar.example.com", []utils.PathRule{{Path: "/bar", Backend: utils.ServicePort{NodePort: 30000, BackendNamer: j.namer}}})
+	ing := newIngress()
+	certName := j.feNamer.SSLCertName(translator.GetCertHash("cert"))
+	certificateMap := "//certificatemanager.googleapis.com/projects/" + j.fakeGCE.ProjectID() + "/locations/global/certificateMaps/test-map"
+
+	lbInfo := &L7RuntimeInfo{
+		AllowHTTP: false,
+		TLS:       []*translator.TLSCerts{createCert("key", "cert", "name")},
+		UrlMap:    gceUrlMap,
+		Ingress:   ing,
+	}
+	verifyCertificateMap := func(want string) {
+		t.Helper()
+		key, err := composite.CreateKey(j.fakeGCE, j.feNamer.TargetProxy(namer_util.HTTPSProtocol), defaultScope)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tps, err := composite.GetTargetHttpsProxy(j.fakeGCE, key, defaultVersion, klog.TODO())
+		if err != nil {
+			t.Fatalf("expected https proxy to exist: %v", err)
+		}
+		if tps.CertificateMap != want {
+			t.Errorf("tps.CertificateMap = %q, want %q", tps.CertificateMap, want)
+		}
+	}
+
+	// Sync secret based cert.
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("j.pool.Ensure() = err %v", err)
+	}
+	expectCerts := map[string]string{certName: "cert"}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+	verifyCertificateMap("")
+
+	// Switch to the certificate map, the secret based cert is left unchanged
+	// and a warning is emitted that it is ignored.
+	lbInfo.FrontendConfig = &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{CertificateMap: utils.NewStringPointer("test-map")}}
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("j.pool.Ensure() = err %v", err)
+	}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+	verifyCertificateMap(certificateMap)
+	var warned bool
+	for len(recorder.Events) > 0 {
+		if event := <-recorder.Events; strings.HasPrefix(event, "Warning "+events.SyncIngress) && strings.Contains(event, "test-map") {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Errorf("no warning event emitted for the ignored certificates")
+	}
+
+	// Sync again without changes.
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("j.pool.Ensure() = err %v", err)
+	}
+	verifyCertificateMap(certificateMap)
+
+	// Switch back to the secret based cert.
+	lbInfo.FrontendConfig = nil
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("j.pool.Ensure() = err %v", err)
+	}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+	verifyCertificateMap("")
+}
+
+func TestFrontendConfigManagedCertificates(t *testing.T) {
+	flags.F.EnableFrontendConfig = true
+	defer func() { flags.F.EnableFrontendConfig = false }()
+
+	j := newTestJig(t)
+
+	gceUrlMap := utils.NewGCEURLMap()
+	gceUrlMap.DefaultBackend = &utils.ServicePort{NodePort: 31234, BackendNamer: j.namer}
+	gceUrlMap.PutPathRulesForHost("bar.example.com", []utils.PathRule{{Path: "/bar", Backend: utils.ServicePort{NodePort: 30000, BackendNamer: j.namer}}})
+	ing := newIngress()
+	certName := j.feNamer.SSLCertName(translator.GetCertHash("cert"))
+	managedCertName := j.feNamer.SSLCertName(translator.GetCertHash("bar.example.com"))
+
+	lbInfo := &L7RuntimeInfo{
+		AllowHTTP: false,
+		TLS:       []*translator.TLSCerts{createCert("key", "cert", "name")},
+		UrlMap:    gceUrlMap,
+		Ingress:   ing,
+	}
+
+	// Sync secret based cert.
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("j.pool.Ensure() = err %v", err)
+	}
+	expectCerts := map[string]string{certName: "cert"}
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+
+	// Switch to the managed cert, the secret based cert is retained while the
+	// managed cert is provisioning.
+	lbInfo.TLS = nil
+	lbInfo.FrontendConfig = &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{
+		ManagedCertificates: &frontendconfigv1beta1.ManagedCertificatesConfig{Domains: []string{"bar.example.com"}},
+	}}
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("j.pool.Ensure() = err %v", err)
+	}
+	expectCerts[managedCertName] = ""
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+	managedCert := j.mock.MockSslCertificates.Objects[*meta.GlobalKey(managedCertName)].ToGA()
+	if managedCert.Type != "MANAGED" || managedCert.Managed == nil || !cmp.Equal(managedCert.Managed.Domains, []string{"bar.example.com"}) {
+		t.Errorf("managed cert = %+v, want a managed cert for bar.example.com", managedCert)
+	}
+
+	// The secret based cert is deleted once the managed cert is provisioned.
+	managedCert.Managed.Status = "ACTIVE"
+	if _, err := j.pool.Ensure(lbInfo); err != nil {
+		t.Fatalf("j.pool.Ensure() = err %v", err)
+	}
+	delete(expectCerts, certName)
+	verifyCertAndProxyLink(expectCerts, expectCerts, j, t)
+}
+
+func TestFrontendConfigILB(t *testing.T) {
+	flags.F.EnableFrontendConfig = true
+	defer func() { flags.F.EnableFrontendConfig = false }()
+
+	for _, tc := range []struct {
+		desc string
+		spec frontendconfigv1beta1.FrontendConfigSpec
+	}{
+		{
+			desc: "certificate map",
+			spec: frontendconfigv1beta1.FrontendConfigSpec{CertificateMap: utils.NewStringPointer("test-map")},
+		},
+		{
+			desc: "managed certificates",
+			spec: frontendconfigv1beta1.FrontendConfigSpec{ManagedCertificates: &frontendconfigv1beta1.ManagedCertificatesConfig{Domains: []string{"bar.example.com"}}},
+		},
+		{
+			desc: "server tls policy",
+			spec: frontendconfigv1beta1.FrontendConfigSpec{ServerTlsPolicy: utils.NewStringPointer("test-policy")},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			j := newTestJig(t)
+			gceUrlMap := utils.NewGCEURLMap()
+			gceUrlMap.DefaultBackend = &utils.ServicePort{NodePort: 31234, BackendNamer: j.namer}
+			lbInfo := &L7RuntimeInfo{
+				AllowHTTP:      true,
+				UrlMap:         gceUrlMap,
+				Ingress:        newILBIngress(),
+				FrontendConfig: &frontendconfigv1beta1.FrontendConfig{Spec: tc.spec},
+			}
+			if _, err := j.pool.Ensure(lbInfo); err == nil {
+				t.Errorf("j.pool.Ensure() = nil, want error")
+			}
+		})
+	}
+}
+
 func TestEnsureSslPolicy(t *testing.T) {
 	t.Parallel()
 	j := newTestJig(t)
@@ -1263,6 +1559,272 @@ func TestEnsureSslPolicy(t *testing.T) {
 	}
 }
 
+func TestEnsureServerTlsPolicy(t *testing.T) {
+	t.Parallel()
+	policyLink := "//networksecurity.googleapis.com/projects/test-project/locations/global/serverTlsPolicies/test-policy"
+
+	for _, tc := range []struct {
+		desc       string
+		current    string
+		policyLink string
+		want       string
+		wantEvent  bool
+	}{
+		{
+			desc:       "attach server tls policy",
+			policyLink: policyLink,
+			want:       policyLink,
+			wantEvent:  true,
+		},
+		{
+			desc:       "proxy with server tls policy",
+			current:    policyLink,
+			policyLink: policyLink,
+			want:       policyLink,
+		},
+		{
+			desc:       "proxy with server tls policy url",
+			current:    "https://networksecurity.googleapis.com/v1/projects/test-project/locations/global/serverTlsPolicies/test-policy",
+			policyLink: policyLink,
+			want:       "https://networksecurity.googleapis.com/v1/projects/test-project/locations/global/serverTlsPolicies/test-policy",
+		},
+		{
+			desc:       "proxy with different server tls policy",
+			current:    "//networksecurity.googleapis.com/projects/test-project/locations/global/serverTlsPolicies/other-policy",
+			policyLink: policyLink,
+			want:       policyLink,
+			wantEvent:  true,
+		},
+		{
+			desc:      "remove server tls policy",
+			current:   policyLink,
+			wantEvent: true,
+		},
+		{
+			desc: "proxy without server tls policy",
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			j := newTestJig(t)
+			test.NewFakeComputeAPI(t, j.fakeGCE)
+			proxy := &composite.TargetHttpsProxy{Name: "test-proxy", ServerTlsPolicy: tc.current}
+			key := meta.GlobalKey(proxy.Name)
+			if err := composite.CreateTargetHttpsProxy(j.fakeGCE, key, proxy, klog.TODO()); err != nil {
+				t.Fatal(err)
+			}
+			recorder := record.NewFakeRecorder(10)
+			l7 := L7{runtimeInfo: &L7RuntimeInfo{}, cloud: j.fakeGCE, scope: meta.Global, recorder: recorder}
+
+			if err := l7.ensureServerTlsPolicy(proxy, tc.policyLink); err != nil {
+				t.Errorf("l7.ensureServerTlsPolicy() = %v, want nil", err)
+			}
+			result, err := composite.GetTargetHttpsProxy(j.fakeGCE, key, meta.VersionGA, klog.TODO())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.ServerTlsPolicy != tc.want {
+				t.Errorf("ServerTlsPolicy = %q, want %q", result.ServerTlsPolicy, tc.want)
+			}
+			if gotEvent := len(recorder.Events) > 0; gotEvent != tc.wantEvent {
+				t.Errorf("got event = %v, want %v", gotEvent, tc.wantEvent)
+			}
+		})
+	}
+}
+
+func TestFrontendConfigQuicOverride(t *testing.T) {
+	flags.F.EnableFrontendConfig = true
+	defer func() { flags.F.EnableFrontendConfig = false }()
+
+	j := newTestJig(t)
+	test.NewFakeComputeAPI(t, j.fakeGCE)
+	gceUrlMap := utils.NewGCEURLMap()
+	gceUrlMap.DefaultBackend = &utils.ServicePort{NodePort: 31234, BackendNamer: j.namer}
+	lbInfo := &L7RuntimeInfo{
+		AllowHTTP: false,
+		TLS:       []*translator.TLSCerts{createCert("key", "cert", "name")},
+		UrlMap:    gceUrlMap,
+		Ingress:   newIngress(),
+		FrontendConfig: &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{
+			QuicOverride: utils.NewStringPointer("ENABLE"),
+		}},
+	}
+	key, err := composite.CreateKey(j.fakeGCE, j.feNamer.TargetProxy(namer_util.HTTPSProtocol), defaultScope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		desc         string
+		quicOverride *string
+		want         string
+	}{
+		{
+			desc:         "create proxy with quic override",
+			quicOverride: utils.NewStringPointer("ENABLE"),
+			want:         "ENABLE",
+		},
+		{
+			desc:         "update quic override",
+			quicOverride: utils.NewStringPointer("DISABLE"),
+			want:         "DISABLE",
+		},
+		{
+			desc: "remove quic override",
+			want: "NONE",
+		},
+	} {
+		lbInfo.FrontendConfig.Spec.QuicOverride = tc.quicOverride
+		if _, err := j.pool.Ensure(lbInfo); err != nil {
+			t.Fatalf("%s: j.pool.Ensure() = err %v", tc.desc, err)
+		}
+		tps, err := composite.GetTargetHttpsProxy(j.fakeGCE, key, meta.VersionGA, klog.TODO())
+		if err != nil {
+			t.Fatalf("%s: expected https proxy to exist: %v", tc.desc, err)
+		}
+		if tps.QuicOverride != tc.want {
+			t.Errorf("%s: tps.QuicOverride = %q, want %q", tc.desc, tps.QuicOverride, tc.want)
+		}
+	}
+}
+
+func TestRouteActionFeaturesOnExternalLoadBalancer(t *testing.T) {
+	j := newTestJig(t)
+	gceUrlMap := utils.NewGCEURLMap()
+	gceUrlMap.DefaultBackend = &utils.ServicePort{NodePort: 31234, BackendNamer: j.namer}
+	gceUrlMap.PutPathRulesForHost("bar.example.com", []utils.PathRule{{
+		Path: "/bar",
+		Backend: utils.ServicePort{ID: utils.ServicePortID{Service: types.NamespacedName{Namespace: namespace, Name: "bar"}}, NodePort: 30000, BackendNamer: j.namer, BackendConfig: &backendconfigv1.BackendConfig{
+			Spec: backendconfigv1.BackendConfigSpec{RouteTimeout: &backendconfigv1.DurationConfig{Seconds: 30}},
+		}},
+	}})
+	lbInfo := &L7RuntimeInfo{
+		AllowHTTP: true,
+		UrlMap:    gceUrlMap,
+		Ingress:   newIngress(),
+	}
+
+	if _, err := j.pool.Ensure(lbInfo); err == nil {
+		t.Fatalf("j.pool.Ensure() = nil, want error for a route timeout on the external load balancer")
+	}
+	key, err := composite.CreateKey(j.fakeGCE, j.feNamer.UrlMap(), defaultScope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if um, err := composite.GetUrlMap(j.fakeGCE, key, meta.VersionGA, klog.TODO()); err == nil {
+		t.Errorf("GetUrlMap() = %+v, want the url map not to be created", um)
+	}
+}
+
+func TestCheckQuicOverrideConfig(t *testing.T) {
+	flags.F.EnableFrontendConfig = true
+	defer func() { flags.F.EnableFrontendConfig = false }()
+
+	for _, tc := range []struct {
+		desc    string
+		ing     *networkingv1.Ingress
+		spec    frontendconfigv1beta1.FrontendConfigSpec
+		wantErr bool
+	}{
+		{
+			desc: "no quic override",
+			ing:  newILBIngress(),
+		},
+		{
+			desc: "valid quic override",
+			ing:  newIngress(),
+			spec: frontendconfigv1beta1.FrontendConfigSpec{QuicOverride: utils.NewStringPointer("DISABLE")},
+		},
+		{
+			desc:    "invalid quic override",
+			ing:     newIngress(),
+			spec:    frontendconfigv1beta1.FrontendConfigSpec{QuicOverride: utils.NewStringPointer("enabled")},
+			wantErr: true,
+		},
+		{
+			desc:    "quic override with ilb",
+			ing:     newILBIngress(),
+			spec:    frontendconfigv1beta1.FrontendConfigSpec{QuicOverride: utils.NewStringPointer("ENABLE")},
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			l7 := L7{ingress: *tc.ing, runtimeInfo: &L7RuntimeInfo{Ingress: tc.ing, FrontendConfig: &frontendconfigv1beta1.FrontendConfig{Spec: tc.spec}}}
+			err := l7.checkQuicOverrideConfig()
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("l7.checkQuicOverrideConfig() = %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnsureQuicOverride(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc         string
+		current      string
+		quicOverride string
+		want         string
+		wantEvent    bool
+	}{
+		{
+			desc:         "matching quic override",
+			current:      "ENABLE",
+			quicOverride: "ENABLE",
+			want:         "ENABLE",
+		},
+		{
+			desc:         "default quic override",
+			quicOverride: "NONE",
+			want:         "",
+		},
+		{
+			desc:         "quic override changed",
+			current:      "NONE",
+			quicOverride: "DISABLE",
+			want:         "DISABLE",
+			wantEvent:    true,
+		},
+		{
+			desc:      "quic override removed",
+			current:   "ENABLE",
+			want:      "NONE",
+			wantEvent: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			j := newTestJig(t)
+			test.NewFakeComputeAPI(t, j.fakeGCE)
+			proxy := &composite.TargetHttpsProxy{Name: "test-proxy", QuicOverride: tc.current}
+			key := meta.GlobalKey(proxy.Name)
+			if err := composite.CreateTargetHttpsProxy(j.fakeGCE, key, proxy, klog.TODO()); err != nil {
+				t.Fatal(err)
+			}
+			recorder := record.NewFakeRecorder(10)
+			l7 := L7{runtimeInfo: &L7RuntimeInfo{}, cloud: j.fakeGCE, scope: meta.Global, recorder: recorder}
+
+			if err := l7.ensureQuicOverride(proxy, tc.quicOverride); err != nil {
+				t.Errorf("l7.ensureQuicOverride() = %v, want nil", err)
+			}
+			result, err := composite.GetTargetHttpsProxy(j.fakeGCE, key, meta.VersionGA, klog.TODO())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.QuicOverride != tc.want {
+				t.Errorf("QuicOverride = %q, want %q", result.QuicOverride, tc.want)
+			}
+			if gotEvent := len(recorder.Events) > 0; gotEvent != tc.wantEvent {
+				t.Errorf("got event = %v, want %v", gotEvent, tc.wantEvent)
+			}
+		})
+	}
+}
+
 // verifyURLMap gets the created URLMap and compares it against an expected one.
 func verifyURLMap(t *testing.T, j *testJig, feNamer namer_util.IngressFrontendNamer, wantGCEURLMap *utils.GCEURLMap) {
 	t.Helper()
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	loadbalancersSubsystem  = "loadbalancers"
+	sslCertRotationDuration = "ssl_cert_rotation_duration_seconds"
+	tlsCertExpiry           = "tls_cert_expiry_seconds"
+)
+
+var (
+	SSLCertRotationDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Subsystem: loadbalancersSubsystem,
+			Name:      sslCertRotationDuration,
+			Help:      "Duration of SSL certificate rotations, from attaching the new certificates to detaching the old certificates",
+			// custom buckets - [1s, 2s, 4s, 8s, ..., 16384s (~4.5hr)]
+			Buckets: prometheus.ExponentialBuckets(1, 2, 15),
+		},
+	)
+	TLSCertExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: loadbalancersSubsystem,
+			Name:      tlsCertExpiry,
+			Help:      "Seconds until the TLS certificate of a secret of an Ingress expires",
+		},
+		[]string{
+			"ingress", // namespace/name of the Ingress
+			"secret",  // name of the secret
+		},
+	)
+)
+
+var register sync.Once
+
+func RegisterMetrics() {
+	register.Do(func() {
+		prometheus.MustRegister(SSLCertRotationDuration)
+		prometheus.MustRegister(TLSCertExpiry)
+	})
+}
+
+// PublishSSLCertRotationMetrics observes the duration of a completed SSL
+// certificate rotation.
+func PublishSSLCertRotationMetrics(duration time.Duration) {
+	SSLCertRotationDuration.Observe(duration.Seconds())
+}
+
+// PublishTLSCertExpiryMetrics sets the seconds until expiry of the TLS
+// certificates of the Ingress, keyed by secret name. Secrets which are no longer
+// used by the Ingress are removed.
+func PublishTLSCertExpiryMetrics(ingKey string, expiries map[string]time.Duration) {
+	TLSCertExpiry.DeletePartialMatch(prometheus.Labels{"ingress": ingKey})
+	for secret, expiry := range expiries {
+		TLSCertExpiry.WithLabelValues(ingKey, secret).Set(expiry.Seconds())
+	}
+}
+
+// DeleteTLSCertExpiryMetrics removes the TLS certificate expiry metrics of the
+// Ingress.
+func DeleteTLSCertExpiryMetrics(ingKey string) {
+	TLSCertExpiry.DeletePartialMatch(prometheus.Labels{"ingress": ingKey})
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package loadbalancers
 
 import (
+	"fmt"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/events"
 	"k8s.io/ingress-gce/pkg/flags"
@@ -32,6 +36,8 @@ const (
 	TargetProxyCertLimit = 10
 )
 
+var quicOverrideValues = sets.NewString("ENABLE", "DISABLE", "NONE")
+
 // checkProxy ensures the correct TargetHttpProxy for a loadbalancer
 func (l7 *L7) checkProxy() (err error) {
 	// Get UrlMap Name, could be the url map or the redirect url map
@@ -110,9 +116,9 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 	isL7ILB := utils.IsGCEL7ILBIngress(l7.runtimeInfo.Ingress)
 	isL7XLBRegional := utils.IsGCEL7XLBRegionalIngress(l7.runtimeInfo.Ingress)
 	tr := translator.NewTranslator(isL7ILB, isL7XLBRegional, l7.namer)
-	env := &translator.Env{FrontendConfig: l7.runtimeInfo.FrontendConfig}
+	env := &translator.Env{FrontendConfig: l7.runtimeInfo.FrontendConfig, Project: l7.cloud.ProjectID()}
 
-	if len(l7.sslCerts) == 0 {
+	if len(l7.sslCerts) == 0 && !l7.usesCertificateMap() {
 		klog.V(2).Infof("No SSL certificates for %q, will not create HTTPS Proxy.", l7)
 		return nil
 	}
@@ -122,7 +128,7 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 		return err
 	}
 	description, err := l7.description()
-	version := l7.Versions().TargetHttpProxy
+	version := l7.Versions().TargetHttpsProxy
 	proxy, sslPolicySet, err := tr.ToCompositeTargetHttpsProxy(env, description, version, urlMapKey, l7.sslCerts)
 	if err != nil {
 		return err
@@ -171,7 +177,18 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q updated", key.Name)
 	}
 
-	if !l7.compareCerts(currentProxy.SslCertificates) {
+	// The certificate map takes precedence over the certs of the proxy,
+	// which are left unchanged while a certificate map is attached. A
+	// certificate map is removed only after the certs are attached, so that
+	// HTTPS is served throughout a switch back to certs.
+	certificateMapChanged := !equalLinks(currentProxy.CertificateMap, proxy.CertificateMap)
+	if certificateMapChanged && proxy.CertificateMap != "" {
+		if err := l7.setCertificateMap(currentProxy, proxy.CertificateMap); err != nil {
+			return err
+		}
+	}
+
+	if proxy.CertificateMap == "" && !l7.compareCerts(currentProxy.SslCertificates) {
 		klog.V(2).Infof("Https Proxy %q has the wrong ssl certs, setting %v overwriting %v",
 			currentProxy.Name, toCertNames(l7.sslCerts), currentProxy.SslCertificates)
 		var sslCertURLs []string
@@ -188,12 +205,30 @@ func (l7 *L7) checkHttpsProxy() (err error) {
 		l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q certs updated", key.Name)
 	}
 
+	if certificateMapChanged && proxy.CertificateMap == "" {
+		if err := l7.setCertificateMap(currentProxy, ""); err != nil {
+			return err
+		}
+	}
+
 	if flags.F.EnableFrontendConfig && sslPolicySet {
 		if err := l7.ensureSslPolicy(env, currentProxy, proxy.SslPolicy); err != nil {
 			return err
 		}
 	}
 
+	if l7.usesServerTlsPolicy() {
+		if err := l7.ensureServerTlsPolicy(currentProxy, proxy.ServerTlsPolicy); err != nil {
+			return err
+		}
+	}
+
+	if l7.usesQuicOverride() {
+		if err := l7.ensureQuicOverride(currentProxy, proxy.QuicOverride); err != nil {
+			return err
+		}
+	}
+
 	l7.tps = currentProxy
 	return nil
 }
@@ -227,3 +262,109 @@ func (l7 *L7) ensureSslPolicy(env *translator.Env, currentProxy *composite.Targe
 	}
 	return nil
 }
+
+// setCertificateMap sets the Certificate Manager certificate map of the proxy.
+// An empty link removes the certificate map.
+func (l7 *L7) setCertificateMap(currentProxy *composite.TargetHttpsProxy, certificateMapLink string) error {
+	klog.V(2).Infof("Https Proxy %q has the wrong certificate map, setting %q overwriting %q", currentProxy.Name, certificateMapLink, currentProxy.CertificateMap)
+	key, err := l7.CreateKey(currentProxy.Name)
+	if err != nil {
+		return err
+	}
+	if err := composite.SetCertificateMapForTargetHttpsProxy(l7.cloud, key, currentProxy, certificateMapLink); err != nil {
+		return err
+	}
+	l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q certificate map updated", key.Name)
+	return nil
+}
+
+// ensureServerTlsPolicy ensures that the server TLS policy described in the
+// FrontendConfig is attached to the proxy.
+func (l7 *L7) ensureServerTlsPolicy(currentProxy *composite.TargetHttpsProxy, policyLink string) error {
+	if equalLinks(currentProxy.ServerTlsPolicy, policyLink) {
+		return nil
+	}
+	klog.V(2).Infof("Https Proxy %q has the wrong server TLS policy, setting %q overwriting %q", currentProxy.Name, policyLink, currentProxy.ServerTlsPolicy)
+	key, err := l7.CreateKey(currentProxy.Name)
+	if err != nil {
+		return err
+	}
+	if err := composite.SetServerTlsPolicyForTargetHttpsProxy(l7.cloud, key, currentProxy, policyLink); err != nil {
+		return err
+	}
+	l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q server TLS policy updated", key.Name)
+	return nil
+}
+
+// usesQuicOverride returns true if the QUIC override of the proxy is managed
+// through the FrontendConfig. It is only supported by global external load
+// balancers.
+func (l7 *L7) usesQuicOverride() bool {
+	return flags.F.EnableFrontendConfig && !utils.IsGCEL7ILBIngress(&l7.ingress) && !utils.IsGCEL7XLBRegionalIngress(&l7.ingress)
+}
+
+// checkQuicOverrideConfig returns an error if the FrontendConfig of the load
+// balancer configures an invalid QUIC override, or configures it for a load
+// balancer which does not support it.
+func (l7 *L7) checkQuicOverrideConfig() error {
+	fc := l7.runtimeInfo.FrontendConfig
+	if !flags.F.EnableFrontendConfig || fc == nil || fc.Spec.QuicOverride == nil {
+		return nil
+	}
+	if utils.IsGCEL7ILBIngress(&l7.ingress) || utils.IsGCEL7XLBRegionalIngress(&l7.ingress) {
+		return fmt.Errorf("error: cannot use QUIC override with L7 ILB or regional external load balancers")
+	}
+	if !quicOverrideValues.Has(*fc.Spec.QuicOverride) {
+		return fmt.Errorf("error: invalid QUIC override %q, must be one of %v", *fc.Spec.QuicOverride, quicOverrideValues.List())
+	}
+	return nil
+}
+
+// ensureQuicOverride ensures that the QUIC override of the proxy matches the
+// one described in the FrontendConfig. An empty QUIC override resets the proxy
+// to NONE.
+func (l7 *L7) ensureQuicOverride(currentProxy *composite.TargetHttpsProxy, quicOverride string) error {
+	if equalSettings(currentProxy.QuicOverride, quicOverride, "NONE") {
+		return nil
+	}
+	if quicOverride == "" {
+		quicOverride = "NONE"
+	}
+	klog.V(2).Infof("Https Proxy %q has the wrong QUIC override, setting %q overwriting %q", currentProxy.Name, quicOverride, currentProxy.QuicOverride)
+	key, err := l7.CreateKey(currentProxy.Name)
+	if err != nil {
+		return err
+	}
+	if err := composite.SetQuicOverrideForTargetHttpsProxy(l7.cloud, key, currentProxy, quicOverride); err != nil {
+		return err
+	}
+	l7.recorder.Eventf(l7.runtimeInfo.Ingress, corev1.EventTypeNormal, events.SyncIngress, "TargetProxy %q QUIC override updated", key.Name)
+	return nil
+}
+
+// equalSettings returns true if the settings are equal, where an empty setting
+// is equal to the default setting.
+func equalSettings(a, b, defaultSetting string) bool {
+	if a == "" {
+		a = defaultSetting
+	}
+	if b == "" {
+		b = defaultSetting
+	}
+	return a == b
+}
+
+// equalLinks returns true if the references to a certificate map or a server
+// TLS policy refer to the same resource. References are either relative to the
+// API of the resource, such as
+// //certificatemanager.googleapis.com/projects/p/locations/global/certificateMaps/m,
+// or full URLs.
+func equalLinks(a, b string) bool {
+	trim := func(link string) string {
+		if i := strings.Index(link, "projects/"); i >= 0 {
+			return link[i:]
+		}
+		return link
+	}
+	return trim(a) == trim(b)
+}
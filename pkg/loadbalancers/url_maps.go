@@ -18,12 +18,14 @@ package loadbalancers
 
 import (
 	"fmt"
+	"reflect"
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/ingress-gce/pkg/annotations"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/events"
+	"k8s.io/ingress-gce/pkg/loadbalancers/features"
 	"k8s.io/ingress-gce/pkg/translator"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/klog/v2"
@@ -35,6 +37,12 @@ func (l7 *L7) ensureComputeURLMap() error {
 	if l7.runtimeInfo.UrlMap == nil {
 		return fmt.Errorf("cannot create urlmap without internal representation")
 	}
+	if err := features.ValidateRouteActionFeatures(&l7.ingress, l7.runtimeInfo.UrlMap); err != nil {
+		return err
+	}
+	if err := features.ValidateCustomErrorResponsePolicies(&l7.ingress, l7.runtimeInfo.UrlMap, l7.runtimeInfo.FrontendConfig); err != nil {
+		return err
+	}
 
 	// Every update replaces the entire urlmap.
 	// Use an empty name parameter since we only care about the scope
@@ -46,6 +54,11 @@ func (l7 *L7) ensureComputeURLMap() error {
 	expectedMap := translator.ToCompositeURLMap(l7.runtimeInfo.UrlMap, l7.namer, key)
 	key.Name = expectedMap.Name
 
+	expectedMap.DefaultCustomErrorResponsePolicy, err = translator.ToCompositeDefaultCustomErrorResponsePolicy(l7.runtimeInfo.FrontendConfig, key)
+	if err != nil {
+		return err
+	}
+
 	expectedMap.Version = l7.Versions().UrlMap
 	currentMap, err := composite.GetUrlMap(l7.cloud, key, expectedMap.Version, klog.TODO())
 	if utils.IgnoreHTTPNotFound(err) != nil {
@@ -168,6 +181,9 @@ func getBackendNames(computeURLMap *composite.UrlMap) ([]string, error) {
 			return nil, err
 		}
 		beNames.Insert(name)
+		if err := insertRouteActionBackendNames(beNames, pathMatcher.DefaultRouteAction); err != nil {
+			return nil, err
+		}
 
 		for _, pathRule := range pathMatcher.PathRules {
 			name, err = utils.KeyName(pathRule.Service)
@@ -175,6 +191,22 @@ func getBackendNames(computeURLMap *composite.UrlMap) ([]string, error) {
 				return nil, err
 			}
 			beNames.Insert(name)
+			if err := insertRouteActionBackendNames(beNames, pathRule.RouteAction); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, routeRule := range pathMatcher.RouteRules {
+			if routeRule.Service != "" {
+				name, err = utils.KeyName(routeRule.Service)
+				if err != nil {
+					return nil, err
+				}
+				beNames.Insert(name)
+			}
+			if err := insertRouteActionBackendNames(beNames, routeRule.RouteAction); err != nil {
+				return nil, err
+			}
 		}
 	}
 	// The default Service recorded in the urlMap is a link to the backend.
@@ -185,9 +217,35 @@ func getBackendNames(computeURLMap *composite.UrlMap) ([]string, error) {
 		return nil, err
 	}
 	beNames.Insert(name)
+	if err := insertRouteActionBackendNames(beNames, computeURLMap.DefaultRouteAction); err != nil {
+		return nil, err
+	}
 	return beNames.List(), nil
 }
 
+// insertRouteActionBackendNames inserts the names of the backends that the
+// route action sends or mirrors requests to into beNames.
+func insertRouteActionBackendNames(beNames sets.String, routeAction *composite.HttpRouteAction) error {
+	if routeAction == nil {
+		return nil
+	}
+	for _, wb := range routeAction.WeightedBackendServices {
+		name, err := utils.KeyName(wb.BackendService)
+		if err != nil {
+			return err
+		}
+		beNames.Insert(name)
+	}
+	if routeAction.RequestMirrorPolicy != nil {
+		name, err := utils.KeyName(routeAction.RequestMirrorPolicy.BackendService)
+		if err != nil {
+			return err
+		}
+		beNames.Insert(name)
+	}
+	return nil
+}
+
 // mapsEqual compares the structure of two compute.UrlMaps.
 // The service strings are parsed and compared as resource paths (such as
 // "global/backendServices/my-service") to ignore variables: endpoint, version, and project.
@@ -195,6 +253,12 @@ func mapsEqual(a, b *composite.UrlMap) bool {
 	if !utils.EqualResourcePaths(a.DefaultService, b.DefaultService) {
 		return false
 	}
+	if !routeActionsEqual(a.DefaultRouteAction, b.DefaultRouteAction) {
+		return false
+	}
+	if !customErrorResponsePoliciesEqual(a.DefaultCustomErrorResponsePolicy, b.DefaultCustomErrorResponsePolicy) {
+		return false
+	}
 	if len(a.HostRules) != len(b.HostRules) {
 		return false
 	}
@@ -225,12 +289,21 @@ func mapsEqual(a, b *composite.UrlMap) bool {
 		if !utils.EqualResourcePaths(a.DefaultService, b.DefaultService) {
 			return false
 		}
+		if !routeActionsEqual(a.DefaultRouteAction, b.DefaultRouteAction) {
+			return false
+		}
 		if a.Description != b.Description {
 			return false
 		}
 		if a.Name != b.Name {
 			return false
 		}
+		if !headerActionsEqual(a.HeaderAction, b.HeaderAction) {
+			return false
+		}
+		if !customErrorResponsePoliciesEqual(a.DefaultCustomErrorResponsePolicy, b.DefaultCustomErrorResponsePolicy) {
+			return false
+		}
 		if len(a.PathRules) != len(b.PathRules) {
 			return false
 		}
@@ -245,10 +318,196 @@ func mapsEqual(a, b *composite.UrlMap) bool {
 					return false
 				}
 			}
-			if !utils.EqualResourcePaths(a.Service, b.Service) {
+			if !servicesEqual(a.Service, b.Service) {
 				return false
 			}
+			if !routeActionsEqual(a.RouteAction, b.RouteAction) {
+				return false
+			}
+			if !customErrorResponsePoliciesEqual(a.CustomErrorResponsePolicy, b.CustomErrorResponsePolicy) {
+				return false
+			}
+		}
+		if !routeRulesEqual(a.RouteRules, b.RouteRules) {
+			return false
+		}
+	}
+	return true
+}
+
+// routeRulesEqual compares two lists of route rules. Services are compared
+// as resource paths, like in mapsEqual.
+func routeRulesEqual(a, b []*composite.HttpRouteRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		a := a[i]
+		b := b[i]
+		if a.Priority != b.Priority {
+			return false
+		}
+		if !servicesEqual(a.Service, b.Service) {
+			return false
+		}
+		if !reflect.DeepEqual(a.MatchRules, b.MatchRules) {
+			return false
+		}
+		if !routeActionsEqual(a.RouteAction, b.RouteAction) {
+			return false
+		}
+		if !headerActionsEqual(a.HeaderAction, b.HeaderAction) {
+			return false
+		}
+		if !customErrorResponsePoliciesEqual(a.CustomErrorResponsePolicy, b.CustomErrorResponsePolicy) {
+			return false
+		}
+	}
+	return true
+}
+
+// servicesEqual compares two service links as resource paths. Rules which
+// route with a route action instead of a service have an empty service link.
+func servicesEqual(a, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+	return utils.EqualResourcePaths(a, b)
+}
+
+// customErrorResponsePoliciesEqual compares two custom error response policies.
+// Error services are compared as resource paths, like in mapsEqual.
+func customErrorResponsePoliciesEqual(a, b *composite.CustomErrorResponsePolicy) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !servicesEqual(a.ErrorService, b.ErrorService) {
+		return false
+	}
+	if len(a.ErrorResponseRules) != len(b.ErrorResponseRules) {
+		return false
+	}
+	for i := range a.ErrorResponseRules {
+		a := a.ErrorResponseRules[i]
+		b := b.ErrorResponseRules[i]
+		if !stringsEqual(a.MatchResponseCodes, b.MatchResponseCodes) || a.Path != b.Path || a.OverrideResponseCode != b.OverrideResponseCode {
+			return false
+		}
+	}
+	return true
+}
+
+// headerActionsEqual compares two header actions. Empty and nil header lists
+// are considered equal.
+func headerActionsEqual(a, b *composite.HttpHeaderAction) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return headerOptionsEqual(a.RequestHeadersToAdd, b.RequestHeadersToAdd) &&
+		stringsEqual(a.RequestHeadersToRemove, b.RequestHeadersToRemove) &&
+		headerOptionsEqual(a.ResponseHeadersToAdd, b.ResponseHeadersToAdd) &&
+		stringsEqual(a.ResponseHeadersToRemove, b.ResponseHeadersToRemove)
+}
+
+func headerOptionsEqual(a, b []*composite.HttpHeaderOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].HeaderName != b[i].HeaderName || a[i].HeaderValue != b[i].HeaderValue || a[i].Replace != b[i].Replace {
+			return false
+		}
+	}
+	return true
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// routeActionsEqual compares two route actions. Backend services are compared
+// as resource paths, like in mapsEqual.
+func routeActionsEqual(a, b *composite.HttpRouteAction) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.WeightedBackendServices) != len(b.WeightedBackendServices) {
+		return false
+	}
+	for i := range a.WeightedBackendServices {
+		a := a.WeightedBackendServices[i]
+		b := b.WeightedBackendServices[i]
+		if !utils.EqualResourcePaths(a.BackendService, b.BackendService) {
+			return false
+		}
+		if a.Weight != b.Weight {
+			return false
 		}
 	}
+	if (a.RequestMirrorPolicy == nil) != (b.RequestMirrorPolicy == nil) {
+		return false
+	}
+	if a.RequestMirrorPolicy != nil && !utils.EqualResourcePaths(a.RequestMirrorPolicy.BackendService, b.RequestMirrorPolicy.BackendService) {
+		return false
+	}
+	if !urlRewritesEqual(a.UrlRewrite, b.UrlRewrite) {
+		return false
+	}
+	if !retryPoliciesEqual(a.RetryPolicy, b.RetryPolicy) {
+		return false
+	}
+	if !faultInjectionPoliciesEqual(a.FaultInjectionPolicy, b.FaultInjectionPolicy) {
+		return false
+	}
+	if !durationsEqual(a.Timeout, b.Timeout) {
+		return false
+	}
+	return true
+}
+
+func retryPoliciesEqual(a, b *composite.HttpRetryPolicy) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.NumRetries == b.NumRetries &&
+		stringsEqual(a.RetryConditions, b.RetryConditions) &&
+		durationsEqual(a.PerTryTimeout, b.PerTryTimeout)
+}
+
+func faultInjectionPoliciesEqual(a, b *composite.HttpFaultInjection) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if (a.Delay == nil) != (b.Delay == nil) || (a.Abort == nil) != (b.Abort == nil) {
+		return false
+	}
+	if a.Delay != nil && (a.Delay.Percentage != b.Delay.Percentage || !durationsEqual(a.Delay.FixedDelay, b.Delay.FixedDelay)) {
+		return false
+	}
+	if a.Abort != nil && (a.Abort.Percentage != b.Abort.Percentage || a.Abort.HttpStatus != b.Abort.HttpStatus) {
+		return false
+	}
 	return true
 }
+
+func durationsEqual(a, b *composite.Duration) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Seconds == b.Seconds && a.Nanos == b.Nanos
+}
+
+func urlRewritesEqual(a, b *composite.UrlRewrite) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.PathPrefixRewrite == b.PathPrefixRewrite && a.HostRewrite == b.HostRewrite
+}
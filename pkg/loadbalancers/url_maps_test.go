@@ -19,8 +19,15 @@ package loadbalancers
 import (
 	"testing"
 
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/loadbalancers/features"
+	"k8s.io/ingress-gce/pkg/translator"
+	"k8s.io/ingress-gce/pkg/utils"
+	"k8s.io/klog/v2"
 )
 
 func TestComputeURLMapEquals(t *testing.T) {
@@ -41,6 +48,423 @@ func TestComputeURLMapEquals(t *testing.T) {
 	}
 }
 
+func TestComputeURLMapEqualsUrlRewrite(t *testing.T) {
+	t.Parallel()
+
+	withRewrite := func() *composite.UrlMap {
+		m := testCompositeURLMap()
+		m.PathMatchers[0].PathRules[0].RouteAction = &composite.HttpRouteAction{
+			UrlRewrite: &composite.UrlRewrite{PathPrefixRewrite: "/", HostRewrite: "legacy.example.com"},
+		}
+		return m
+	}
+
+	m := withRewrite()
+	same := withRewrite()
+	if !mapsEqual(m, same) {
+		t.Errorf("mapsEqual(%+v, %+v) = false, want true", m, same)
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		mutate func(m *composite.UrlMap)
+	}{
+		{
+			desc:   "no route action",
+			mutate: func(m *composite.UrlMap) { m.PathMatchers[0].PathRules[0].RouteAction = nil },
+		},
+		{
+			desc:   "no url rewrite",
+			mutate: func(m *composite.UrlMap) { m.PathMatchers[0].PathRules[0].RouteAction.UrlRewrite = nil },
+		},
+		{
+			desc: "different path prefix rewrite",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].PathRules[0].RouteAction.UrlRewrite.PathPrefixRewrite = "/app1/"
+			},
+		},
+		{
+			desc: "different host rewrite",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].PathRules[0].RouteAction.UrlRewrite.HostRewrite = "example.com"
+			},
+		},
+		{
+			desc: "url rewrite on route rule",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[1].PathRules = nil
+				m.PathMatchers[1].RouteRules = []*composite.HttpRouteRule{
+					{
+						Priority:    1,
+						MatchRules:  []*composite.HttpRouteRuleMatch{{PrefixMatch: "/"}},
+						Service:     "global/backendServices/k8s-be-33500--uid1",
+						RouteAction: &composite.HttpRouteAction{UrlRewrite: &composite.UrlRewrite{PathPrefixRewrite: "/"}},
+					},
+				}
+			},
+		},
+		{
+			desc: "url rewrite on default backend",
+			mutate: func(m *composite.UrlMap) {
+				m.DefaultRouteAction = &composite.HttpRouteAction{UrlRewrite: &composite.UrlRewrite{PathPrefixRewrite: "/"}}
+			},
+		},
+		{
+			desc: "url rewrite on default backend of path matcher",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].DefaultRouteAction = &composite.HttpRouteAction{UrlRewrite: &composite.UrlRewrite{PathPrefixRewrite: "/"}}
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			diff := withRewrite()
+			tc.mutate(diff)
+			if mapsEqual(m, diff) {
+				t.Errorf("mapsEqual(%+v, %+v) = true, want false", m, diff)
+			}
+		})
+	}
+}
+
+func TestComputeURLMapEqualsRouteRules(t *testing.T) {
+	t.Parallel()
+
+	m := testCompositeURLMapWithRouteRules()
+	// Test equality, services are compared as resource paths.
+	same := testCompositeURLMapWithRouteRules()
+	same.PathMatchers[0].RouteRules[0].Service = "https://www.googleapis.com/compute/v1/projects/p/global/backendServices/k8s-be-35000--uid1"
+	if !mapsEqual(m, same) {
+		t.Errorf("mapsEqual(%+v, %+v) = false, want true", m, same)
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		mutate func(m *composite.UrlMap)
+	}{
+		{
+			desc:   "no route rules",
+			mutate: func(m *composite.UrlMap) { m.PathMatchers[0].RouteRules = nil },
+		},
+		{
+			desc:   "different priority",
+			mutate: func(m *composite.UrlMap) { m.PathMatchers[0].RouteRules[0].Priority = 10 },
+		},
+		{
+			desc: "different service",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].RouteRules[0].Service = "global/backendServices/k8s-be-36000--uid1"
+			},
+		},
+		{
+			desc: "different header match",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].RouteRules[0].MatchRules[0].HeaderMatches[0].ExactMatch = "false"
+			},
+		},
+		{
+			desc: "weighted backends",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].RouteRules[1].Service = ""
+				m.PathMatchers[0].RouteRules[1].RouteAction = &composite.HttpRouteAction{
+					WeightedBackendServices: []*composite.WeightedBackendService{
+						{BackendService: "global/backendServices/k8s-be-32000--uid1", Weight: 100},
+					},
+				}
+			},
+		},
+		{
+			desc: "different weight",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].RouteRules[2].RouteAction.WeightedBackendServices[0].Weight = 50
+			},
+		},
+		{
+			desc: "different weighted backend service",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].RouteRules[2].RouteAction.WeightedBackendServices[1].BackendService = "global/backendServices/k8s-be-38000--uid1"
+			},
+		},
+		{
+			desc: "different query parameter match",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].RouteRules[0].MatchRules[0].QueryParameterMatches = nil
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			diff := testCompositeURLMapWithRouteRules()
+			tc.mutate(diff)
+			if mapsEqual(m, diff) {
+				t.Errorf("mapsEqual(%+v, %+v) = true, want false", m, diff)
+			}
+		})
+	}
+}
+
+func TestComputeURLMapEqualsRetryAndFaultInjection(t *testing.T) {
+	t.Parallel()
+
+	withPolicies := func() *composite.UrlMap {
+		m := testCompositeURLMap()
+		m.PathMatchers[0].PathRules[0].RouteAction = &composite.HttpRouteAction{
+			RetryPolicy: &composite.HttpRetryPolicy{
+				RetryConditions: []string{"5xx", "connect-failure"},
+				NumRetries:      3,
+				PerTryTimeout:   &composite.Duration{Seconds: 2},
+			},
+			FaultInjectionPolicy: &composite.HttpFaultInjection{
+				Delay: &composite.HttpFaultDelay{FixedDelay: &composite.Duration{Nanos: 500000000}, Percentage: 50},
+				Abort: &composite.HttpFaultAbort{HttpStatus: 503, Percentage: 10},
+			},
+			Timeout: &composite.Duration{Seconds: 30},
+		}
+		return m
+	}
+
+	m := withPolicies()
+	same := withPolicies()
+	if !mapsEqual(m, same) {
+		t.Errorf("mapsEqual(%+v, %+v) = false, want true", m, same)
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		mutate func(ra *composite.HttpRouteAction)
+	}{
+		{
+			desc:   "no retry policy",
+			mutate: func(ra *composite.HttpRouteAction) { ra.RetryPolicy = nil },
+		},
+		{
+			desc:   "different retry conditions",
+			mutate: func(ra *composite.HttpRouteAction) { ra.RetryPolicy.RetryConditions = []string{"5xx"} },
+		},
+		{
+			desc:   "different number of retries",
+			mutate: func(ra *composite.HttpRouteAction) { ra.RetryPolicy.NumRetries = 1 },
+		},
+		{
+			desc:   "different per try timeout",
+			mutate: func(ra *composite.HttpRouteAction) { ra.RetryPolicy.PerTryTimeout.Seconds = 5 },
+		},
+		{
+			desc:   "no fault injection policy",
+			mutate: func(ra *composite.HttpRouteAction) { ra.FaultInjectionPolicy = nil },
+		},
+		{
+			desc:   "no fault delay",
+			mutate: func(ra *composite.HttpRouteAction) { ra.FaultInjectionPolicy.Delay = nil },
+		},
+		{
+			desc:   "different fault delay",
+			mutate: func(ra *composite.HttpRouteAction) { ra.FaultInjectionPolicy.Delay.FixedDelay.Nanos = 0 },
+		},
+		{
+			desc:   "different abort status",
+			mutate: func(ra *composite.HttpRouteAction) { ra.FaultInjectionPolicy.Abort.HttpStatus = 500 },
+		},
+		{
+			desc:   "different abort percentage",
+			mutate: func(ra *composite.HttpRouteAction) { ra.FaultInjectionPolicy.Abort.Percentage = 20 },
+		},
+		{
+			desc: "request mirror policy",
+			mutate: func(ra *composite.HttpRouteAction) {
+				ra.RequestMirrorPolicy = &composite.RequestMirrorPolicy{BackendService: "global/backendServices/k8s-be-33000--uid1"}
+			},
+		},
+		{
+			desc:   "no timeout",
+			mutate: func(ra *composite.HttpRouteAction) { ra.Timeout = nil },
+		},
+		{
+			desc:   "different timeout",
+			mutate: func(ra *composite.HttpRouteAction) { ra.Timeout.Seconds = 60 },
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			diff := withPolicies()
+			tc.mutate(diff.PathMatchers[0].PathRules[0].RouteAction)
+			if mapsEqual(m, diff) {
+				t.Errorf("mapsEqual(%+v, %+v) = true, want false", m, diff)
+			}
+		})
+	}
+}
+
+func TestComputeURLMapEqualsHeaderActions(t *testing.T) {
+	t.Parallel()
+
+	withHeaderActions := func() *composite.UrlMap {
+		m := testCompositeURLMapWithRouteRules()
+		m.PathMatchers[0].HeaderAction = &composite.HttpHeaderAction{
+			ResponseHeadersToRemove: []string{"server"},
+		}
+		m.PathMatchers[0].RouteRules[0].HeaderAction = &composite.HttpHeaderAction{
+			RequestHeadersToAdd: []*composite.HttpHeaderOption{
+				{HeaderName: "x-canary", HeaderValue: "true", Replace: true},
+			},
+		}
+		return m
+	}
+
+	m := withHeaderActions()
+	// Test equality, empty and nil header lists are equal.
+	same := withHeaderActions()
+	same.PathMatchers[0].HeaderAction.RequestHeadersToAdd = []*composite.HttpHeaderOption{}
+	if !mapsEqual(m, same) {
+		t.Errorf("mapsEqual(%+v, %+v) = false, want true", m, same)
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		mutate func(m *composite.UrlMap)
+	}{
+		{
+			desc:   "no path matcher header action",
+			mutate: func(m *composite.UrlMap) { m.PathMatchers[0].HeaderAction = nil },
+		},
+		{
+			desc: "different header to remove",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].HeaderAction.ResponseHeadersToRemove = []string{"via"}
+			},
+		},
+		{
+			desc:   "no route rule header action",
+			mutate: func(m *composite.UrlMap) { m.PathMatchers[0].RouteRules[0].HeaderAction = nil },
+		},
+		{
+			desc: "different header value",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].RouteRules[0].HeaderAction.RequestHeadersToAdd[0].HeaderValue = "false"
+			},
+		},
+		{
+			desc: "different replace",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].RouteRules[0].HeaderAction.RequestHeadersToAdd[0].Replace = false
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			diff := withHeaderActions()
+			tc.mutate(diff)
+			if mapsEqual(m, diff) {
+				t.Errorf("mapsEqual(%+v, %+v) = true, want false", m, diff)
+			}
+		})
+	}
+}
+
+func TestComputeURLMapEqualsCustomErrorResponsePolicies(t *testing.T) {
+	t.Parallel()
+
+	withPolicies := func() *composite.UrlMap {
+		m := testCompositeURLMap()
+		m.DefaultCustomErrorResponsePolicy = &composite.CustomErrorResponsePolicy{
+			ErrorService: "global/backendBuckets/error-pages",
+			ErrorResponseRules: []*composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+				{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+			},
+		}
+		m.PathMatchers[0].PathRules[0].CustomErrorResponsePolicy = &composite.CustomErrorResponsePolicy{
+			ErrorResponseRules: []*composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+				{MatchResponseCodes: []string{"404"}, Path: "/404.html", OverrideResponseCode: 200},
+			},
+		}
+		return m
+	}
+
+	m := withPolicies()
+	// Test equality, error services are compared as resource paths.
+	same := withPolicies()
+	same.DefaultCustomErrorResponsePolicy.ErrorService = "https://www.googleapis.com/compute/v1/projects/test-project/global/backendBuckets/error-pages"
+	if !mapsEqual(m, same) {
+		t.Errorf("mapsEqual(%+v, %+v) = false, want true", m, same)
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		mutate func(m *composite.UrlMap)
+	}{
+		{
+			desc:   "no default policy",
+			mutate: func(m *composite.UrlMap) { m.DefaultCustomErrorResponsePolicy = nil },
+		},
+		{
+			desc: "different error service",
+			mutate: func(m *composite.UrlMap) {
+				m.DefaultCustomErrorResponsePolicy.ErrorService = "global/backendBuckets/other"
+			},
+		},
+		{
+			desc: "path matcher policy",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].DefaultCustomErrorResponsePolicy = m.DefaultCustomErrorResponsePolicy
+			},
+		},
+		{
+			desc:   "no path rule policy",
+			mutate: func(m *composite.UrlMap) { m.PathMatchers[0].PathRules[0].CustomErrorResponsePolicy = nil },
+		},
+		{
+			desc: "different response codes",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].PathRules[0].CustomErrorResponsePolicy.ErrorResponseRules[0].MatchResponseCodes = []string{"4xx"}
+			},
+		},
+		{
+			desc: "different override response code",
+			mutate: func(m *composite.UrlMap) {
+				m.PathMatchers[0].PathRules[0].CustomErrorResponsePolicy.ErrorResponseRules[0].OverrideResponseCode = 0
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			diff := withPolicies()
+			tc.mutate(diff)
+			if mapsEqual(m, diff) {
+				t.Errorf("mapsEqual(%+v, %+v) = true, want false", m, diff)
+			}
+		})
+	}
+}
+
+func testCompositeURLMapWithRouteRules() *composite.UrlMap {
+	m := testCompositeURLMap()
+	m.PathMatchers[0].PathRules = nil
+	m.PathMatchers[0].RouteRules = []*composite.HttpRouteRule{
+		{
+			Priority: 1,
+			MatchRules: []*composite.HttpRouteRuleMatch{
+				{
+					PrefixMatch:           "/",
+					HeaderMatches:         []*composite.HttpHeaderMatch{{HeaderName: "x-canary", ExactMatch: "true"}},
+					QueryParameterMatches: []*composite.HttpQueryParameterMatch{{Name: "version", ExactMatch: "v2"}},
+				},
+			},
+			Service: "global/backendServices/k8s-be-35000--uid1",
+		},
+		{
+			Priority:   2,
+			MatchRules: []*composite.HttpRouteRuleMatch{{PrefixMatch: "/"}},
+			Service:    "global/backendServices/k8s-be-32000--uid1",
+		},
+		{
+			Priority:   3,
+			MatchRules: []*composite.HttpRouteRuleMatch{{PrefixMatch: "/blue-green/"}},
+			RouteAction: &composite.HttpRouteAction{
+				WeightedBackendServices: []*composite.WeightedBackendService{
+					{BackendService: "global/backendServices/k8s-be-36000--uid1", Weight: 80},
+					{BackendService: "global/backendServices/k8s-be-36500--uid1", Weight: 20},
+				},
+			},
+		},
+	}
+	return m
+}
+
 func testCompositeURLMap() *composite.UrlMap {
 	return &composite.UrlMap{
 		Name:           "k8s-um-lb-name",
@@ -113,6 +537,69 @@ func TestGetBackendNames(t *testing.T) {
 			},
 			wantNames: []string{"service-A", "service-B", "service-C"},
 		},
+		"Valid UrlMap with RouteRules": {
+			urlMap: &composite.UrlMap{
+				DefaultService: "global/backendServices/service-A",
+				PathMatchers: []*composite.PathMatcher{
+					{
+						DefaultService: "global/backendServices/service-B",
+						RouteRules: []*composite.HttpRouteRule{
+							{
+								Priority: 1,
+								Service:  "global/backendServices/service-C",
+							},
+							{
+								Priority: 2,
+								RouteAction: &composite.HttpRouteAction{
+									WeightedBackendServices: []*composite.WeightedBackendService{
+										{BackendService: "global/backendServices/service-C", Weight: 50},
+										{BackendService: "global/backendServices/service-D", Weight: 50},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantNames: []string{"service-A", "service-B", "service-C", "service-D"},
+		},
+		"Valid UrlMap with RequestMirrorPolicy": {
+			urlMap: &composite.UrlMap{
+				DefaultService: "global/backendServices/service-A",
+				PathMatchers: []*composite.PathMatcher{
+					{
+						DefaultService: "global/backendServices/service-B",
+						PathRules: []*composite.PathRule{
+							{
+								Paths:   []string{"/"},
+								Service: "global/backendServices/service-C",
+								RouteAction: &composite.HttpRouteAction{
+									RequestMirrorPolicy: &composite.RequestMirrorPolicy{BackendService: "global/backendServices/service-D"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantNames: []string{"service-A", "service-B", "service-C", "service-D"},
+		},
+		"Mirrored default backend": {
+			urlMap: &composite.UrlMap{
+				DefaultService: "global/backendServices/service-A",
+				DefaultRouteAction: &composite.HttpRouteAction{
+					RequestMirrorPolicy: &composite.RequestMirrorPolicy{BackendService: "global/backendServices/service-B"},
+				},
+				PathMatchers: []*composite.PathMatcher{
+					{
+						DefaultService: "global/backendServices/service-A",
+						DefaultRouteAction: &composite.HttpRouteAction{
+							RequestMirrorPolicy: &composite.RequestMirrorPolicy{BackendService: "global/backendServices/service-B"},
+						},
+					},
+				},
+			},
+			wantNames: []string{"service-A", "service-B"},
+		},
 		"Invalid DefaultService": {
 			urlMap: &composite.UrlMap{
 				DefaultService: "/global/backendServices/service-A",
@@ -165,3 +652,61 @@ func TestGetBackendNames(t *testing.T) {
 		})
 	}
 }
+
+// TestCustomErrorResponsePoliciesRoundTrip asserts that the custom error
+// response policies of a UrlMap are read back from GCE at the UrlMap version
+// selected for them, so that the UrlMap is not updated on every sync.
+func TestCustomErrorResponsePoliciesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	policy := &routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorService:       "error-pages",
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"404"}, Path: "/404.html", OverrideResponseCode: 200}},
+	}
+
+	for _, tc := range []struct {
+		desc      string
+		version   func(g *utils.GCEURLMap) meta.Version
+		wantEqual bool
+	}{
+		{
+			desc: "version selected by features",
+			version: func(g *utils.GCEURLMap) meta.Version {
+				return features.VersionsFromIngressURLMapAndFrontendConfig(&networkingv1.Ingress{}, g, nil).UrlMap
+			},
+			wantEqual: true,
+		},
+		{
+			desc:    "GA drops the policies",
+			version: func(*utils.GCEURLMap) meta.Version { return meta.VersionGA },
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			j := newTestJig(t)
+			g := utils.NewGCEURLMap()
+			g.DefaultBackend = &utils.ServicePort{NodePort: 31234, BackendNamer: j.namer}
+			g.PutPathRulesForHost("foo.example.com", []utils.PathRule{{
+				Path:                      "/foo",
+				Backend:                   utils.ServicePort{NodePort: 30000, BackendNamer: j.namer},
+				CustomErrorResponsePolicy: policy,
+			}})
+
+			key := meta.GlobalKey("")
+			expectedMap := translator.ToCompositeURLMap(g, j.feNamer, key)
+			key.Name = expectedMap.Name
+			expectedMap.Version = tc.version(g)
+			if err := composite.CreateUrlMap(j.fakeGCE, key, expectedMap, klog.TODO()); err != nil {
+				t.Fatalf("composite.CreateUrlMap() = %v", err)
+			}
+			currentMap, err := composite.GetUrlMap(j.fakeGCE, key, expectedMap.Version, klog.TODO())
+			if err != nil {
+				t.Fatalf("composite.GetUrlMap() = %v", err)
+			}
+			if got := mapsEqual(currentMap, expectedMap); got != tc.wantEqual {
+				t.Errorf("mapsEqual(%+v, %+v) = %v, want %v", currentMap, expectedMap, got, tc.wantEqual)
+			}
+		})
+	}
+}
@@ -98,6 +98,11 @@ const (
 	sslPolicy      = feature("SSLPolicy")
 	httpsRedirects = feature("HTTPSRedirects")
 
+	// Route action Features
+	retryPolicy    = feature("RetryPolicy")
+	faultInjection = feature("FaultInjection")
+	routeTimeout   = feature("RouteTimeout")
+
 	standaloneNeg  = feature("StandaloneNEG")
 	ingressNeg     = feature("IngressNEG")
 	asmNeg         = feature("AsmNEG")
@@ -143,7 +148,7 @@ const (
 )
 
 // featuresForIngress returns the list of features for given ingress.
-func featuresForIngress(ing *v1.Ingress, fc *frontendconfigv1beta1.FrontendConfig) []feature {
+func featuresForIngress(ing *v1.Ingress, fc *frontendconfigv1beta1.FrontendConfig, svcPorts []utils.ServicePort) []feature {
 	features := []feature{ingress}
 
 	ingKey := fmt.Sprintf("%s/%s", ing.Namespace, ing.Name)
@@ -258,6 +263,30 @@ func featuresForIngress(ing *v1.Ingress, fc *frontendconfigv1beta1.FrontendConfi
 		}
 	}
 
+	// Route action features are configured in the BackendConfigs of the
+	// backends, but are programmed on the UrlMap of the ingress.
+	var hasRetryPolicy, hasFaultInjection, hasRouteTimeout bool
+	for _, sp := range svcPorts {
+		if sp.BackendConfig == nil {
+			continue
+		}
+		hasRetryPolicy = hasRetryPolicy || sp.BackendConfig.Spec.RetryPolicy != nil
+		hasFaultInjection = hasFaultInjection || sp.BackendConfig.Spec.FaultInjectionPolicy != nil
+		hasRouteTimeout = hasRouteTimeout || sp.BackendConfig.Spec.RouteTimeout != nil
+	}
+	if hasRetryPolicy {
+		klog.V(6).Infof("Retry policy is configured for ingress %s", ingKey)
+		features = append(features, retryPolicy)
+	}
+	if hasFaultInjection {
+		klog.V(6).Infof("Fault injection is configured for ingress %s", ingKey)
+		features = append(features, faultInjection)
+	}
+	if hasRouteTimeout {
+		klog.V(6).Infof("Route timeout is configured for ingress %s", ingKey)
+		features = append(features, routeTimeout)
+	}
+
 	klog.V(4).Infof("Features for ingress %s: %v", ingKey, features)
 	return features
 }
@@ -359,7 +359,7 @@ func (im *ControllerMetrics) computeIngressMetrics() (map[feature]int, map[featu
 		currIngFeatures := make(map[feature]bool)
 		klog.V(6).Infof("Computing frontend based features for ingress %s", ingKey)
 		// Add frontend associated ingress features.
-		for _, feature := range featuresForIngress(ingState.ingress, ingState.frontendconfig) {
+		for _, feature := range featuresForIngress(ingState.ingress, ingState.frontendconfig, ingState.servicePorts) {
 			currIngFeatures[feature] = true
 		}
 		klog.V(6).Infof("Frontend based features for ingress %s: %v", ingKey, currIngFeatures)
@@ -858,6 +858,49 @@ var (
 			[]utils.ServicePort{testServicePorts[6]},
 			[]feature{servicePort, externalServicePort, cloudArmorNil},
 		},
+		{
+			"backend with retry policy, fault injection and route timeout",
+			&v1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNamespace,
+					Name:      "ingress22",
+				},
+				Spec: v1.IngressSpec{
+					DefaultBackend: &v1.IngressBackend{
+						Service: &v1.IngressServiceBackend{
+							Name: "service-with-route-action",
+							Port: v1.ServiceBackendPort{
+								Number: int32(80),
+							},
+						},
+					},
+					Rules: []v1.IngressRule{},
+				},
+			},
+			nil,
+			[]feature{ingress, externalIngress, httpEnabled, retryPolicy, faultInjection, routeTimeout},
+			[]utils.ServicePort{
+				{
+					ID: utils.ServicePortID{
+						Service: types.NamespacedName{
+							Name:      "service-with-route-action",
+							Namespace: defaultNamespace,
+						},
+						Port: v1.ServiceBackendPort{Number: 80},
+					},
+					BackendConfig: &backendconfigv1.BackendConfig{
+						Spec: backendconfigv1.BackendConfigSpec{
+							RetryPolicy: &backendconfigv1.RetryPolicyConfig{RetryConditions: []string{"5xx"}},
+							FaultInjectionPolicy: &backendconfigv1.FaultInjectionPolicyConfig{
+								Delay: &backendconfigv1.FaultDelayConfig{FixedDelay: backendconfigv1.DurationConfig{Seconds: 1}, Percentage: 50},
+							},
+							RouteTimeout: &backendconfigv1.DurationConfig{Seconds: 30},
+						},
+					},
+				},
+			},
+			[]feature{servicePort, externalServicePort, cloudArmorNil},
+		},
 	}
 )
 
@@ -867,7 +910,7 @@ func TestFeaturesForIngress(t *testing.T) {
 		tc := tc
 		t.Run(tc.desc, func(t *testing.T) {
 			t.Parallel()
-			gotFrontendFeatures := featuresForIngress(tc.ing, tc.fc)
+			gotFrontendFeatures := featuresForIngress(tc.ing, tc.fc, tc.svcPorts)
 			if diff := cmp.Diff(tc.frontendFeatures, gotFrontendFeatures); diff != "" {
 				t.Fatalf("Got diff for frontend features (-want +got):\n%s", diff)
 			}
@@ -35,6 +35,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/cloud-provider/service/helpers"
 	"k8s.io/ingress-gce/pkg/annotations"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	svcnegv1beta1 "k8s.io/ingress-gce/pkg/apis/svcneg/v1beta1"
 	"k8s.io/ingress-gce/pkg/controller/translator"
 	"k8s.io/ingress-gce/pkg/flags"
@@ -45,6 +46,7 @@ import (
 	"k8s.io/ingress-gce/pkg/neg/syncers/labels"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
 	"k8s.io/ingress-gce/pkg/network"
+	"k8s.io/ingress-gce/pkg/routeconfig"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/endpointslices"
@@ -75,6 +77,7 @@ type Controller struct {
 	hasSynced                   func() bool
 	ingressLister               cache.Indexer
 	serviceLister               cache.Indexer
+	routeConfigLister           cache.Indexer
 	client                      kubernetes.Interface
 	defaultBackendService       utils.ServicePort
 	enableASM                   bool
@@ -124,6 +127,7 @@ func NewController(
 	svcNegInformer cache.SharedIndexInformer,
 	networkInformer cache.SharedIndexInformer,
 	gkeNetworkParamSetInformer cache.SharedIndexInformer,
+	routeConfigInformer cache.SharedIndexInformer,
 	hasSynced func() bool,
 	controllerMetrics *usageMetrics.ControllerMetrics,
 	l4Namer namer2.L4ResourcesNamer,
@@ -212,6 +216,10 @@ func NewController(
 	if gkeNetworkParamSetInformer != nil {
 		gkeNetworkParamSetIndexer = gkeNetworkParamSetInformer.GetIndexer()
 	}
+	var routeConfigIndexer cache.Indexer
+	if routeConfigInformer != nil {
+		routeConfigIndexer = routeConfigInformer.GetIndexer()
+	}
 	negController := &Controller{
 		client:                        kubeClient,
 		manager:                       manager,
@@ -226,6 +234,7 @@ func NewController(
 		hasSynced:                     hasSynced,
 		ingressLister:                 ingressInformer.GetIndexer(),
 		serviceLister:                 serviceInformer.GetIndexer(),
+		routeConfigLister:             routeConfigIndexer,
 		networkResolver:               network.NewNetworksResolver(networkIndexer, gkeNetworkParamSetIndexer, cloud, enableMultiNetworking, logger),
 		serviceQueue:                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "neg_service_queue"),
 		endpointQueue:                 workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "neg_endpoint_queue"),
@@ -265,14 +274,31 @@ func NewController(
 					logger.V(4).Info("Ignoring update for ingress based on annotation", "ingress", klog.KObj(curIng), "annotation", annotations.IngressClassKey)
 					return
 				}
-				keys := gatherIngressServiceKeys(oldIng)
-				keys = keys.Union(gatherIngressServiceKeys(curIng))
+				routeConfigs := negController.routeConfigs()
+				keys := gatherIngressServiceKeys(oldIng, routeConfigs)
+				keys = keys.Union(gatherIngressServiceKeys(curIng, routeConfigs))
 				for _, key := range keys.List() {
 					negController.enqueueService(cache.ExplicitKey(key))
 				}
 			},
 		})
 
+		if routeConfigInformer != nil {
+			routeConfigInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc: negController.enqueueRouteConfigServices,
+				DeleteFunc: func(obj interface{}) {
+					if state, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+						obj = state.Obj
+					}
+					negController.enqueueRouteConfigServices(obj)
+				},
+				UpdateFunc: func(old, cur interface{}) {
+					negController.enqueueRouteConfigServices(old)
+					negController.enqueueRouteConfigServices(cur)
+				},
+			})
+		}
+
 		podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
 				pod := obj.(*apiv1.Pod)
@@ -543,8 +569,9 @@ func (c *Controller) mergeIngressPortInfo(service *apiv1.Service, name types.Nam
 	// handle NEGs used by ingress
 	if negAnnotation != nil && negAnnotation.NEGEnabledForIngress() {
 		// Only service ports referenced by ingress are synced for NEG
-		ings := getIngressServicesFromStore(c.ingressLister, service)
-		ingressSvcPortTuples := gatherPortMappingUsedByIngress(ings, service, c.logger)
+		routeConfigs := c.routeConfigs()
+		ings := getIngressServicesFromStore(c.ingressLister, routeConfigs, service)
+		ingressSvcPortTuples := gatherPortMappingUsedByIngress(ings, routeConfigs, service, c.logger)
 		ingressPortInfoMap := negtypes.NewPortInfoMap(name.Namespace, name.Name, ingressSvcPortTuples, c.namer, true, nil, networkInfo)
 		if err := portInfoMap.Merge(ingressPortInfoMap); err != nil {
 			return fmt.Errorf("failed to merge service ports referenced by ingress (%v): %w", ingressPortInfoMap, err)
@@ -807,7 +834,7 @@ func (c *Controller) enqueueService(obj interface{}) {
 
 func (c *Controller) enqueueIngressServices(ing *v1.Ingress) {
 	// enqueue services referenced by ingress
-	keys := gatherIngressServiceKeys(ing)
+	keys := gatherIngressServiceKeys(ing, c.routeConfigs())
 	for key := range keys {
 		c.enqueueService(cache.ExplicitKey(key))
 	}
@@ -818,6 +845,48 @@ func (c *Controller) enqueueIngressServices(ing *v1.Ingress) {
 	}
 }
 
+// enqueueRouteConfigServices enqueues the services referenced by the given
+// RouteConfig for the ingresses that use it.
+func (c *Controller) enqueueRouteConfigServices(obj interface{}) {
+	routeConfig, ok := obj.(*routeconfigv1beta1.RouteConfig)
+	if !ok {
+		c.logger.Error(nil, "Unexpected object type for RouteConfig", "type", fmt.Sprintf("%T", obj))
+		return
+	}
+	for _, m := range c.ingressLister.List() {
+		ing := m.(*v1.Ingress)
+		if !utils.IsGLBCIngress(ing) || ing.Namespace != routeConfig.Namespace || annotations.FromIngress(ing).RouteConfig() != routeConfig.Name {
+			continue
+		}
+		utils.TraverseIngressBackends(ing, routeConfig, func(id utils.ServicePortID) bool {
+			c.enqueueService(cache.ExplicitKey(id.Service.String()))
+			return false
+		})
+	}
+}
+
+// routeConfigs returns the RouteConfigs in the store, if RouteConfigs are enabled.
+func (c *Controller) routeConfigs() []*routeconfigv1beta1.RouteConfig {
+	if c.routeConfigLister == nil {
+		return nil
+	}
+	var routeConfigs []*routeconfigv1beta1.RouteConfig
+	for _, obj := range c.routeConfigLister.List() {
+		routeConfigs = append(routeConfigs, obj.(*routeconfigv1beta1.RouteConfig))
+	}
+	return routeConfigs
+}
+
+// routeConfigForIngress returns the RouteConfig referenced by the ingress,
+// or nil if it does not reference an existing RouteConfig.
+func routeConfigForIngress(routeConfigs []*routeconfigv1beta1.RouteConfig, ing *v1.Ingress) *routeconfigv1beta1.RouteConfig {
+	routeConfig, err := routeconfig.RouteConfigForIngress(routeConfigs, ing)
+	if err != nil {
+		return nil
+	}
+	return routeConfig
+}
+
 func (c *Controller) gc() {
 	if err := c.manager.GC(); err != nil {
 		c.logger.Error(err, "NEG controller garbage collection failed")
@@ -827,11 +896,12 @@ func (c *Controller) gc() {
 
 // gatherPortMappingUsedByIngress returns a map containing port:targetport
 // of all service ports of the service that are referenced by ingresses
-func gatherPortMappingUsedByIngress(ings []v1.Ingress, svc *apiv1.Service, logger klog.Logger) negtypes.SvcPortTupleSet {
+// or by the RouteConfigs they reference
+func gatherPortMappingUsedByIngress(ings []v1.Ingress, routeConfigs []*routeconfigv1beta1.RouteConfig, svc *apiv1.Service, logger klog.Logger) negtypes.SvcPortTupleSet {
 	ingressSvcPortTuples := make(negtypes.SvcPortTupleSet)
 	for _, ing := range ings {
 		if utils.IsGLBCIngress(&ing) {
-			utils.TraverseIngressBackends(&ing, func(id utils.ServicePortID) bool {
+			utils.TraverseIngressBackends(&ing, routeConfigForIngress(routeConfigs, &ing), func(id utils.ServicePortID) bool {
 				if id.Service.Name == svc.Name && id.Service.Namespace == svc.Namespace {
 					servicePort := translator.ServicePort(*svc, id.Port)
 					if servicePort == nil {
@@ -852,19 +922,20 @@ func gatherPortMappingUsedByIngress(ings []v1.Ingress, svc *apiv1.Service, logge
 }
 
 // gatherIngressServiceKeys returns all service key (formatted as namespace/name) referenced in the ingress
-func gatherIngressServiceKeys(ing *v1.Ingress) sets.String {
+// or in the RouteConfig it references
+func gatherIngressServiceKeys(ing *v1.Ingress, routeConfigs []*routeconfigv1beta1.RouteConfig) sets.String {
 	set := sets.NewString()
 	if ing == nil {
 		return set
 	}
-	utils.TraverseIngressBackends(ing, func(id utils.ServicePortID) bool {
+	utils.TraverseIngressBackends(ing, routeConfigForIngress(routeConfigs, ing), func(id utils.ServicePortID) bool {
 		set.Insert(utils.ServiceKeyFunc(id.Service.Namespace, id.Service.Name))
 		return false
 	})
 	return set
 }
 
-func getIngressServicesFromStore(store cache.Store, svc *apiv1.Service) (ings []v1.Ingress) {
+func getIngressServicesFromStore(store cache.Store, routeConfigs []*routeconfigv1beta1.RouteConfig, svc *apiv1.Service) (ings []v1.Ingress) {
 	for _, m := range store.List() {
 		ing := *m.(*v1.Ingress)
 		if ing.Namespace != svc.Namespace {
@@ -872,7 +943,7 @@ func getIngressServicesFromStore(store cache.Store, svc *apiv1.Service) (ings []
 		}
 
 		if utils.IsGLBCIngress(&ing) {
-			utils.TraverseIngressBackends(&ing, func(id utils.ServicePortID) bool {
+			utils.TraverseIngressBackends(&ing, routeConfigForIngress(routeConfigs, &ing), func(id utils.ServicePortID) bool {
 				if id.Service.Name == svc.Name {
 					ings = append(ings, ing)
 					return true
@@ -41,6 +41,7 @@ import (
 	networkv1 "k8s.io/cloud-provider-gcp/crd/apis/network/v1"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/annotations"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/metrics"
 	"k8s.io/ingress-gce/pkg/neg/syncers/labels"
 	negtypes "k8s.io/ingress-gce/pkg/neg/types"
@@ -134,6 +135,7 @@ func newTestControllerWithParamsAndContext(kubeClient kubernetes.Interface, test
 		testContext.SvcNegInformer,
 		testContext.NetworkInformer,
 		testContext.GKENetworkParamSetInformer,
+		testContext.RouteConfigInformer,
 		func() bool { return true },
 		metrics.FakeControllerMetrics(),
 		testContext.L4Namer,
@@ -343,6 +345,68 @@ func TestEnableNEGServiceWithIngress(t *testing.T) {
 	validateServiceStateAnnotation(t, svc, svcPorts, controller.namer)
 }
 
+// TestNewNEGServiceWithRouteConfig tests that NEGs are created for the
+// weighted backends of the RouteConfig referenced by an ingress.
+func TestNewNEGServiceWithRouteConfig(t *testing.T) {
+	t.Parallel()
+
+	controller := newTestController(fake.NewSimpleClientset())
+	defer controller.stop()
+	controller.serviceLister.Add(newTestService(controller, true, []int32{}))
+	controller.ingressLister.Add(&networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "route-config-ingress",
+			Namespace:   testServiceNamespace,
+			Annotations: map[string]string{annotations.RouteConfigKey: "route-config"},
+		},
+		Spec: networkingv1.IngressSpec{
+			DefaultBackend: &networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: "other-service",
+					Port: networkingv1.ServiceBackendPort{Number: 80},
+				},
+			},
+		},
+	})
+	controller.routeConfigLister.Add(&routeconfigv1beta1.RouteConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route-config",
+			Namespace: testServiceNamespace,
+		},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			Rules: []routeconfigv1beta1.RouteRule{
+				{
+					WeightedBackends: []routeconfigv1beta1.WeightedServiceBackend{
+						{
+							ServiceBackend: routeconfigv1beta1.ServiceBackend{Name: testServiceName, Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}},
+							Weight:         90,
+						},
+						{
+							ServiceBackend: routeconfigv1beta1.ServiceBackend{Name: testServiceName, Port: routeconfigv1beta1.ServiceBackendPort{Number: 443}},
+							Weight:         10,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	svcKey := utils.ServiceKeyFunc(testServiceNamespace, testServiceName)
+	if err := controller.processService(svcKey); err != nil {
+		t.Fatalf("Failed to process service: %v", err)
+	}
+	validateSyncers(t, controller, 2, false)
+	svc, err := controller.client.CoreV1().Services(testServiceNamespace).Get(context.TODO(), testServiceName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Service was not created successfully, err: %v", err)
+	}
+	validateServiceStateAnnotation(t, svc, []int32{80, 443}, controller.namer)
+
+	if keys := gatherIngressServiceKeys(controller.ingressLister.List()[0].(*networkingv1.Ingress), controller.routeConfigs()); !keys.Has(svcKey) {
+		t.Errorf("gatherIngressServiceKeys() = %v, want it to contain %q", keys.List(), svcKey)
+	}
+}
+
 // TestEnableNEGSeviceWithL4ILB tests L4 ILB service with NEGs enabled.
 // Also verifies that modifying the TrafficPolicy on the service will
 // take effect.
@@ -657,7 +721,7 @@ func TestGatherPortMappingUsedByIngress(t *testing.T) {
 	for _, tc := range testCases {
 		controller := newTestController(fake.NewSimpleClientset())
 		defer controller.stop()
-		portTupleSet := gatherPortMappingUsedByIngress(tc.ings, newTestService(controller, true, []int32{}), klog.TODO())
+		portTupleSet := gatherPortMappingUsedByIngress(tc.ings, nil, newTestService(controller, true, []int32{}), klog.TODO())
 		if len(portTupleSet) != len(tc.expect) {
 			t.Errorf("For test case %q, expect %d ports, but got %d.", tc.desc, len(tc.expect), len(portTupleSet))
 		}
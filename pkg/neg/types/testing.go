@@ -31,6 +31,8 @@ import (
 	informernetwork "k8s.io/cloud-provider-gcp/crd/client/network/informers/externalversions/network/v1"
 	informergkenetworkparamset "k8s.io/cloud-provider-gcp/crd/client/network/informers/externalversions/network/v1alpha1"
 	"k8s.io/cloud-provider-gcp/providers/gce"
+	routeconfigfake "k8s.io/ingress-gce/pkg/routeconfig/client/clientset/versioned/fake"
+	informerrouteconfig "k8s.io/ingress-gce/pkg/routeconfig/client/informers/externalversions/routeconfig/v1beta1"
 	svcnegclient "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned"
 	negfake "k8s.io/ingress-gce/pkg/svcneg/client/clientset/versioned/fake"
 	informersvcneg "k8s.io/ingress-gce/pkg/svcneg/client/informers/externalversions/svcneg/v1beta1"
@@ -64,6 +66,7 @@ type TestContext struct {
 	SvcNegInformer             cache.SharedIndexInformer
 	NetworkInformer            cache.SharedIndexInformer
 	GKENetworkParamSetInformer cache.SharedIndexInformer
+	RouteConfigInformer        cache.SharedIndexInformer
 
 	KubeSystemUID      types.UID
 	ResyncPeriod       time.Duration
@@ -79,6 +82,7 @@ func NewTestContext() *TestContext {
 func NewTestContextWithKubeClient(kubeClient kubernetes.Interface) *TestContext {
 	negClient := negfake.NewSimpleClientset()
 	networkClient := netfake.NewSimpleClientset()
+	routeConfigClient := routeconfigfake.NewSimpleClientset()
 	fakeGCE := gce.NewFakeGCECloud(gce.DefaultTestClusterValues())
 	MockNetworkEndpointAPIs(fakeGCE)
 
@@ -100,6 +104,7 @@ func NewTestContextWithKubeClient(kubeClient kubernetes.Interface) *TestContext
 		SvcNegInformer:             informersvcneg.NewServiceNetworkEndpointGroupInformer(negClient, namespace, resyncPeriod, utils.NewNamespaceIndexer()),
 		NetworkInformer:            informernetwork.NewNetworkInformer(networkClient, resyncPeriod, utils.NewNamespaceIndexer()),
 		GKENetworkParamSetInformer: informergkenetworkparamset.NewGKENetworkParamSetInformer(networkClient, resyncPeriod, utils.NewNamespaceIndexer()),
+		RouteConfigInformer:        informerrouteconfig.NewRouteConfigInformer(routeConfigClient, namespace, resyncPeriod, utils.NewNamespaceIndexer()),
 		KubeSystemUID:              kubeSystemUID,
 		ResyncPeriod:               resyncPeriod,
 		NumGCWorkers:               numGCWorkers,
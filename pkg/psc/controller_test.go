@@ -1092,7 +1092,7 @@ func newTestController(clusterType string) *Controller {
 
 	flags.F.GKEClusterName = ClusterName
 	flags.F.GKEClusterType = clusterType
-	ctx := context.NewControllerContext(nil, kubeClient, nil, nil, nil, nil, nil, saClient, nil, gceClient, resourceNamer, kubeSystemUID, ctxConfig)
+	ctx := context.NewControllerContext(nil, kubeClient, nil, nil, nil, nil, nil, nil, saClient, nil, nil, nil, gceClient, resourceNamer, kubeSystemUID, ctxConfig)
 
 	return NewController(ctx)
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	v1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+	scheme "k8s.io/ingress-gce/pkg/routeconfig/client/clientset/versioned/scheme"
+)
+
+// RouteConfigsGetter has a method to return a RouteConfigInterface.
+// A group's client should implement this interface.
+type RouteConfigsGetter interface {
+	RouteConfigs(namespace string) RouteConfigInterface
+}
+
+// RouteConfigInterface has methods to work with RouteConfig resources.
+type RouteConfigInterface interface {
+	Create(ctx context.Context, routeConfig *v1beta1.RouteConfig, opts v1.CreateOptions) (*v1beta1.RouteConfig, error)
+	Update(ctx context.Context, routeConfig *v1beta1.RouteConfig, opts v1.UpdateOptions) (*v1beta1.RouteConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.RouteConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.RouteConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.RouteConfig, err error)
+	RouteConfigExpansion
+}
+
+// routeConfigs implements RouteConfigInterface
+type routeConfigs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRouteConfigs returns a RouteConfigs
+func newRouteConfigs(c *NetworkingV1beta1Client, namespace string) *routeConfigs {
+	return &routeConfigs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the routeConfig, and returns the corresponding routeConfig object, and an error if there is any.
+func (c *routeConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.RouteConfig, err error) {
+	result = &v1beta1.RouteConfig{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("routeconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RouteConfigs that match those selectors.
+func (c *routeConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.RouteConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.RouteConfigList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("routeconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested routeConfigs.
+func (c *routeConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("routeconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a routeConfig and creates it.  Returns the server's representation of the routeConfig, and an error, if there is any.
+func (c *routeConfigs) Create(ctx context.Context, routeConfig *v1beta1.RouteConfig, opts v1.CreateOptions) (result *v1beta1.RouteConfig, err error) {
+	result = &v1beta1.RouteConfig{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("routeconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(routeConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a routeConfig and updates it. Returns the server's representation of the routeConfig, and an error, if there is any.
+func (c *routeConfigs) Update(ctx context.Context, routeConfig *v1beta1.RouteConfig, opts v1.UpdateOptions) (result *v1beta1.RouteConfig, err error) {
+	result = &v1beta1.RouteConfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("routeconfigs").
+		Name(routeConfig.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(routeConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the routeConfig and deletes it. Returns an error if one occurs.
+func (c *routeConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("routeconfigs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *routeConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("routeconfigs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched routeConfig.
+func (c *routeConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.RouteConfig, err error) {
+	result = &v1beta1.RouteConfig{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("routeconfigs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routeconfig
+
+import (
+	"errors"
+
+	v1 "k8s.io/api/networking/v1"
+	"k8s.io/ingress-gce/pkg/annotations"
+	apisrouteconfig "k8s.io/ingress-gce/pkg/apis/routeconfig"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+	"k8s.io/ingress-gce/pkg/common/operator"
+	"k8s.io/ingress-gce/pkg/crd"
+)
+
+var (
+	ErrRouteConfigDoesNotExist = errors.New("no RouteConfig for Ingress exists.")
+)
+
+func CRDMeta() *crd.CRDMeta {
+	meta := crd.NewCRDMeta(
+		apisrouteconfig.GroupName,
+		"RouteConfig",
+		"RouteConfigList",
+		"routeconfig",
+		"routeconfigs",
+		[]*crd.Version{
+			crd.NewVersion("v1beta1", "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1.RouteConfig", routeconfigv1beta1.GetOpenAPIDefinitions, false),
+		},
+	)
+	return meta
+}
+
+// RouteConfigForIngress returns the corresponding RouteConfig for the given Ingress if one was specified.
+func RouteConfigForIngress(routeConfigs []*routeconfigv1beta1.RouteConfig, ing *v1.Ingress) (*routeconfigv1beta1.RouteConfig, error) {
+	routeConfigName := annotations.FromIngress(ing).RouteConfig()
+	if routeConfigName == "" {
+		// If the user did not provide the annotation at all, then we
+		// do not want to return an error.
+		return nil, nil
+	}
+
+	matches := operator.RouteConfigs(routeConfigs).ReferencedByIngress(ing).AsList()
+	if len(matches) == 0 {
+		return nil, ErrRouteConfigDoesNotExist
+	}
+
+	// Note: Theoretically, this list should never have more than 1 item. That
+	// would mean we have a bug somewhere in the operator or annotation processing.
+	return matches[0], nil
+}
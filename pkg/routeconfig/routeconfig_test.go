@@ -0,0 +1,51 @@
+package routeconfig
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/networking/v1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+	"k8s.io/ingress-gce/pkg/test"
+)
+
+func TestRouteConfigForIngress(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		ing      *v1.Ingress
+		expected *routeconfigv1beta1.RouteConfig
+		err      error
+	}{
+		{
+			desc:     "ingress with no route config annotation",
+			ing:      test.IngressWithoutRouteConfig,
+			expected: nil,
+			err:      nil,
+		},
+		{
+			desc:     "route config missing",
+			ing:      test.IngressWithOtherRouteConfig,
+			expected: nil,
+			err:      ErrRouteConfigDoesNotExist,
+		},
+		{
+			desc:     "ingress with route config that exists",
+			ing:      test.IngressWithRouteConfig,
+			expected: test.RouteConfig,
+			err:      nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := RouteConfigForIngress([]*routeconfigv1beta1.RouteConfig{test.RouteConfig}, tc.ing)
+			if result != tc.expected {
+				t.Fatalf("Expected result to be %v, got %v", tc.expected, result)
+			}
+			if err != tc.err {
+				t.Fatalf("Expected err to be %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routeconfig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+)
+
+// maxBackendWeight is the largest weight GCE accepts for a weighted backend service.
+const maxBackendWeight = 1000
+
+// maxHeaders is the largest number of headers GCE accepts in each list of a header action.
+const maxHeaders = 16
+
+// maxErrorContentPathLength is the longest path of custom error content GCE accepts.
+const maxErrorContentPathLength = 1024
+
+// maxPathPrefixRewriteLength is the longest path prefix rewrite GCE accepts.
+const maxPathPrefixRewriteLength = 1024
+
+// maxHostRewriteLength is the longest host rewrite GCE accepts.
+const maxHostRewriteLength = 255
+
+// Validate returns an error if the RouteConfig cannot be translated into
+// route rules of a GCE UrlMap.
+func Validate(routeConfig *routeconfigv1beta1.RouteConfig) error {
+	if routeConfig == nil {
+		return nil
+	}
+
+	for i, rule := range routeConfig.Spec.Rules {
+		if err := validateRule(rule); err != nil {
+			return fmt.Errorf("RouteConfig %s/%s: rule %d: %v", routeConfig.Namespace, routeConfig.Name, i, err)
+		}
+	}
+
+	type hostPath struct{ host, path string }
+	seen := make(map[hostPath]bool)
+	for i, ha := range routeConfig.Spec.HeaderActions {
+		key := hostPath{ha.Host, ha.Path}
+		if seen[key] {
+			return fmt.Errorf("RouteConfig %s/%s: header action %d: duplicate header action for host %q and path %q", routeConfig.Namespace, routeConfig.Name, i, ha.Host, ha.Path)
+		}
+		seen[key] = true
+		if ha.Path != "" && !strings.HasPrefix(ha.Path, "/") {
+			return fmt.Errorf("RouteConfig %s/%s: header action %d: path %q must begin with '/'", routeConfig.Namespace, routeConfig.Name, i, ha.Path)
+		}
+		if err := validateHeaderAction(&ha.HeaderAction); err != nil {
+			return fmt.Errorf("RouteConfig %s/%s: header action %d: %v", routeConfig.Namespace, routeConfig.Name, i, err)
+		}
+	}
+
+	seen = make(map[hostPath]bool)
+	for i, cp := range routeConfig.Spec.CustomErrorResponsePolicies {
+		key := hostPath{cp.Host, cp.Path}
+		if seen[key] {
+			return fmt.Errorf("RouteConfig %s/%s: custom error response policy %d: duplicate policy for host %q and path %q", routeConfig.Namespace, routeConfig.Name, i, cp.Host, cp.Path)
+		}
+		seen[key] = true
+		if cp.Path != "" && !strings.HasPrefix(cp.Path, "/") {
+			return fmt.Errorf("RouteConfig %s/%s: custom error response policy %d: path %q must begin with '/'", routeConfig.Namespace, routeConfig.Name, i, cp.Path)
+		}
+		if err := ValidateCustomErrorResponsePolicy(&cp.Policy, false); err != nil {
+			return fmt.Errorf("RouteConfig %s/%s: custom error response policy %d: %v", routeConfig.Namespace, routeConfig.Name, i, err)
+		}
+	}
+
+	seen = make(map[hostPath]bool)
+	for i, ur := range routeConfig.Spec.UrlRewrites {
+		key := hostPath{ur.Host, ur.Path}
+		if seen[key] {
+			return fmt.Errorf("RouteConfig %s/%s: url rewrite %d: duplicate url rewrite for host %q and path %q", routeConfig.Namespace, routeConfig.Name, i, ur.Host, ur.Path)
+		}
+		seen[key] = true
+		if !strings.HasPrefix(ur.Path, "/") {
+			return fmt.Errorf("RouteConfig %s/%s: url rewrite %d: path %q must begin with '/'", routeConfig.Namespace, routeConfig.Name, i, ur.Path)
+		}
+		if err := validateUrlRewrite(&ur.UrlRewrite); err != nil {
+			return fmt.Errorf("RouteConfig %s/%s: url rewrite %d: %v", routeConfig.Namespace, routeConfig.Name, i, err)
+		}
+	}
+
+	if err := validateUrlRewrite(routeConfig.Spec.DefaultUrlRewrite); err != nil {
+		return fmt.Errorf("RouteConfig %s/%s: default url rewrite: %v", routeConfig.Namespace, routeConfig.Name, err)
+	}
+	return nil
+}
+
+func validateUrlRewrite(urlRewrite *routeconfigv1beta1.UrlRewrite) error {
+	if urlRewrite == nil {
+		return nil
+	}
+	if urlRewrite.PathPrefixRewrite == "" && urlRewrite.HostRewrite == "" {
+		return fmt.Errorf("at least one of pathPrefixRewrite and hostRewrite must be set")
+	}
+	if pathPrefixRewrite := urlRewrite.PathPrefixRewrite; pathPrefixRewrite != "" {
+		if !strings.HasPrefix(pathPrefixRewrite, "/") {
+			return fmt.Errorf("path prefix rewrite %q must begin with '/'", pathPrefixRewrite)
+		}
+		if len(pathPrefixRewrite) > maxPathPrefixRewriteLength {
+			return fmt.Errorf("path prefix rewrite %q must be at most %d characters", pathPrefixRewrite, maxPathPrefixRewriteLength)
+		}
+	}
+	if hostRewrite := urlRewrite.HostRewrite; hostRewrite != "" {
+		if strings.ContainsAny(hostRewrite, "/ ") {
+			return fmt.Errorf("host rewrite %q must be a host name", hostRewrite)
+		}
+		if len(hostRewrite) > maxHostRewriteLength {
+			return fmt.Errorf("host rewrite %q must be at most %d characters", hostRewrite, maxHostRewriteLength)
+		}
+	}
+	return nil
+}
+
+// ValidateCustomErrorResponsePolicy returns an error if the policy cannot be
+// translated into a custom error response policy of a GCE UrlMap. The error
+// service can only be omitted by policies inheriting it from a higher level.
+func ValidateCustomErrorResponsePolicy(policy *routeconfigv1beta1.CustomErrorResponsePolicy, requireErrorService bool) error {
+	if policy == nil {
+		return nil
+	}
+	if requireErrorService && policy.ErrorService == "" {
+		return fmt.Errorf("errorService must be set")
+	}
+	switch policy.ErrorServiceKind {
+	case "", routeconfigv1beta1.ErrorServiceKindBackendBucket, routeconfigv1beta1.ErrorServiceKindBackendService:
+	default:
+		return fmt.Errorf("errorServiceKind %q must be %s or %s", policy.ErrorServiceKind, routeconfigv1beta1.ErrorServiceKindBackendBucket, routeconfigv1beta1.ErrorServiceKindBackendService)
+	}
+	if policy.ErrorServiceKind != "" && policy.ErrorService == "" {
+		return fmt.Errorf("errorServiceKind must not be set without errorService")
+	}
+	if len(policy.ErrorResponseRules) == 0 {
+		return fmt.Errorf("at least one error response rule must be set")
+	}
+
+	seenCodes := make(map[string]bool)
+	for _, rule := range policy.ErrorResponseRules {
+		if len(rule.MatchResponseCodes) == 0 {
+			return fmt.Errorf("at least one response code must be matched")
+		}
+		for _, code := range rule.MatchResponseCodes {
+			if !validErrorResponseCode(code) {
+				return fmt.Errorf("response code %q must be between 400 and 599, 4xx or 5xx", code)
+			}
+			if seenCodes[code] {
+				return fmt.Errorf("response code %q is matched more than once", code)
+			}
+			seenCodes[code] = true
+		}
+		if !strings.HasPrefix(rule.Path, "/") || (len(rule.Path) > 1 && strings.HasSuffix(rule.Path, "/")) {
+			return fmt.Errorf("path %q must begin with '/' and must not end with '/'", rule.Path)
+		}
+		if len(rule.Path) > maxErrorContentPathLength {
+			return fmt.Errorf("path %q must be at most %d characters", rule.Path, maxErrorContentPathLength)
+		}
+		if rule.OverrideResponseCode != 0 && (rule.OverrideResponseCode < 100 || rule.OverrideResponseCode > 599) {
+			return fmt.Errorf("override response code %d must be between 100 and 599", rule.OverrideResponseCode)
+		}
+	}
+	return nil
+}
+
+// validErrorResponseCode returns true if code is a response code between 400
+// and 599 or one of the classes 4xx and 5xx.
+func validErrorResponseCode(code string) bool {
+	if code == "4xx" || code == "5xx" {
+		return true
+	}
+	n, err := strconv.Atoi(code)
+	return err == nil && n >= 400 && n <= 599 && strconv.Itoa(n) == code
+}
+
+func validateRule(rule routeconfigv1beta1.RouteRule) error {
+	if (rule.Backend.Name == "") == (len(rule.WeightedBackends) == 0) {
+		return fmt.Errorf("exactly one of backend and weightedBackends must be set")
+	}
+	if rule.Backend.Name != "" {
+		if err := validateBackend(rule.Backend); err != nil {
+			return err
+		}
+	}
+	for _, backend := range rule.WeightedBackends {
+		if err := validateBackend(backend.ServiceBackend); err != nil {
+			return err
+		}
+		if backend.Weight < 0 || backend.Weight > maxBackendWeight {
+			return fmt.Errorf("weight %d of backend %q must be between 0 and %d", backend.Weight, backend.Name, maxBackendWeight)
+		}
+	}
+	if len(rule.Matches) == 0 {
+		return fmt.Errorf("at least one match must be set")
+	}
+
+	for _, match := range rule.Matches {
+		if err := validateMatch(match); err != nil {
+			return err
+		}
+	}
+	if err := validateUrlRewrite(rule.UrlRewrite); err != nil {
+		return err
+	}
+	return validateHeaderAction(rule.HeaderAction)
+}
+
+func validateHeaderAction(headerAction *routeconfigv1beta1.HeaderAction) error {
+	if headerAction == nil {
+		return nil
+	}
+	for _, headers := range [][]routeconfigv1beta1.HeaderOption{headerAction.RequestHeadersToAdd, headerAction.ResponseHeadersToAdd} {
+		if len(headers) > maxHeaders {
+			return fmt.Errorf("at most %d headers can be added, got %d", maxHeaders, len(headers))
+		}
+		for _, header := range headers {
+			if header.Name == "" {
+				return fmt.Errorf("name of header to add must be set")
+			}
+		}
+	}
+	for _, headers := range [][]string{headerAction.RequestHeadersToRemove, headerAction.ResponseHeadersToRemove} {
+		if len(headers) > maxHeaders {
+			return fmt.Errorf("at most %d headers can be removed, got %d", maxHeaders, len(headers))
+		}
+		for _, header := range headers {
+			if header == "" {
+				return fmt.Errorf("name of header to remove must be set")
+			}
+		}
+	}
+	return nil
+}
+
+func validateBackend(backend routeconfigv1beta1.ServiceBackend) error {
+	if backend.Name == "" {
+		return fmt.Errorf("backend service name must be set")
+	}
+	if (backend.Port.Name == "") == (backend.Port.Number == 0) {
+		return fmt.Errorf("exactly one of port name and number must be set for backend %q", backend.Name)
+	}
+	return nil
+}
+
+func validateMatch(match routeconfigv1beta1.RouteMatch) error {
+	if match.PrefixMatch != "" && match.FullPathMatch != "" {
+		return fmt.Errorf("only one of prefixMatch and fullPathMatch can be set")
+	}
+	for _, path := range []string{match.PrefixMatch, match.FullPathMatch} {
+		if path != "" && !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("path %q must begin with '/'", path)
+		}
+	}
+
+	for _, header := range match.Headers {
+		if header.Name == "" {
+			return fmt.Errorf("header match name must be set")
+		}
+		if n := countSet(header.ExactMatch != "", header.PrefixMatch != "", header.SuffixMatch != "", header.RegexMatch != "", header.PresentMatch); n != 1 {
+			return fmt.Errorf("header match %q must set exactly one of exactMatch, prefixMatch, suffixMatch, regexMatch and presentMatch, got %d", header.Name, n)
+		}
+	}
+
+	for _, param := range match.QueryParameters {
+		if param.Name == "" {
+			return fmt.Errorf("query parameter match name must be set")
+		}
+		if n := countSet(param.ExactMatch != "", param.RegexMatch != "", param.PresentMatch); n != 1 {
+			return fmt.Errorf("query parameter match %q must set exactly one of exactMatch, regexMatch and presentMatch, got %d", param.Name, n)
+		}
+	}
+	return nil
+}
+
+func countSet(values ...bool) int {
+	n := 0
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,469 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routeconfig
+
+import (
+	"strings"
+	"testing"
+
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	backend := routeconfigv1beta1.ServiceBackend{Name: "svc", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}}
+
+	testCases := []struct {
+		desc        string
+		rules       []routeconfigv1beta1.RouteRule
+		expectError bool
+	}{
+		{
+			desc: "header and query parameter match",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches: []routeconfigv1beta1.RouteMatch{
+						{
+							PrefixMatch:     "/",
+							Headers:         []routeconfigv1beta1.HeaderMatch{{Name: "x-canary", ExactMatch: "true"}},
+							QueryParameters: []routeconfigv1beta1.QueryParameterMatch{{Name: "version", ExactMatch: "v2"}},
+						},
+					},
+					Backend: backend,
+				},
+			},
+		},
+		{
+			desc: "weighted backends",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+					WeightedBackends: []routeconfigv1beta1.WeightedServiceBackend{
+						{ServiceBackend: backend, Weight: 90},
+						{ServiceBackend: routeconfigv1beta1.ServiceBackend{Name: "green", Port: routeconfigv1beta1.ServiceBackendPort{Name: "http"}}, Weight: 10},
+					},
+				},
+			},
+		},
+		{
+			desc: "backend and weighted backends",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches:          []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+					Backend:          backend,
+					WeightedBackends: []routeconfigv1beta1.WeightedServiceBackend{{ServiceBackend: backend, Weight: 100}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "no backend",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "weight out of range",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches:          []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+					WeightedBackends: []routeconfigv1beta1.WeightedServiceBackend{{ServiceBackend: backend, Weight: 1001}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "no matches",
+			rules: []routeconfigv1beta1.RouteRule{
+				{Backend: backend},
+			},
+			expectError: true,
+		},
+		{
+			desc: "missing backend port",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+					Backend: routeconfigv1beta1.ServiceBackend{Name: "svc"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "prefix and full path match",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/", FullPathMatch: "/foo"}},
+					Backend: backend,
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "relative path",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "foo"}},
+					Backend: backend,
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "header match without value",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches: []routeconfigv1beta1.RouteMatch{{Headers: []routeconfigv1beta1.HeaderMatch{{Name: "x-canary"}}}},
+					Backend: backend,
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "query parameter match with two values",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches: []routeconfigv1beta1.RouteMatch{{QueryParameters: []routeconfigv1beta1.QueryParameterMatch{{Name: "version", ExactMatch: "v2", PresentMatch: true}}}},
+					Backend: backend,
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			routeConfig := &routeconfigv1beta1.RouteConfig{Spec: routeconfigv1beta1.RouteConfigSpec{Rules: tc.rules}}
+			err := Validate(routeConfig)
+			if gotErr := err != nil; gotErr != tc.expectError {
+				t.Errorf("Validate() = %v, want error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateHeaderActions(t *testing.T) {
+	t.Parallel()
+
+	headerAction := routeconfigv1beta1.HeaderAction{
+		RequestHeadersToAdd:     []routeconfigv1beta1.HeaderOption{{Name: "x-client-region", Value: "{client_region}"}},
+		ResponseHeadersToRemove: []string{"server"},
+	}
+
+	testCases := []struct {
+		desc          string
+		headerActions []routeconfigv1beta1.HeaderActionRule
+		rules         []routeconfigv1beta1.RouteRule
+		expectError   bool
+	}{
+		{
+			desc: "host and path header actions",
+			headerActions: []routeconfigv1beta1.HeaderActionRule{
+				{Host: "foo.com", HeaderAction: headerAction},
+				{Host: "foo.com", Path: "/api", HeaderAction: headerAction},
+			},
+		},
+		{
+			desc: "route rule header action",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches:      []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+					Backend:      routeconfigv1beta1.ServiceBackend{Name: "svc", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}},
+					HeaderAction: &headerAction,
+				},
+			},
+		},
+		{
+			desc: "duplicate host and path",
+			headerActions: []routeconfigv1beta1.HeaderActionRule{
+				{Host: "foo.com", Path: "/api", HeaderAction: headerAction},
+				{Host: "foo.com", Path: "/api", HeaderAction: headerAction},
+			},
+			expectError: true,
+		},
+		{
+			desc: "relative path",
+			headerActions: []routeconfigv1beta1.HeaderActionRule{
+				{Host: "foo.com", Path: "api", HeaderAction: headerAction},
+			},
+			expectError: true,
+		},
+		{
+			desc: "header to add without name",
+			headerActions: []routeconfigv1beta1.HeaderActionRule{
+				{HeaderAction: routeconfigv1beta1.HeaderAction{ResponseHeadersToAdd: []routeconfigv1beta1.HeaderOption{{Value: "bar"}}}},
+			},
+			expectError: true,
+		},
+		{
+			desc: "empty header to remove",
+			rules: []routeconfigv1beta1.RouteRule{
+				{
+					Matches:      []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+					Backend:      routeconfigv1beta1.ServiceBackend{Name: "svc", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}},
+					HeaderAction: &routeconfigv1beta1.HeaderAction{RequestHeadersToRemove: []string{""}},
+				},
+			},
+			expectError: true,
+		},
+		{
+			desc: "too many headers to remove",
+			headerActions: []routeconfigv1beta1.HeaderActionRule{
+				{HeaderAction: routeconfigv1beta1.HeaderAction{RequestHeadersToRemove: make([]string, maxHeaders+1)}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			routeConfig := &routeconfigv1beta1.RouteConfig{Spec: routeconfigv1beta1.RouteConfigSpec{Rules: tc.rules, HeaderActions: tc.headerActions}}
+			err := Validate(routeConfig)
+			if gotErr := err != nil; gotErr != tc.expectError {
+				t.Errorf("Validate() = %v, want error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateCustomErrorResponsePolicies(t *testing.T) {
+	t.Parallel()
+
+	policy := routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorService: "error-pages",
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{
+			{MatchResponseCodes: []string{"404"}, Path: "/errors/404.html", OverrideResponseCode: 404},
+			{MatchResponseCodes: []string{"4xx", "5xx"}, Path: "/errors/error.html"},
+		},
+	}
+
+	testCases := []struct {
+		desc        string
+		policies    []routeconfigv1beta1.CustomErrorResponsePolicyRule
+		expectError bool
+	}{
+		{
+			desc: "host and path policies",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Host: "foo.com", Policy: policy},
+				{Host: "foo.com", Path: "/api", Policy: policy},
+			},
+		},
+		{
+			desc: "policy inheriting the error service",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Policy: routeconfigv1beta1.CustomErrorResponsePolicy{
+					ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"503"}, Path: "/maintenance.html"}},
+				}},
+			},
+		},
+		{
+			desc: "backend service error service",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Policy: routeconfigv1beta1.CustomErrorResponsePolicy{
+					ErrorService:       "error-backend",
+					ErrorServiceKind:   routeconfigv1beta1.ErrorServiceKindBackendService,
+					ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"503"}, Path: "/maintenance.html"}},
+				}},
+			},
+		},
+		{
+			desc: "unknown error service kind",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Policy: routeconfigv1beta1.CustomErrorResponsePolicy{
+					ErrorService:       "error-pages",
+					ErrorServiceKind:   "Bucket",
+					ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"503"}, Path: "/maintenance.html"}},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			desc: "error service kind without error service",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Policy: routeconfigv1beta1.CustomErrorResponsePolicy{
+					ErrorServiceKind:   routeconfigv1beta1.ErrorServiceKindBackendService,
+					ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"503"}, Path: "/maintenance.html"}},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			desc: "duplicate host and path",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Host: "foo.com", Path: "/api", Policy: policy},
+				{Host: "foo.com", Path: "/api", Policy: policy},
+			},
+			expectError: true,
+		},
+		{
+			desc: "relative path",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Host: "foo.com", Path: "api", Policy: policy},
+			},
+			expectError: true,
+		},
+		{
+			desc: "no error response rules",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Policy: routeconfigv1beta1.CustomErrorResponsePolicy{ErrorService: "error-pages"}},
+			},
+			expectError: true,
+		},
+		{
+			desc: "invalid response code",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Policy: routeconfigv1beta1.CustomErrorResponsePolicy{
+					ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"302"}, Path: "/error.html"}},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			desc: "response code matched twice",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Policy: routeconfigv1beta1.CustomErrorResponsePolicy{
+					ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{
+						{MatchResponseCodes: []string{"5xx"}, Path: "/error.html"},
+						{MatchResponseCodes: []string{"5xx"}, Path: "/other.html"},
+					},
+				}},
+			},
+			expectError: true,
+		},
+		{
+			desc: "error content path with trailing slash",
+			policies: []routeconfigv1beta1.CustomErrorResponsePolicyRule{
+				{Policy: routeconfigv1beta1.CustomErrorResponsePolicy{
+					ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"404"}, Path: "/errors/"}},
+				}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			routeConfig := &routeconfigv1beta1.RouteConfig{Spec: routeconfigv1beta1.RouteConfigSpec{CustomErrorResponsePolicies: tc.policies}}
+			err := Validate(routeConfig)
+			if gotErr := err != nil; gotErr != tc.expectError {
+				t.Errorf("Validate() = %v, want error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateUrlRewrites(t *testing.T) {
+	t.Parallel()
+
+	backend := routeconfigv1beta1.ServiceBackend{Name: "svc", Port: routeconfigv1beta1.ServiceBackendPort{Number: 80}}
+	matches := []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/app1/"}}
+
+	testCases := []struct {
+		desc        string
+		spec        routeconfigv1beta1.RouteConfigSpec
+		expectError bool
+	}{
+		{
+			desc: "path, rule and default backend url rewrites",
+			spec: routeconfigv1beta1.RouteConfigSpec{
+				UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+					{Host: "foo.com", Path: "/app1/*", UrlRewrite: routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/"}},
+					{Path: "/app2/*", UrlRewrite: routeconfigv1beta1.UrlRewrite{HostRewrite: "legacy.example.com"}},
+				},
+				Rules:             []routeconfigv1beta1.RouteRule{{Matches: matches, Backend: backend, UrlRewrite: &routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/"}}},
+				DefaultUrlRewrite: &routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/default/"},
+			},
+		},
+		{
+			desc: "duplicate host and path",
+			spec: routeconfigv1beta1.RouteConfigSpec{UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+				{Host: "foo.com", Path: "/app1/*", UrlRewrite: routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/"}},
+				{Host: "foo.com", Path: "/app1/*", UrlRewrite: routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/v2/"}},
+			}},
+			expectError: true,
+		},
+		{
+			desc: "missing path",
+			spec: routeconfigv1beta1.RouteConfigSpec{UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+				{Host: "foo.com", UrlRewrite: routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/"}},
+			}},
+			expectError: true,
+		},
+		{
+			desc: "empty url rewrite",
+			spec: routeconfigv1beta1.RouteConfigSpec{UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+				{Path: "/app1/*"},
+			}},
+			expectError: true,
+		},
+		{
+			desc: "relative path prefix rewrite",
+			spec: routeconfigv1beta1.RouteConfigSpec{UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+				{Path: "/app1/*", UrlRewrite: routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "app1/"}},
+			}},
+			expectError: true,
+		},
+		{
+			desc: "path prefix rewrite too long",
+			spec: routeconfigv1beta1.RouteConfigSpec{UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+				{Path: "/app1/*", UrlRewrite: routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/" + strings.Repeat("a", 1024)}},
+			}},
+			expectError: true,
+		},
+		{
+			desc: "host rewrite with path",
+			spec: routeconfigv1beta1.RouteConfigSpec{UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+				{Path: "/app1/*", UrlRewrite: routeconfigv1beta1.UrlRewrite{HostRewrite: "legacy.example.com/app1"}},
+			}},
+			expectError: true,
+		},
+		{
+			desc: "host rewrite too long",
+			spec: routeconfigv1beta1.RouteConfigSpec{UrlRewrites: []routeconfigv1beta1.UrlRewriteRule{
+				{Path: "/app1/*", UrlRewrite: routeconfigv1beta1.UrlRewrite{HostRewrite: strings.Repeat("a", 256)}},
+			}},
+			expectError: true,
+		},
+		{
+			desc: "invalid rule url rewrite",
+			spec: routeconfigv1beta1.RouteConfigSpec{
+				Rules: []routeconfigv1beta1.RouteRule{{Matches: matches, Backend: backend, UrlRewrite: &routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "app1/"}}},
+			},
+			expectError: true,
+		},
+		{
+			desc:        "invalid default url rewrite",
+			spec:        routeconfigv1beta1.RouteConfigSpec{DefaultUrlRewrite: &routeconfigv1beta1.UrlRewrite{}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			routeConfig := &routeconfigv1beta1.RouteConfig{Spec: tc.spec}
+			err := Validate(routeConfig)
+			if gotErr := err != nil; gotErr != tc.expectError {
+				t.Errorf("Validate() = %v, want error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
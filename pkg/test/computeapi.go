@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/cloud-provider-gcp/providers/gce"
+)
+
+// FakeComputeAPI serves the GA compute API methods which are not wrapped by
+// the cloud provider, such as setting the QUIC override of a target https
+// proxy, from the objects of the mock of a fake GCE cloud. Operations are
+// done as soon as they are started, unless they are overridden with
+// SetOperation.
+type FakeComputeAPI struct {
+	mock *cloud.MockGCE
+
+	lock sync.Mutex
+	// invalidations are the cache invalidation rules sent per url map.
+	invalidations map[string][]*compute.CacheInvalidationRule
+	operations    int
+	// operationOverrides are returned instead of done operations by name.
+	operationOverrides map[string]*compute.Operation
+}
+
+// NewFakeComputeAPI starts a FakeComputeAPI for the fake GCE cloud and points
+// the GA compute service of the cloud to it. The server is closed when the test
+// ends.
+func NewFakeComputeAPI(t *testing.T, fakeGCE *gce.Cloud) *FakeComputeAPI {
+	api := &FakeComputeAPI{
+		mock:               fakeGCE.Compute().(*cloud.MockGCE),
+		invalidations:      map[string][]*compute.CacheInvalidationRule{},
+		operationOverrides: map[string]*compute.Operation{},
+	}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	fakeGCE.ComputeServices().GA.BasePath = server.URL + "/compute/v1/"
+	return api
+}
+
+// Invalidations returns the cache invalidation rules sent for the url map.
+func (api *FakeComputeAPI) Invalidations(urlMap string) []*compute.CacheInvalidationRule {
+	api.lock.Lock()
+	defer api.lock.Unlock()
+	return api.invalidations[urlMap]
+}
+
+// SetOperation overrides the global operation with the name of op, for
+// example to keep it running or to make it fail. Operations are named
+// operation-1, operation-2, ... in the order they are started.
+func (api *FakeComputeAPI) SetOperation(op *compute.Operation) {
+	api.lock.Lock()
+	defer api.lock.Unlock()
+	api.operationOverrides[op.Name] = op
+}
+
+// ServeHTTP serves paths of the form
+// /compute/v1/projects/<project>/global/<collection>/<name>[/<method>].
+func (api *FakeComputeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/compute/v1/"), "/")
+	if len(parts) < 4 || parts[0] != "projects" || parts[2] != "global" {
+		http.NotFound(w, r)
+		return
+	}
+	collection, name, method := parts[3], "", ""
+	if len(parts) > 4 {
+		name = parts[4]
+	}
+	if len(parts) > 5 {
+		method = parts[5]
+	}
+
+	var resp interface{}
+	var err error
+	switch {
+	case collection == "targetHttpsProxies" && method == "" && r.Method == http.MethodGet:
+		resp, err = api.getTargetHttpsProxy(name)
+	case collection == "targetHttpsProxies" && method == "" && r.Method == http.MethodPatch:
+		err = api.patchTargetHttpsProxy(name, r.Body)
+	case collection == "targetHttpsProxies" && method == "setQuicOverride":
+		err = api.setQuicOverride(name, r.Body)
+	case collection == "backendServices" && method == "setEdgeSecurityPolicy":
+		err = api.setEdgeSecurityPolicy(name, r.Body)
+	case collection == "urlMaps" && method == "invalidateCache":
+		err = api.invalidateCache(name, r.Body)
+	case collection == "operations":
+		resp = api.getOperation(name)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		// The error body is parsed by googleapi.CheckResponse.
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]interface{}{"code": http.StatusNotFound, "message": err.Error()}})
+		return
+	}
+	if resp == nil {
+		resp = api.newOperation()
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (api *FakeComputeAPI) newOperation() *compute.Operation {
+	api.lock.Lock()
+	defer api.lock.Unlock()
+	api.operations++
+	return &compute.Operation{Name: fmt.Sprintf("operation-%d", api.operations), Status: "DONE"}
+}
+
+func (api *FakeComputeAPI) getOperation(name string) *compute.Operation {
+	api.lock.Lock()
+	defer api.lock.Unlock()
+	if op, ok := api.operationOverrides[name]; ok {
+		return op
+	}
+	return &compute.Operation{Name: name, Status: "DONE"}
+}
+
+func (api *FakeComputeAPI) getTargetHttpsProxy(name string) (*compute.TargetHttpsProxy, error) {
+	m := api.mock.MockTargetHttpsProxies
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	obj, ok := m.Objects[*meta.GlobalKey(name)]
+	if !ok {
+		return nil, fmt.Errorf("target https proxy %s not found", name)
+	}
+	return obj.ToGA(), nil
+}
+
+// patchTargetHttpsProxy merges the fields of the patch into the proxy. Fields
+// which are null in the patch are cleared.
+func (api *FakeComputeAPI) patchTargetHttpsProxy(name string, body io.Reader) error {
+	m := api.mock.MockTargetHttpsProxies
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	obj, ok := m.Objects[*meta.GlobalKey(name)]
+	if !ok {
+		return fmt.Errorf("target https proxy %s not found", name)
+	}
+	proxy := &compute.TargetHttpsProxy{}
+	if err := mergePatch(proxy, obj.ToGA(), body); err != nil {
+		return err
+	}
+	obj.Obj = proxy
+	return nil
+}
+
+func (api *FakeComputeAPI) setQuicOverride(name string, body io.Reader) error {
+	req := &compute.TargetHttpsProxiesSetQuicOverrideRequest{}
+	if err := json.NewDecoder(body).Decode(req); err != nil {
+		return err
+	}
+	m := api.mock.MockTargetHttpsProxies
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	obj, ok := m.Objects[*meta.GlobalKey(name)]
+	if !ok {
+		return fmt.Errorf("target https proxy %s not found", name)
+	}
+	proxy := obj.ToGA()
+	proxy.QuicOverride = req.QuicOverride
+	obj.Obj = proxy
+	return nil
+}
+
+func (api *FakeComputeAPI) setEdgeSecurityPolicy(name string, body io.Reader) error {
+	ref := &compute.SecurityPolicyReference{}
+	if err := json.NewDecoder(body).Decode(ref); err != nil {
+		return err
+	}
+	m := api.mock.MockBackendServices
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	obj, ok := m.Objects[*meta.GlobalKey(name)]
+	if !ok {
+		return fmt.Errorf("backend service %s not found", name)
+	}
+	bs := obj.ToGA()
+	bs.EdgeSecurityPolicy = ref.SecurityPolicy
+	obj.Obj = bs
+	return nil
+}
+
+func (api *FakeComputeAPI) invalidateCache(name string, body io.Reader) error {
+	rule := &compute.CacheInvalidationRule{}
+	if err := json.NewDecoder(body).Decode(rule); err != nil {
+		return err
+	}
+	m := api.mock.MockUrlMaps
+	m.Lock.Lock()
+	_, ok := m.Objects[*meta.GlobalKey(name)]
+	m.Lock.Unlock()
+	if !ok {
+		return fmt.Errorf("url map %s not found", name)
+	}
+	api.lock.Lock()
+	defer api.lock.Unlock()
+	api.invalidations[name] = append(api.invalidations[name], rule)
+	return nil
+}
+
+// mergePatch stores into dst the JSON merge of the patch read from body into
+// current.
+func mergePatch(dst, current interface{}, body io.Reader) error {
+	data, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	patch := map[string]interface{}{}
+	if err := json.NewDecoder(body).Decode(&patch); err != nil {
+		return err
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(fields, k)
+			continue
+		}
+		fields[k] = v
+	}
+	if data, err = json.Marshal(fields); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
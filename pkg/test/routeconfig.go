@@ -0,0 +1,57 @@
+package test
+
+import (
+	v1 "k8s.io/api/networking/v1"
+	"k8s.io/ingress-gce/pkg/annotations"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
+)
+
+// The below vars are used for sharing unit testing types with multiple packages.
+var (
+	RouteConfig = &routeconfigv1beta1.RouteConfig{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "config-test",
+			Namespace: "test",
+		},
+	}
+
+	IngressWithoutRouteConfig = &v1.Ingress{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "ing-no-config",
+			Namespace: "test",
+		},
+	}
+
+	IngressWithRouteConfig = &v1.Ingress{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "ing-with-config",
+			Namespace: "test",
+			Annotations: map[string]string{
+				annotations.RouteConfigKey: "config-test",
+			},
+		},
+	}
+
+	IngressWithRouteConfigOtherNamespace = &v1.Ingress{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "ing-with-config",
+			Namespace: "other-namespace",
+			Annotations: map[string]string{
+				annotations.RouteConfigKey: "config-test",
+			},
+		},
+	}
+
+	IngressWithOtherRouteConfig = &v1.Ingress{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "ing-with-config",
+			Namespace: "test",
+			Annotations: map[string]string{
+				annotations.RouteConfigKey: "other-config",
+			},
+		},
+	}
+)
@@ -103,6 +103,10 @@ type HealthCheck struct {
 	computealpha.HTTPHealthCheck
 	computealpha.HealthCheck
 
+	// GrpcServiceName is the service name of GRPC health checks. The port
+	// settings of GRPC health checks are maintained in HTTPHealthCheck.
+	GrpcServiceName string
+
 	Service         *v1.Service
 	healthcheckInfo healthcheck.HealthcheckInfo
 }
@@ -143,6 +147,16 @@ func NewHealthCheck(hc *computealpha.HealthCheck) (*HealthCheck, error) {
 			return nil, fmt.Errorf(newHealthCheckErrorMessageTemplate, annotations.ProtocolHTTP2, hc.Name)
 		}
 		v.HTTPHealthCheck = computealpha.HTTPHealthCheck(*hc.Http2HealthCheck)
+	case annotations.ProtocolGRPC:
+		if hc.GrpcHealthCheck == nil {
+			return nil, fmt.Errorf(newHealthCheckErrorMessageTemplate, annotations.ProtocolGRPC, hc.Name)
+		}
+		v.HTTPHealthCheck = computealpha.HTTPHealthCheck{
+			Port:              hc.GrpcHealthCheck.Port,
+			PortName:          hc.GrpcHealthCheck.PortName,
+			PortSpecification: hc.GrpcHealthCheck.PortSpecification,
+		}
+		v.GrpcServiceName = hc.GrpcHealthCheck.GrpcServiceName
 	}
 
 	// Users should be modifying HTTP(S) specific settings on the embedded
@@ -150,6 +164,7 @@ func NewHealthCheck(hc *computealpha.HealthCheck) (*HealthCheck, error) {
 	v.HealthCheck.HttpHealthCheck = nil
 	v.HealthCheck.HttpsHealthCheck = nil
 	v.HealthCheck.Http2HealthCheck = nil
+	v.HealthCheck.GrpcHealthCheck = nil
 
 	return v, nil
 }
@@ -196,6 +211,7 @@ func (hc *HealthCheck) merge() error {
 	hc.HealthCheck.Http2HealthCheck = nil
 	hc.HealthCheck.HttpsHealthCheck = nil
 	hc.HealthCheck.HttpHealthCheck = nil
+	hc.HealthCheck.GrpcHealthCheck = nil
 
 	switch hc.Protocol() {
 	case annotations.ProtocolHTTP:
@@ -207,9 +223,17 @@ func (hc *HealthCheck) merge() error {
 	case annotations.ProtocolHTTP2:
 		http2 := computealpha.HTTP2HealthCheck(hc.HTTPHealthCheck)
 		hc.HealthCheck.Http2HealthCheck = &http2
+	case annotations.ProtocolGRPC:
+		// Only the port settings of HTTPHealthCheck apply to GRPC.
+		hc.HealthCheck.GrpcHealthCheck = &computealpha.GRPCHealthCheck{
+			GrpcServiceName:   hc.GrpcServiceName,
+			Port:              hc.Port,
+			PortName:          hc.PortName,
+			PortSpecification: hc.PortSpecification,
+		}
 	default:
-		return fmt.Errorf("Protocol %q is not valid, must be one of [%q,%q,%q]",
-			hc.Protocol(), annotations.ProtocolHTTP, annotations.ProtocolHTTPS, annotations.ProtocolHTTP2,
+		return fmt.Errorf("Protocol %q is not valid, must be one of [%q,%q,%q,%q]",
+			hc.Protocol(), annotations.ProtocolHTTP, annotations.ProtocolHTTPS, annotations.ProtocolHTTP2, annotations.ProtocolGRPC,
 		)
 	}
 	return nil
@@ -261,6 +285,18 @@ func (hc *HealthCheck) UpdateFromBackendConfig(c *backendconfigv1.HealthCheckCon
 	if c.RequestPath != nil {
 		hc.RequestPath = *c.RequestPath
 	}
+	if c.Host != nil {
+		hc.Host = *c.Host
+	}
+	if c.Response != nil {
+		hc.Response = *c.Response
+	}
+	if c.ProxyHeader != nil {
+		hc.ProxyHeader = *c.ProxyHeader
+	}
+	if c.GrpcServiceName != nil {
+		hc.GrpcServiceName = *c.GrpcServiceName
+	}
 	if c.Port != nil {
 		hc.Port = *c.Port
 		// This override is necessary regardless of type
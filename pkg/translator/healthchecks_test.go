@@ -23,6 +23,7 @@ import (
 	"github.com/kr/pretty"
 	computealpha "google.golang.org/api/compute/v0.alpha"
 	"k8s.io/ingress-gce/pkg/annotations"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	"k8s.io/ingress-gce/pkg/utils/healthcheck"
 )
 
@@ -44,6 +45,10 @@ func TestMerge(t *testing.T) {
 			desc: "HTTPS",
 			hc:   &HealthCheck{HealthCheck: computealpha.HealthCheck{Type: "HTTPS"}},
 		},
+		{
+			desc: "GRPC",
+			hc:   &HealthCheck{HealthCheck: computealpha.HealthCheck{Type: "GRPC"}, GrpcServiceName: "foo.Service"},
+		},
 		{
 			desc:    "Malformed Protocol",
 			hc:      &HealthCheck{HealthCheck: computealpha.HealthCheck{Type: "http"}},
@@ -76,6 +81,12 @@ func TestMerge(t *testing.T) {
 				if tc.hc.HttpHealthCheck != nil || tc.hc.HttpsHealthCheck != nil || tc.hc.Http2HealthCheck == nil {
 					t.Errorf("Invalid HC %v for protocol %q", tc.hc, annotations.ProtocolHTTP2)
 				}
+			case annotations.ProtocolGRPC:
+				if tc.hc.HttpHealthCheck != nil || tc.hc.HttpsHealthCheck != nil || tc.hc.Http2HealthCheck != nil || tc.hc.HealthCheck.GrpcHealthCheck == nil {
+					t.Errorf("Invalid HC %v for protocol %q", tc.hc, annotations.ProtocolGRPC)
+				} else if tc.hc.HealthCheck.GrpcHealthCheck.GrpcServiceName != tc.hc.GrpcServiceName {
+					t.Errorf("GrpcServiceName = %q, want %q", tc.hc.HealthCheck.GrpcHealthCheck.GrpcServiceName, tc.hc.GrpcServiceName)
+				}
 			}
 
 			// Verify port spec
@@ -117,3 +128,45 @@ func TestOverwriteWithTHC(t *testing.T) {
 		t.Fatalf("Translate healthcheck is:\n%s, want:\n%s", pretty.Sprint(hc), pretty.Sprint(wantHC))
 	}
 }
+
+func TestUpdateFromBackendConfig(t *testing.T) {
+	s := func(s string) *string { return &s }
+
+	hc := DefaultHealthCheck(8080, annotations.ProtocolHTTP)
+	hc.UpdateFromBackendConfig(&backendconfigv1.HealthCheckConfig{
+		Host:        s("foo.com"),
+		Response:    s("OK"),
+		ProxyHeader: s("PROXY_V1"),
+	})
+	alphaHC, err := hc.ToAlphaComputeHealthCheck()
+	if err != nil {
+		t.Fatalf("hc.ToAlphaComputeHealthCheck() = %v, want nil", err)
+	}
+	wantHTTP := &computealpha.HTTPHealthCheck{Port: 8080, Host: "foo.com", Response: "OK", ProxyHeader: "PROXY_V1"}
+	if !reflect.DeepEqual(alphaHC.HttpHealthCheck, wantHTTP) {
+		t.Errorf("HttpHealthCheck = %s, want %s", pretty.Sprint(alphaHC.HttpHealthCheck), pretty.Sprint(wantHTTP))
+	}
+
+	hc = DefaultHealthCheck(8080, annotations.ProtocolHTTP2)
+	hc.UpdateFromBackendConfig(&backendconfigv1.HealthCheckConfig{
+		Type:            s("GRPC"),
+		GrpcServiceName: s("foo.Service"),
+	})
+	alphaHC, err = hc.ToAlphaComputeHealthCheck()
+	if err != nil {
+		t.Fatalf("hc.ToAlphaComputeHealthCheck() = %v, want nil", err)
+	}
+	wantGRPC := &computealpha.GRPCHealthCheck{Port: 8080, GrpcServiceName: "foo.Service"}
+	if alphaHC.Http2HealthCheck != nil || !reflect.DeepEqual(alphaHC.GrpcHealthCheck, wantGRPC) {
+		t.Errorf("GrpcHealthCheck = %s, want %s", pretty.Sprint(alphaHC.GrpcHealthCheck), pretty.Sprint(wantGRPC))
+	}
+
+	// The GRPC settings survive the round trip through NewHealthCheck.
+	newHC, err := NewHealthCheck(alphaHC)
+	if err != nil {
+		t.Fatalf("NewHealthCheck() = %v, want nil", err)
+	}
+	if newHC.GrpcServiceName != "foo.Service" || newHC.Port != 8080 {
+		t.Errorf("NewHealthCheck() = %s, want GrpcServiceName %q and Port %d", pretty.Sprint(newHC), "foo.Service", 8080)
+	}
+}
@@ -19,8 +19,13 @@ import (
 	"context"
 	"crypto/md5"
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
@@ -28,9 +33,12 @@ import (
 	v1 "k8s.io/api/networking/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/composite"
 	"k8s.io/ingress-gce/pkg/flags"
+	"k8s.io/ingress-gce/pkg/routeconfig"
 	"k8s.io/ingress-gce/pkg/utils"
 	"k8s.io/ingress-gce/pkg/utils/namer"
 )
@@ -107,6 +115,8 @@ type TLSCerts struct {
 	Name  string
 	// md5 hash(first 8 bytes) of the cert contents
 	CertHash string
+	// NotAfter is the expiry time of the leaf certificate.
+	NotAfter time.Time
 }
 
 // Secrets returns the Secrets from the environment which are specified in the Ingress.
@@ -193,6 +203,7 @@ func ToCompositeURLMap(g *utils.GCEURLMap, namer namer.IngressFrontendNamer, key
 		Name:           namer.UrlMap(),
 		DefaultService: resourceID.ResourcePath(),
 	}
+	m.DefaultRouteAction = toCompositeRouteAction(*g.DefaultBackend, g.DefaultUrlRewrite, key)
 
 	for _, hostRule := range g.HostRules {
 		// Create a host rule
@@ -205,9 +216,22 @@ func ToCompositeURLMap(g *utils.GCEURLMap, namer namer.IngressFrontendNamer, key
 		})
 
 		pathMatcher := &composite.PathMatcher{
-			Name:           pmName,
-			DefaultService: m.DefaultService,
-			PathRules:      []*composite.PathRule{},
+			Name:                             pmName,
+			DefaultService:                   m.DefaultService,
+			DefaultRouteAction:               toCompositeRouteAction(*g.DefaultBackend, g.DefaultUrlRewrite, key),
+			PathRules:                        []*composite.PathRule{},
+			HeaderAction:                     toCompositeHeaderAction(hostRule.HeaderAction),
+			DefaultCustomErrorResponsePolicy: toCompositeCustomErrorResponsePolicy(hostRule.CustomErrorResponsePolicy, key),
+		}
+
+		// GCE does not allow path rules and route rules in the same path matcher.
+		// Path rules have no header action, so paths with a header action are
+		// converted to route rules as well.
+		if len(hostRule.RouteRules) > 0 || hostRule.HasPathHeaderActions() {
+			pathMatcher.PathRules = nil
+			pathMatcher.RouteRules = toCompositeRouteRules(hostRule, key)
+			m.PathMatchers = append(m.PathMatchers, pathMatcher)
+			continue
 		}
 
 		// GCE ensures that matched rule with longest prefix wins.
@@ -217,8 +241,10 @@ func ToCompositeURLMap(g *utils.GCEURLMap, namer namer.IngressFrontendNamer, key
 			resourceID := cloud.ResourceID{ProjectID: "", Resource: "backendServices", Key: key}
 			beLink := resourceID.ResourcePath()
 			pathMatcher.PathRules = append(pathMatcher.PathRules, &composite.PathRule{
-				Paths:   []string{rule.Path},
-				Service: beLink,
+				Paths:                     []string{rule.Path},
+				Service:                   beLink,
+				RouteAction:               toCompositeRouteAction(rule.Backend, rule.UrlRewrite, key),
+				CustomErrorResponsePolicy: toCompositeCustomErrorResponsePolicy(rule.CustomErrorResponsePolicy, key),
 			})
 		}
 		m.PathMatchers = append(m.PathMatchers, pathMatcher)
@@ -226,6 +252,260 @@ func ToCompositeURLMap(g *utils.GCEURLMap, namer namer.IngressFrontendNamer, key
 	return m
 }
 
+// toCompositeRouteRules returns the route rules of the host followed by its
+// path rules converted to route rules. Route rules are evaluated by priority
+// rather than by longest match, so exact paths are placed before prefixes and
+// longer prefixes before shorter ones to keep the semantics of path rules.
+func toCompositeRouteRules(hostRule utils.HostRule, key *meta.Key) []*composite.HttpRouteRule {
+	var routeRules []*composite.HttpRouteRule
+	for _, rule := range hostRule.RouteRules {
+		routeRule := &composite.HttpRouteRule{HeaderAction: toCompositeHeaderAction(rule.HeaderAction)}
+		if len(rule.WeightedBackends) > 0 {
+			// The weighted backends of a rule are validated to share the
+			// settings of their route action, so it is taken from the first.
+			routeRule.RouteAction = toCompositeRouteAction(rule.WeightedBackends[0].Backend, rule.UrlRewrite, key)
+			if routeRule.RouteAction == nil {
+				routeRule.RouteAction = &composite.HttpRouteAction{}
+			}
+			for _, wb := range rule.WeightedBackends {
+				routeRule.RouteAction.WeightedBackendServices = append(routeRule.RouteAction.WeightedBackendServices, &composite.WeightedBackendService{
+					BackendService: backendServicePath(wb.Backend, key),
+					Weight:         wb.Weight,
+				})
+			}
+		} else {
+			routeRule.Service = backendServicePath(rule.Backend, key)
+			routeRule.RouteAction = toCompositeRouteAction(rule.Backend, rule.UrlRewrite, key)
+		}
+		for _, match := range rule.Matches {
+			routeRule.MatchRules = append(routeRule.MatchRules, toCompositeRouteRuleMatch(match))
+		}
+		routeRules = append(routeRules, routeRule)
+	}
+
+	paths := make([]utils.PathRule, len(hostRule.Paths))
+	copy(paths, hostRule.Paths)
+	sort.SliceStable(paths, func(i, j int) bool {
+		iPrefix, jPrefix := strings.HasSuffix(paths[i].Path, "*"), strings.HasSuffix(paths[j].Path, "*")
+		if iPrefix != jPrefix {
+			return !iPrefix
+		}
+		return len(paths[i].Path) > len(paths[j].Path)
+	})
+	for _, rule := range paths {
+		match := &composite.HttpRouteRuleMatch{}
+		if strings.HasSuffix(rule.Path, "*") {
+			match.PrefixMatch = strings.TrimSuffix(rule.Path, "*")
+		} else {
+			match.FullPathMatch = rule.Path
+		}
+		routeRules = append(routeRules, &composite.HttpRouteRule{
+			MatchRules:                []*composite.HttpRouteRuleMatch{match},
+			Service:                   backendServicePath(rule.Backend, key),
+			RouteAction:               toCompositeRouteAction(rule.Backend, rule.UrlRewrite, key),
+			HeaderAction:              toCompositeHeaderAction(rule.HeaderAction),
+			CustomErrorResponsePolicy: toCompositeCustomErrorResponsePolicy(rule.CustomErrorResponsePolicy, key),
+		})
+	}
+
+	// Priorities start at 1 as a zero priority is omitted from requests.
+	for i, routeRule := range routeRules {
+		routeRule.Priority = int64(i + 1)
+	}
+	return routeRules
+}
+
+// toCompositeRouteAction returns the route action for requests sent to the
+// given backend, based on its BackendConfig, its shadow Service and the url
+// rewrite of the path or rule. It returns nil if the backend does not need a
+// route action.
+func toCompositeRouteAction(sp utils.ServicePort, urlRewrite *routeconfigv1beta1.UrlRewrite, key *meta.Key) *composite.HttpRouteAction {
+	routeAction := &composite.HttpRouteAction{UrlRewrite: toCompositeUrlRewrite(urlRewrite)}
+	if sp.MirrorBackend != nil {
+		routeAction.RequestMirrorPolicy = &composite.RequestMirrorPolicy{
+			BackendService: backendServicePath(*sp.MirrorBackend, key),
+		}
+	}
+
+	var spec backendconfigv1.BackendConfigSpec
+	if sp.BackendConfig != nil {
+		spec = sp.BackendConfig.Spec
+	}
+	if retryPolicy := spec.RetryPolicy; retryPolicy != nil {
+		routeAction.RetryPolicy = &composite.HttpRetryPolicy{
+			RetryConditions: retryPolicy.RetryConditions,
+			PerTryTimeout:   toCompositeDuration(retryPolicy.PerTryTimeout),
+		}
+		if retryPolicy.NumRetries != nil {
+			routeAction.RetryPolicy.NumRetries = *retryPolicy.NumRetries
+		}
+	}
+	if faultInjection := spec.FaultInjectionPolicy; faultInjection != nil && (faultInjection.Delay != nil || faultInjection.Abort != nil) {
+		routeAction.FaultInjectionPolicy = &composite.HttpFaultInjection{}
+		if delay := faultInjection.Delay; delay != nil {
+			routeAction.FaultInjectionPolicy.Delay = &composite.HttpFaultDelay{
+				FixedDelay: toCompositeDuration(&delay.FixedDelay),
+				Percentage: delay.Percentage,
+			}
+		}
+		if abort := faultInjection.Abort; abort != nil {
+			routeAction.FaultInjectionPolicy.Abort = &composite.HttpFaultAbort{
+				HttpStatus: abort.HttpStatus,
+				Percentage: abort.Percentage,
+			}
+		}
+	}
+	routeAction.Timeout = toCompositeDuration(spec.RouteTimeout)
+
+	if routeAction.RequestMirrorPolicy == nil && routeAction.UrlRewrite == nil && routeAction.RetryPolicy == nil && routeAction.FaultInjectionPolicy == nil && routeAction.Timeout == nil {
+		return nil
+	}
+	return routeAction
+}
+
+// toCompositeUrlRewrite converts a UrlRewrite to a composite url rewrite.
+// It returns nil if urlRewrite is nil.
+func toCompositeUrlRewrite(urlRewrite *routeconfigv1beta1.UrlRewrite) *composite.UrlRewrite {
+	if urlRewrite == nil {
+		return nil
+	}
+	return &composite.UrlRewrite{
+		PathPrefixRewrite: urlRewrite.PathPrefixRewrite,
+		HostRewrite:       urlRewrite.HostRewrite,
+	}
+}
+
+// toCompositeDuration converts a DurationConfig to a composite duration.
+// It returns nil if d is nil.
+func toCompositeDuration(d *backendconfigv1.DurationConfig) *composite.Duration {
+	if d == nil {
+		return nil
+	}
+	return &composite.Duration{Seconds: d.Seconds, Nanos: d.Nanos}
+}
+
+// toCompositeHeaderAction converts a HeaderAction to a composite header action.
+// It returns nil if headerAction is nil.
+func toCompositeHeaderAction(headerAction *routeconfigv1beta1.HeaderAction) *composite.HttpHeaderAction {
+	if headerAction == nil {
+		return nil
+	}
+	return &composite.HttpHeaderAction{
+		RequestHeadersToAdd:     toCompositeHeaderOptions(headerAction.RequestHeadersToAdd),
+		RequestHeadersToRemove:  headerAction.RequestHeadersToRemove,
+		ResponseHeadersToAdd:    toCompositeHeaderOptions(headerAction.ResponseHeadersToAdd),
+		ResponseHeadersToRemove: headerAction.ResponseHeadersToRemove,
+	}
+}
+
+func toCompositeHeaderOptions(headers []routeconfigv1beta1.HeaderOption) []*composite.HttpHeaderOption {
+	var options []*composite.HttpHeaderOption
+	for _, header := range headers {
+		options = append(options, &composite.HttpHeaderOption{
+			HeaderName:  header.Name,
+			HeaderValue: header.Value,
+			Replace:     header.Replace,
+		})
+	}
+	return options
+}
+
+// toCompositeCustomErrorResponsePolicy converts a CustomErrorResponsePolicy to
+// a composite custom error response policy. It returns nil if policy is nil.
+func toCompositeCustomErrorResponsePolicy(policy *routeconfigv1beta1.CustomErrorResponsePolicy, key *meta.Key) *composite.CustomErrorResponsePolicy {
+	if policy == nil {
+		return nil
+	}
+	compositePolicy := &composite.CustomErrorResponsePolicy{}
+	if policy.ErrorService != "" {
+		compositePolicy.ErrorService = errorServicePath(policy, key)
+	}
+	for _, rule := range policy.ErrorResponseRules {
+		compositePolicy.ErrorResponseRules = append(compositePolicy.ErrorResponseRules, &composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+			MatchResponseCodes:   rule.MatchResponseCodes,
+			Path:                 rule.Path,
+			OverrideResponseCode: int64(rule.OverrideResponseCode),
+		})
+	}
+	return compositePolicy
+}
+
+// errorServicePath returns the link of the error service of the policy. Backend
+// services are in the scope of the url map, backend buckets are global.
+func errorServicePath(policy *routeconfigv1beta1.CustomErrorResponsePolicy, key *meta.Key) string {
+	if policy.ErrorServiceKind == routeconfigv1beta1.ErrorServiceKindBackendService {
+		resourceID := cloud.ResourceID{ProjectID: "", Resource: "backendServices", Key: &meta.Key{Name: policy.ErrorService, Region: key.Region}}
+		return resourceID.ResourcePath()
+	}
+	resourceID := cloud.ResourceID{ProjectID: "", Resource: "backendBuckets", Key: meta.GlobalKey(policy.ErrorService)}
+	return resourceID.ResourcePath()
+}
+
+// ToCompositeDefaultCustomErrorResponsePolicy returns the default custom error
+// response policy of the UrlMap configured in the FrontendConfig, or nil if
+// there is none.
+func ToCompositeDefaultCustomErrorResponsePolicy(feConfig *frontendconfigv1beta1.FrontendConfig, key *meta.Key) (*composite.CustomErrorResponsePolicy, error) {
+	if feConfig == nil || feConfig.Spec.DefaultCustomErrorResponsePolicy == nil {
+		return nil, nil
+	}
+	policy := &routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorService:     feConfig.Spec.DefaultCustomErrorResponsePolicy.ErrorService,
+		ErrorServiceKind: feConfig.Spec.DefaultCustomErrorResponsePolicy.ErrorServiceKind,
+	}
+	for _, rule := range feConfig.Spec.DefaultCustomErrorResponsePolicy.ErrorResponseRules {
+		policy.ErrorResponseRules = append(policy.ErrorResponseRules, routeconfigv1beta1.CustomErrorResponseRule{
+			MatchResponseCodes:   rule.MatchResponseCodes,
+			Path:                 rule.Path,
+			OverrideResponseCode: rule.OverrideResponseCode,
+		})
+	}
+	if err := routeconfig.ValidateCustomErrorResponsePolicy(policy, true); err != nil {
+		return nil, fmt.Errorf("FrontendConfig %s/%s: defaultCustomErrorResponsePolicy: %v", feConfig.Namespace, feConfig.Name, err)
+	}
+	return toCompositeCustomErrorResponsePolicy(policy, key), nil
+}
+
+// toCompositeRouteRuleMatch converts a RouteMatch to a composite match rule.
+// A match without a path matches every path.
+func toCompositeRouteRuleMatch(match routeconfigv1beta1.RouteMatch) *composite.HttpRouteRuleMatch {
+	matchRule := &composite.HttpRouteRuleMatch{
+		PrefixMatch:   match.PrefixMatch,
+		FullPathMatch: match.FullPathMatch,
+		IgnoreCase:    match.IgnoreCase,
+	}
+	if matchRule.PrefixMatch == "" && matchRule.FullPathMatch == "" {
+		matchRule.PrefixMatch = "/"
+	}
+	for _, header := range match.Headers {
+		matchRule.HeaderMatches = append(matchRule.HeaderMatches, &composite.HttpHeaderMatch{
+			HeaderName:   header.Name,
+			ExactMatch:   header.ExactMatch,
+			PrefixMatch:  header.PrefixMatch,
+			SuffixMatch:  header.SuffixMatch,
+			RegexMatch:   header.RegexMatch,
+			PresentMatch: header.PresentMatch,
+			InvertMatch:  header.InvertMatch,
+		})
+	}
+	for _, param := range match.QueryParameters {
+		matchRule.QueryParameterMatches = append(matchRule.QueryParameterMatches, &composite.HttpQueryParameterMatch{
+			Name:         param.Name,
+			ExactMatch:   param.ExactMatch,
+			RegexMatch:   param.RegexMatch,
+			PresentMatch: param.PresentMatch,
+		})
+	}
+	return matchRule
+}
+
+// backendServicePath returns the relative resource path of the backend service
+// for the given ServicePort in the scope of key.
+func backendServicePath(sp utils.ServicePort, key *meta.Key) string {
+	key.Name = sp.BackendName()
+	resourceID := cloud.ResourceID{ProjectID: "", Resource: "backendServices", Key: key}
+	return resourceID.ResourcePath()
+}
+
 // ToRedirectUrlMap returns the UrlMap used for HTTPS Redirects on a L7 ELB
 // This function returns nil if no url map needs to be created
 func (t *Translator) ToRedirectUrlMap(env *Env, version meta.Version) *composite.UrlMap {
@@ -340,6 +620,11 @@ func (t *Translator) ToCompositeTargetHttpsProxy(env *Env, description string, v
 			proxy.SslPolicy = *sslPolicy
 			sslPolicySet = true
 		}
+		proxy.CertificateMap = certificateMapLink(env)
+		proxy.ServerTlsPolicy = serverTlsPolicyLink(env)
+		if env.FrontendConfig != nil && env.FrontendConfig.Spec.QuicOverride != nil {
+			proxy.QuicOverride = *env.FrontendConfig.Spec.QuicOverride
+		}
 	}
 
 	return proxy, sslPolicySet, nil
@@ -379,9 +664,53 @@ func (t *Translator) ToCompositeSSLCertificates(env *Env, tlsName string, tls []
 		certs = append(certs, cert)
 	}
 
+	// Google-managed cert
+	if env.FrontendConfig != nil && env.FrontendConfig.Spec.ManagedCertificates != nil && len(env.FrontendConfig.Spec.ManagedCertificates.Domains) > 0 {
+		domains := append([]string{}, env.FrontendConfig.Spec.ManagedCertificates.Domains...)
+		sort.Strings(domains)
+		// The name changes with the domains, as the domains of a managed cert
+		// cannot be updated in place.
+		gcpCertName := t.FrontendNamer.SSLCertName(GetCertHash(strings.Join(domains, ",")))
+		resID := cloud.ResourceID{Resource: "sslCertificates", Key: &meta.Key{Name: gcpCertName}, ProjectID: env.Project}
+		managedCert := &composite.SslCertificate{
+			Name:     gcpCertName,
+			Type:     "MANAGED",
+			Managed:  &composite.SslCertificateManagedSslCertificate{Domains: domains},
+			SelfLink: resID.SelfLink(version),
+		}
+		certs = append(certs, managedCert)
+	}
+
 	return certs
 }
 
+// certificateMapLink returns the ref to the Certificate Manager certificate
+// map that is described by the frontend config, or an empty string if there is
+// none. A name refers to a global certificate map of the project.
+func certificateMapLink(env *Env) string {
+	if env.FrontendConfig == nil || env.FrontendConfig.Spec.CertificateMap == nil {
+		return ""
+	}
+	certificateMap := *env.FrontendConfig.Spec.CertificateMap
+	if certificateMap == "" || strings.Contains(certificateMap, "/") {
+		return certificateMap
+	}
+	return fmt.Sprintf("//certificatemanager.googleapis.com/projects/%s/locations/global/certificateMaps/%s", env.Project, certificateMap)
+}
+
+// serverTlsPolicyLink returns the ref to the server TLS policy of the frontend
+// config. A policy name is expanded to the global policy of the project.
+func serverTlsPolicyLink(env *Env) string {
+	if env.FrontendConfig == nil || env.FrontendConfig.Spec.ServerTlsPolicy == nil {
+		return ""
+	}
+	serverTlsPolicy := *env.FrontendConfig.Spec.ServerTlsPolicy
+	if serverTlsPolicy == "" || strings.Contains(serverTlsPolicy, "/") {
+		return serverTlsPolicy
+	}
+	return fmt.Sprintf("//networksecurity.googleapis.com/projects/%s/locations/global/serverTlsPolicies/%s", env.Project, serverTlsPolicy)
+}
+
 // sslPolicyLink returns the ref to the ssl policy that is described by the
 // frontend config.  Since Ssl Policy is a *string, there are three possible I/O situations
 // 1) policy is nil -> this returns nil
@@ -416,20 +745,96 @@ func GetCertHash(contents string) string {
 	return fmt.Sprintf("%x", sha256.Sum256([]byte(contents)))[:16]
 }
 
+// InvalidCertError is returned for a TLS secret whose certificate is invalid
+// or does not cover the hosts it is used for. Only that certificate is left out
+// of the load balancer.
+type InvalidCertError struct {
+	SecretName string
+	Reason     string
+}
+
+func (e *InvalidCertError) Error() string {
+	return fmt.Sprintf("certificate of secret %q %s", e.SecretName, e.Reason)
+}
+
+// ToTLSCerts returns the TLS certificates of the secrets of the Ingress.
+// Certificates which are invalid or whose SANs do not cover any of the hosts of
+// their TLS entry are left out and reported as InvalidCertErrors. Expired
+// certificates are returned, the load balancer keeps them if they are already
+// uploaded and does not upload them otherwise.
 func ToTLSCerts(env *Env) ([]*TLSCerts, []error) {
 	var certs []*TLSCerts
 	var errors []error
 
 	secrets, errors := secrets(env)
+	hosts := tlsHosts(env.Ing)
 	for _, secret := range secrets {
+		chain, err := parseCertChain(secret)
+		if err != nil {
+			errors = append(errors, &InvalidCertError{SecretName: secret.Name, Reason: fmt.Sprintf("is invalid: %v", err)})
+			continue
+		}
+		if secretHosts := hosts[secret.Name]; len(secretHosts) > 0 && !certCoversAnyHost(chain[0], secretHosts) {
+			errors = append(errors, &InvalidCertError{SecretName: secret.Name, Reason: fmt.Sprintf("with SANs %v does not cover any of the TLS hosts %v", chain[0].DNSNames, secretHosts)})
+			continue
+		}
+
 		cert := string(secret.Data[api_v1.TLSCertKey])
 		newCert := &TLSCerts{
 			Key:      string(secret.Data[api_v1.TLSPrivateKeyKey]),
 			Cert:     cert,
 			Name:     secret.Name,
 			CertHash: GetCertHash(cert),
+			NotAfter: chain[0].NotAfter,
 		}
 		certs = append(certs, newCert)
 	}
 	return certs, errors
 }
+
+// parseCertChain parses the PEM encoded certificate chain of the secret. The
+// leaf certificate is the first one of the chain.
+func parseCertChain(secret *api_v1.Secret) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	rest := secret.Data[api_v1.TLSCertKey]
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %v", err)
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return chain, nil
+}
+
+// tlsHosts returns the hosts of the TLS entries of the Ingress, keyed by the
+// names of their secrets.
+func tlsHosts(ing *v1.Ingress) map[string][]string {
+	hosts := map[string][]string{}
+	for _, tls := range ing.Spec.TLS {
+		hosts[tls.SecretName] = append(hosts[tls.SecretName], tls.Hosts...)
+	}
+	return hosts
+}
+
+// certCoversAnyHost returns true if the SANs of the certificate cover at least
+// one of the hosts.
+func certCoversAnyHost(cert *x509.Certificate, hosts []string) bool {
+	for _, host := range hosts {
+		if cert.VerifyHostname(host) == nil {
+			return true
+		}
+	}
+	return false
+}
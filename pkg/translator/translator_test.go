@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"github.com/google/go-cmp/cmp"
@@ -25,10 +26,13 @@ import (
 	v1 "k8s.io/api/networking/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	backendconfigv1 "k8s.io/ingress-gce/pkg/apis/backendconfig/v1"
 	frontendconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/frontendconfig/v1beta1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/flags"
 
 	"k8s.io/ingress-gce/pkg/composite"
+	"k8s.io/ingress-gce/pkg/test"
 	"k8s.io/ingress-gce/pkg/utils"
 	namer_util "k8s.io/ingress-gce/pkg/utils/namer"
 )
@@ -119,6 +123,720 @@ func TestToComputeURLMap(t *testing.T) {
 	}
 }
 
+func TestToComputeURLMapWithUrlRewrite(t *testing.T) {
+	t.Parallel()
+
+	namer := namer_util.NewNamer("uid1", "fw1")
+	rewrite := &routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/", HostRewrite: "legacy.example.com"}
+	gceURLMap := &utils.GCEURLMap{
+		DefaultBackend:    &utils.ServicePort{NodePort: 30000, BackendNamer: namer},
+		DefaultUrlRewrite: &routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/default/"},
+		HostRules: []utils.HostRule{
+			{
+				Hostname: "abc.com",
+				Paths: []utils.PathRule{
+					{
+						Path:       "/app1/*",
+						Backend:    utils.ServicePort{NodePort: 32000, BackendNamer: namer},
+						UrlRewrite: rewrite,
+					},
+					{
+						Path:    "/app2/*",
+						Backend: utils.ServicePort{NodePort: 32000, BackendNamer: namer},
+					},
+				},
+			},
+			{
+				Hostname: "foo.bar.com",
+				Paths: []utils.PathRule{
+					{
+						Path:       "/app1/*",
+						Backend:    utils.ServicePort{NodePort: 32000, BackendNamer: namer},
+						UrlRewrite: rewrite,
+					},
+				},
+				RouteRules: []utils.RouteRule{
+					{
+						Matches:    []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/canary/", Headers: []routeconfigv1beta1.HeaderMatch{{Name: "x-canary", PresentMatch: true}}}},
+						Backend:    utils.ServicePort{NodePort: 35000, BackendNamer: namer},
+						UrlRewrite: &routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/"},
+					},
+				},
+			},
+		},
+	}
+
+	wantRouteAction := &composite.HttpRouteAction{
+		UrlRewrite: &composite.UrlRewrite{PathPrefixRewrite: "/", HostRewrite: "legacy.example.com"},
+	}
+	wantDefaultRouteAction := &composite.HttpRouteAction{
+		UrlRewrite: &composite.UrlRewrite{PathPrefixRewrite: "/default/"},
+	}
+	wantComputeMap := &composite.UrlMap{
+		Name:               "k8s-um-lb-name",
+		DefaultService:     "global/backendServices/k8s-be-30000--uid1",
+		DefaultRouteAction: wantDefaultRouteAction,
+		HostRules: []*composite.HostRule{
+			{
+				Hosts:       []string{"abc.com"},
+				PathMatcher: "host929ba26f492f86d4a9d66a080849865a",
+			},
+			{
+				Hosts:       []string{"foo.bar.com"},
+				PathMatcher: "host2d50cf9711f59181be6a5e5658e42c21",
+			},
+		},
+		PathMatchers: []*composite.PathMatcher{
+			{
+				DefaultService:     "global/backendServices/k8s-be-30000--uid1",
+				DefaultRouteAction: wantDefaultRouteAction,
+				Name:               "host929ba26f492f86d4a9d66a080849865a",
+				PathRules: []*composite.PathRule{
+					{
+						Paths:       []string{"/app1/*"},
+						Service:     "global/backendServices/k8s-be-32000--uid1",
+						RouteAction: wantRouteAction,
+					},
+					{
+						Paths:   []string{"/app2/*"},
+						Service: "global/backendServices/k8s-be-32000--uid1",
+					},
+				},
+			},
+			{
+				DefaultService:     "global/backendServices/k8s-be-30000--uid1",
+				DefaultRouteAction: wantDefaultRouteAction,
+				Name:               "host2d50cf9711f59181be6a5e5658e42c21",
+				RouteRules: []*composite.HttpRouteRule{
+					{
+						Priority: 1,
+						MatchRules: []*composite.HttpRouteRuleMatch{
+							{
+								PrefixMatch:   "/canary/",
+								HeaderMatches: []*composite.HttpHeaderMatch{{HeaderName: "x-canary", PresentMatch: true}},
+							},
+						},
+						Service:     "global/backendServices/k8s-be-35000--uid1",
+						RouteAction: &composite.HttpRouteAction{UrlRewrite: &composite.UrlRewrite{PathPrefixRewrite: "/"}},
+					},
+					{
+						Priority:    2,
+						MatchRules:  []*composite.HttpRouteRuleMatch{{PrefixMatch: "/app1/"}},
+						Service:     "global/backendServices/k8s-be-32000--uid1",
+						RouteAction: wantRouteAction,
+					},
+				},
+			},
+		},
+	}
+
+	namerFactory := namer_util.NewFrontendNamerFactory(namer, "")
+	feNamer := namerFactory.NamerForLoadBalancer("lb-name")
+	gotComputeURLMap := ToCompositeURLMap(gceURLMap, feNamer, meta.GlobalKey("ns-lb-name"))
+	if diff := cmp.Diff(wantComputeMap, gotComputeURLMap); diff != "" {
+		t.Errorf("Unexpected diff from ToComputeURLMap() (-want +got):\n%s", diff)
+	}
+}
+
+func TestToComputeURLMapWithMirroredDefaultBackend(t *testing.T) {
+	t.Parallel()
+
+	namer := namer_util.NewNamer("uid1", "fw1")
+	gceURLMap := &utils.GCEURLMap{
+		DefaultBackend: &utils.ServicePort{
+			NodePort:      30000,
+			BackendNamer:  namer,
+			MirrorBackend: &utils.ServicePort{NodePort: 33000, BackendNamer: namer},
+		},
+		HostRules: []utils.HostRule{
+			{
+				Hostname: "abc.com",
+				Paths: []utils.PathRule{
+					{
+						Path:    "/app1/*",
+						Backend: utils.ServicePort{NodePort: 32000, BackendNamer: namer},
+					},
+				},
+			},
+		},
+	}
+
+	wantDefaultRouteAction := &composite.HttpRouteAction{
+		RequestMirrorPolicy: &composite.RequestMirrorPolicy{BackendService: "global/backendServices/k8s-be-33000--uid1"},
+	}
+	wantComputeMap := &composite.UrlMap{
+		Name:               "k8s-um-lb-name",
+		DefaultService:     "global/backendServices/k8s-be-30000--uid1",
+		DefaultRouteAction: wantDefaultRouteAction,
+		HostRules: []*composite.HostRule{
+			{
+				Hosts:       []string{"abc.com"},
+				PathMatcher: "host929ba26f492f86d4a9d66a080849865a",
+			},
+		},
+		PathMatchers: []*composite.PathMatcher{
+			{
+				DefaultService:     "global/backendServices/k8s-be-30000--uid1",
+				DefaultRouteAction: wantDefaultRouteAction,
+				Name:               "host929ba26f492f86d4a9d66a080849865a",
+				PathRules: []*composite.PathRule{
+					{
+						Paths:   []string{"/app1/*"},
+						Service: "global/backendServices/k8s-be-32000--uid1",
+					},
+				},
+			},
+		},
+	}
+
+	namerFactory := namer_util.NewFrontendNamerFactory(namer, "")
+	feNamer := namerFactory.NamerForLoadBalancer("lb-name")
+	gotComputeURLMap := ToCompositeURLMap(gceURLMap, feNamer, meta.GlobalKey("ns-lb-name"))
+	if diff := cmp.Diff(wantComputeMap, gotComputeURLMap); diff != "" {
+		t.Errorf("Unexpected diff from ToComputeURLMap() (-want +got):\n%s", diff)
+	}
+}
+
+func TestToCompositeRouteAction(t *testing.T) {
+	t.Parallel()
+
+	namer := namer_util.NewNamer("uid1", "fw1")
+	for _, tc := range []struct {
+		desc   string
+		spec   *backendconfigv1.BackendConfigSpec
+		mirror *utils.ServicePort
+		want   *composite.HttpRouteAction
+	}{
+		{
+			desc: "no backend config",
+		},
+		{
+			desc: "no route action settings",
+			spec: &backendconfigv1.BackendConfigSpec{TimeoutSec: utils.NewInt64Pointer(30)},
+		},
+		{
+			desc: "retry policy",
+			spec: &backendconfigv1.BackendConfigSpec{
+				RetryPolicy: &backendconfigv1.RetryPolicyConfig{
+					RetryConditions: []string{"5xx", "connect-failure"},
+					NumRetries:      utils.NewInt64Pointer(3),
+					PerTryTimeout:   &backendconfigv1.DurationConfig{Seconds: 2},
+				},
+			},
+			want: &composite.HttpRouteAction{
+				RetryPolicy: &composite.HttpRetryPolicy{
+					RetryConditions: []string{"5xx", "connect-failure"},
+					NumRetries:      3,
+					PerTryTimeout:   &composite.Duration{Seconds: 2},
+				},
+			},
+		},
+		{
+			desc: "fault injection and route timeout",
+			spec: &backendconfigv1.BackendConfigSpec{
+				FaultInjectionPolicy: &backendconfigv1.FaultInjectionPolicyConfig{
+					Delay: &backendconfigv1.FaultDelayConfig{FixedDelay: backendconfigv1.DurationConfig{Nanos: 500000000}, Percentage: 50},
+					Abort: &backendconfigv1.FaultAbortConfig{HttpStatus: 503, Percentage: 10},
+				},
+				RouteTimeout: &backendconfigv1.DurationConfig{Seconds: 30},
+			},
+			want: &composite.HttpRouteAction{
+				FaultInjectionPolicy: &composite.HttpFaultInjection{
+					Delay: &composite.HttpFaultDelay{FixedDelay: &composite.Duration{Nanos: 500000000}, Percentage: 50},
+					Abort: &composite.HttpFaultAbort{HttpStatus: 503, Percentage: 10},
+				},
+				Timeout: &composite.Duration{Seconds: 30},
+			},
+		},
+		{
+			desc:   "request mirror",
+			mirror: &utils.ServicePort{NodePort: 33000, BackendNamer: namer},
+			want: &composite.HttpRouteAction{
+				RequestMirrorPolicy: &composite.RequestMirrorPolicy{BackendService: "global/backendServices/k8s-be-33000--uid1"},
+			},
+		},
+		{
+			desc:   "request mirror and route timeout",
+			spec:   &backendconfigv1.BackendConfigSpec{RouteTimeout: &backendconfigv1.DurationConfig{Seconds: 30}},
+			mirror: &utils.ServicePort{NodePort: 33000, BackendNamer: namer},
+			want: &composite.HttpRouteAction{
+				RequestMirrorPolicy: &composite.RequestMirrorPolicy{BackendService: "global/backendServices/k8s-be-33000--uid1"},
+				Timeout:             &composite.Duration{Seconds: 30},
+			},
+		},
+		{
+			desc: "empty fault injection policy",
+			spec: &backendconfigv1.BackendConfigSpec{
+				FaultInjectionPolicy: &backendconfigv1.FaultInjectionPolicyConfig{},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			sp := utils.ServicePort{MirrorBackend: tc.mirror}
+			if tc.spec != nil {
+				sp.BackendConfig = &backendconfigv1.BackendConfig{Spec: *tc.spec}
+			}
+			got := toCompositeRouteAction(sp, nil, meta.GlobalKey("ns-lb-name"))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("toCompositeRouteAction() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToComputeURLMapWithHeaderActions(t *testing.T) {
+	t.Parallel()
+
+	namer := namer_util.NewNamer("uid1", "fw1")
+	hostHeaderAction := &routeconfigv1beta1.HeaderAction{
+		ResponseHeadersToRemove: []string{"server"},
+	}
+	pathHeaderAction := &routeconfigv1beta1.HeaderAction{
+		RequestHeadersToAdd: []routeconfigv1beta1.HeaderOption{{Name: "x-client-region", Value: "{client_region}", Replace: true}},
+	}
+	gceURLMap := &utils.GCEURLMap{
+		DefaultBackend: &utils.ServicePort{NodePort: 30000, BackendNamer: namer},
+		HostRules: []utils.HostRule{
+			{
+				Hostname: "abc.com",
+				Paths: []utils.PathRule{
+					{
+						Path:    "/web/*",
+						Backend: utils.ServicePort{NodePort: 32000, BackendNamer: namer},
+					},
+				},
+				HeaderAction: hostHeaderAction,
+			},
+			{
+				Hostname: "foo.bar.com",
+				Paths: []utils.PathRule{
+					{
+						Path:    "/web/*",
+						Backend: utils.ServicePort{NodePort: 32000, BackendNamer: namer},
+					},
+					{
+						Path:         "/api/*",
+						Backend:      utils.ServicePort{NodePort: 34000, BackendNamer: namer},
+						HeaderAction: pathHeaderAction,
+					},
+				},
+			},
+		},
+	}
+
+	wantComputeMap := &composite.UrlMap{
+		Name:           "k8s-um-lb-name",
+		DefaultService: "global/backendServices/k8s-be-30000--uid1",
+		HostRules: []*composite.HostRule{
+			{
+				Hosts:       []string{"abc.com"},
+				PathMatcher: "host929ba26f492f86d4a9d66a080849865a",
+			},
+			{
+				Hosts:       []string{"foo.bar.com"},
+				PathMatcher: "host2d50cf9711f59181be6a5e5658e42c21",
+			},
+		},
+		PathMatchers: []*composite.PathMatcher{
+			{
+				DefaultService: "global/backendServices/k8s-be-30000--uid1",
+				Name:           "host929ba26f492f86d4a9d66a080849865a",
+				PathRules: []*composite.PathRule{
+					{
+						Paths:   []string{"/web/*"},
+						Service: "global/backendServices/k8s-be-32000--uid1",
+					},
+				},
+				HeaderAction: &composite.HttpHeaderAction{ResponseHeadersToRemove: []string{"server"}},
+			},
+			{
+				DefaultService: "global/backendServices/k8s-be-30000--uid1",
+				Name:           "host2d50cf9711f59181be6a5e5658e42c21",
+				RouteRules: []*composite.HttpRouteRule{
+					{
+						Priority:   1,
+						MatchRules: []*composite.HttpRouteRuleMatch{{PrefixMatch: "/web/"}},
+						Service:    "global/backendServices/k8s-be-32000--uid1",
+					},
+					{
+						Priority:   2,
+						MatchRules: []*composite.HttpRouteRuleMatch{{PrefixMatch: "/api/"}},
+						Service:    "global/backendServices/k8s-be-34000--uid1",
+						HeaderAction: &composite.HttpHeaderAction{
+							RequestHeadersToAdd: []*composite.HttpHeaderOption{
+								{HeaderName: "x-client-region", HeaderValue: "{client_region}", Replace: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	namerFactory := namer_util.NewFrontendNamerFactory(namer, "")
+	feNamer := namerFactory.NamerForLoadBalancer("lb-name")
+	gotComputeURLMap := ToCompositeURLMap(gceURLMap, feNamer, meta.GlobalKey("ns-lb-name"))
+	if diff := cmp.Diff(wantComputeMap, gotComputeURLMap); diff != "" {
+		t.Errorf("Unexpected diff from ToComputeURLMap() (-want +got):\n%s", diff)
+	}
+}
+
+func TestToComputeURLMapWithCustomErrorResponsePolicies(t *testing.T) {
+	t.Parallel()
+
+	namer := namer_util.NewNamer("uid1", "fw1")
+	hostPolicy := &routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorService: "error-pages",
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{
+			{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html", OverrideResponseCode: 503},
+		},
+	}
+	pathPolicy := &routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{
+			{MatchResponseCodes: []string{"404", "410"}, Path: "/api/not-found.json"},
+		},
+	}
+	servicePolicy := &routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorService:     "error-backend",
+		ErrorServiceKind: routeconfigv1beta1.ErrorServiceKindBackendService,
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{
+			{MatchResponseCodes: []string{"5xx"}, Path: "/static/5xx.html"},
+		},
+	}
+	gceURLMap := &utils.GCEURLMap{
+		DefaultBackend: &utils.ServicePort{NodePort: 30000, BackendNamer: namer},
+		HostRules: []utils.HostRule{
+			{
+				Hostname: "abc.com",
+				Paths: []utils.PathRule{
+					{
+						Path:                      "/api/*",
+						Backend:                   utils.ServicePort{NodePort: 32000, BackendNamer: namer},
+						CustomErrorResponsePolicy: pathPolicy,
+					},
+					{
+						Path:                      "/static/*",
+						Backend:                   utils.ServicePort{NodePort: 32000, BackendNamer: namer},
+						CustomErrorResponsePolicy: servicePolicy,
+					},
+				},
+				CustomErrorResponsePolicy: hostPolicy,
+			},
+		},
+	}
+
+	wantComputeMap := &composite.UrlMap{
+		Name:           "k8s-um-lb-name",
+		DefaultService: "global/backendServices/k8s-be-30000--uid1",
+		HostRules: []*composite.HostRule{
+			{
+				Hosts:       []string{"abc.com"},
+				PathMatcher: "host929ba26f492f86d4a9d66a080849865a",
+			},
+		},
+		PathMatchers: []*composite.PathMatcher{
+			{
+				DefaultService: "global/backendServices/k8s-be-30000--uid1",
+				Name:           "host929ba26f492f86d4a9d66a080849865a",
+				PathRules: []*composite.PathRule{
+					{
+						Paths:   []string{"/api/*"},
+						Service: "global/backendServices/k8s-be-32000--uid1",
+						CustomErrorResponsePolicy: &composite.CustomErrorResponsePolicy{
+							ErrorResponseRules: []*composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+								{MatchResponseCodes: []string{"404", "410"}, Path: "/api/not-found.json"},
+							},
+						},
+					},
+					{
+						Paths:   []string{"/static/*"},
+						Service: "global/backendServices/k8s-be-32000--uid1",
+						CustomErrorResponsePolicy: &composite.CustomErrorResponsePolicy{
+							ErrorService: "global/backendServices/error-backend",
+							ErrorResponseRules: []*composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+								{MatchResponseCodes: []string{"5xx"}, Path: "/static/5xx.html"},
+							},
+						},
+					},
+				},
+				DefaultCustomErrorResponsePolicy: &composite.CustomErrorResponsePolicy{
+					ErrorService: "global/backendBuckets/error-pages",
+					ErrorResponseRules: []*composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+						{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html", OverrideResponseCode: 503},
+					},
+				},
+			},
+		},
+	}
+
+	namerFactory := namer_util.NewFrontendNamerFactory(namer, "")
+	feNamer := namerFactory.NamerForLoadBalancer("lb-name")
+	gotComputeURLMap := ToCompositeURLMap(gceURLMap, feNamer, meta.GlobalKey("ns-lb-name"))
+	if diff := cmp.Diff(wantComputeMap, gotComputeURLMap); diff != "" {
+		t.Errorf("Unexpected diff from ToComputeURLMap() (-want +got):\n%s", diff)
+	}
+}
+
+func TestToCompositeDefaultCustomErrorResponsePolicy(t *testing.T) {
+	t.Parallel()
+
+	feConfig := func(policy *frontendconfigv1beta1.CustomErrorResponsePolicy) *frontendconfigv1beta1.FrontendConfig {
+		return &frontendconfigv1beta1.FrontendConfig{
+			ObjectMeta: meta_v1.ObjectMeta{Namespace: "default", Name: "fe"},
+			Spec:       frontendconfigv1beta1.FrontendConfigSpec{DefaultCustomErrorResponsePolicy: policy},
+		}
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		feConfig *frontendconfigv1beta1.FrontendConfig
+		region   string
+		want     *composite.CustomErrorResponsePolicy
+		wantErr  bool
+	}{
+		{
+			desc: "nil frontend config",
+		},
+		{
+			desc:     "no policy",
+			feConfig: feConfig(nil),
+		},
+		{
+			desc: "policy",
+			feConfig: feConfig(&frontendconfigv1beta1.CustomErrorResponsePolicy{
+				ErrorService: "error-pages",
+				ErrorResponseRules: []frontendconfigv1beta1.CustomErrorResponseRule{
+					{MatchResponseCodes: []string{"4xx"}, Path: "/4xx.html", OverrideResponseCode: 404},
+					{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+				},
+			}),
+			want: &composite.CustomErrorResponsePolicy{
+				ErrorService: "global/backendBuckets/error-pages",
+				ErrorResponseRules: []*composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+					{MatchResponseCodes: []string{"4xx"}, Path: "/4xx.html", OverrideResponseCode: 404},
+					{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+				},
+			},
+		},
+		{
+			desc: "backend service",
+			feConfig: feConfig(&frontendconfigv1beta1.CustomErrorResponsePolicy{
+				ErrorService:     "error-pages",
+				ErrorServiceKind: routeconfigv1beta1.ErrorServiceKindBackendService,
+				ErrorResponseRules: []frontendconfigv1beta1.CustomErrorResponseRule{
+					{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+				},
+			}),
+			want: &composite.CustomErrorResponsePolicy{
+				ErrorService: "global/backendServices/error-pages",
+				ErrorResponseRules: []*composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+					{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+				},
+			},
+		},
+		{
+			desc: "regional backend service",
+			feConfig: feConfig(&frontendconfigv1beta1.CustomErrorResponsePolicy{
+				ErrorService:     "error-pages",
+				ErrorServiceKind: routeconfigv1beta1.ErrorServiceKindBackendService,
+				ErrorResponseRules: []frontendconfigv1beta1.CustomErrorResponseRule{
+					{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+				},
+			}),
+			region: "us-central1",
+			want: &composite.CustomErrorResponsePolicy{
+				ErrorService: "regions/us-central1/backendServices/error-pages",
+				ErrorResponseRules: []*composite.CustomErrorResponsePolicyCustomErrorResponseRule{
+					{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+				},
+			},
+		},
+		{
+			desc: "unknown error service kind",
+			feConfig: feConfig(&frontendconfigv1beta1.CustomErrorResponsePolicy{
+				ErrorService:     "error-pages",
+				ErrorServiceKind: "Bucket",
+				ErrorResponseRules: []frontendconfigv1beta1.CustomErrorResponseRule{
+					{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+				},
+			}),
+			wantErr: true,
+		},
+		{
+			desc: "missing error service",
+			feConfig: feConfig(&frontendconfigv1beta1.CustomErrorResponsePolicy{
+				ErrorResponseRules: []frontendconfigv1beta1.CustomErrorResponseRule{
+					{MatchResponseCodes: []string{"5xx"}, Path: "/5xx.html"},
+				},
+			}),
+			wantErr: true,
+		},
+		{
+			desc: "invalid response code",
+			feConfig: feConfig(&frontendconfigv1beta1.CustomErrorResponsePolicy{
+				ErrorService: "error-pages",
+				ErrorResponseRules: []frontendconfigv1beta1.CustomErrorResponseRule{
+					{MatchResponseCodes: []string{"302"}, Path: "/302.html"},
+				},
+			}),
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			key := meta.GlobalKey("k8s-um-lb-name")
+			if tc.region != "" {
+				key = meta.RegionalKey("k8s-um-lb-name", tc.region)
+			}
+			got, err := ToCompositeDefaultCustomErrorResponsePolicy(tc.feConfig, key)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ToCompositeDefaultCustomErrorResponsePolicy() = %v, want err %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected diff from ToCompositeDefaultCustomErrorResponsePolicy() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToComputeURLMapWithRouteRules(t *testing.T) {
+	t.Parallel()
+
+	namer := namer_util.NewNamer("uid1", "fw1")
+	canaryBackendConfig := &backendconfigv1.BackendConfig{
+		Spec: backendconfigv1.BackendConfigSpec{
+			RouteTimeout: &backendconfigv1.DurationConfig{Seconds: 10},
+		},
+	}
+	gceURLMap := &utils.GCEURLMap{
+		DefaultBackend: &utils.ServicePort{NodePort: 30000, BackendNamer: namer},
+		HostRules: []utils.HostRule{
+			{
+				Hostname: "foo.bar.com",
+				Paths: []utils.PathRule{
+					{
+						Path:    "/*",
+						Backend: utils.ServicePort{NodePort: 33500, BackendNamer: namer},
+					},
+					{
+						Path:    "/api/*",
+						Backend: utils.ServicePort{NodePort: 34000, BackendNamer: namer},
+					},
+					{
+						Path:    "/",
+						Backend: utils.ServicePort{NodePort: 33000, BackendNamer: namer},
+					},
+				},
+				RouteRules: []utils.RouteRule{
+					{
+						Matches: []routeconfigv1beta1.RouteMatch{
+							{
+								Headers: []routeconfigv1beta1.HeaderMatch{{Name: "x-canary", ExactMatch: "true"}},
+							},
+							{
+								PrefixMatch:     "/api/",
+								QueryParameters: []routeconfigv1beta1.QueryParameterMatch{{Name: "version", PresentMatch: true}},
+							},
+						},
+						Backend: utils.ServicePort{NodePort: 35000, BackendNamer: namer},
+					},
+					{
+						Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/blue-green/"}},
+						WeightedBackends: []utils.WeightedBackend{
+							{Backend: utils.ServicePort{NodePort: 36000, BackendNamer: namer}, Weight: 75},
+							{Backend: utils.ServicePort{NodePort: 36500, BackendNamer: namer}, Weight: 25},
+						},
+					},
+					{
+						Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/canary/"}},
+						WeightedBackends: []utils.WeightedBackend{
+							{Backend: utils.ServicePort{NodePort: 37000, BackendNamer: namer, BackendConfig: canaryBackendConfig}, Weight: 90},
+							{Backend: utils.ServicePort{NodePort: 37500, BackendNamer: namer, BackendConfig: canaryBackendConfig}, Weight: 10},
+						},
+						UrlRewrite: &routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/"},
+					},
+				},
+			},
+		},
+	}
+
+	wantComputeMap := &composite.UrlMap{
+		Name:           "k8s-um-lb-name",
+		DefaultService: "global/backendServices/k8s-be-30000--uid1",
+		HostRules: []*composite.HostRule{
+			{
+				Hosts:       []string{"foo.bar.com"},
+				PathMatcher: "host2d50cf9711f59181be6a5e5658e42c21",
+			},
+		},
+		PathMatchers: []*composite.PathMatcher{
+			{
+				DefaultService: "global/backendServices/k8s-be-30000--uid1",
+				Name:           "host2d50cf9711f59181be6a5e5658e42c21",
+				RouteRules: []*composite.HttpRouteRule{
+					{
+						Priority: 1,
+						MatchRules: []*composite.HttpRouteRuleMatch{
+							{
+								PrefixMatch:   "/",
+								HeaderMatches: []*composite.HttpHeaderMatch{{HeaderName: "x-canary", ExactMatch: "true"}},
+							},
+							{
+								PrefixMatch:           "/api/",
+								QueryParameterMatches: []*composite.HttpQueryParameterMatch{{Name: "version", PresentMatch: true}},
+							},
+						},
+						Service: "global/backendServices/k8s-be-35000--uid1",
+					},
+					{
+						Priority:   2,
+						MatchRules: []*composite.HttpRouteRuleMatch{{PrefixMatch: "/blue-green/"}},
+						RouteAction: &composite.HttpRouteAction{
+							WeightedBackendServices: []*composite.WeightedBackendService{
+								{BackendService: "global/backendServices/k8s-be-36000--uid1", Weight: 75},
+								{BackendService: "global/backendServices/k8s-be-36500--uid1", Weight: 25},
+							},
+						},
+					},
+					{
+						Priority:   3,
+						MatchRules: []*composite.HttpRouteRuleMatch{{PrefixMatch: "/canary/"}},
+						RouteAction: &composite.HttpRouteAction{
+							WeightedBackendServices: []*composite.WeightedBackendService{
+								{BackendService: "global/backendServices/k8s-be-37000--uid1", Weight: 90},
+								{BackendService: "global/backendServices/k8s-be-37500--uid1", Weight: 10},
+							},
+							UrlRewrite: &composite.UrlRewrite{PathPrefixRewrite: "/"},
+							Timeout:    &composite.Duration{Seconds: 10},
+						},
+					},
+					{
+						Priority:   4,
+						MatchRules: []*composite.HttpRouteRuleMatch{{FullPathMatch: "/"}},
+						Service:    "global/backendServices/k8s-be-33000--uid1",
+					},
+					{
+						Priority:   5,
+						MatchRules: []*composite.HttpRouteRuleMatch{{PrefixMatch: "/api/"}},
+						Service:    "global/backendServices/k8s-be-34000--uid1",
+					},
+					{
+						Priority:   6,
+						MatchRules: []*composite.HttpRouteRuleMatch{{PrefixMatch: "/"}},
+						Service:    "global/backendServices/k8s-be-33500--uid1",
+					},
+				},
+			},
+		},
+	}
+
+	namerFactory := namer_util.NewFrontendNamerFactory(namer, "")
+	feNamer := namerFactory.NamerForLoadBalancer("lb-name")
+	gotComputeURLMap := ToCompositeURLMap(gceURLMap, feNamer, meta.GlobalKey("ns-lb-name"))
+	if diff := cmp.Diff(wantComputeMap, gotComputeURLMap); diff != "" {
+		t.Errorf("Unexpected diff from ToComputeURLMap() (-want +got):\n%s", diff)
+	}
+}
+
 func TestToRedirectUrlMap(t *testing.T) {
 	t.Parallel()
 
@@ -372,6 +1090,171 @@ func TestSecrets(t *testing.T) {
 	}
 }
 
+func TestToTLSCerts(t *testing.T) {
+	notAfter := time.Now().Add(365 * 24 * time.Hour).Truncate(time.Second)
+	expiredNotAfter := time.Now().Add(-time.Hour).Truncate(time.Second)
+	newSecret := func(name string, notAfter time.Time, hosts ...string) *api_v1.Secret {
+		cert, key, err := test.GenerateCert(notAfter, hosts...)
+		if err != nil {
+			t.Fatalf("test.GenerateCert() = %v", err)
+		}
+		return &api_v1.Secret{
+			ObjectMeta: meta_v1.ObjectMeta{Name: name},
+			Data: map[string][]byte{
+				api_v1.TLSCertKey:       cert,
+				api_v1.TLSPrivateKeyKey: key,
+			},
+		}
+	}
+	intermediate, _, err := test.GenerateCert(notAfter.Add(-24*time.Hour), "intermediate")
+	if err != nil {
+		t.Fatalf("test.GenerateCert() = %v", err)
+	}
+	chainSecret := newSecret("chain", notAfter, "foo.example.com")
+	chainSecret.Data[api_v1.TLSCertKey] = append(chainSecret.Data[api_v1.TLSCertKey], intermediate...)
+
+	secretsMap := map[string]*api_v1.Secret{
+		"valid":    newSecret("valid", notAfter, "foo.example.com"),
+		"wildcard": newSecret("wildcard", notAfter, "*.example.com"),
+		"expired":  newSecret("expired", expiredNotAfter, "foo.example.com"),
+		"other":    newSecret("other", notAfter, "foo.other.com"),
+		"chain":    chainSecret,
+		"invalid": {
+			ObjectMeta: meta_v1.ObjectMeta{Name: "invalid"},
+			Data: map[string][]byte{
+				api_v1.TLSCertKey:       []byte("cert"),
+				api_v1.TLSPrivateKeyKey: []byte("private key"),
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		desc         string
+		hosts        []string
+		ruleHosts    []string
+		secret       string
+		wantNotAfter time.Time
+		wantErr      bool
+	}{
+		{
+			desc:         "valid certificate",
+			hosts:        []string{"foo.example.com"},
+			secret:       "valid",
+			wantNotAfter: notAfter,
+		},
+		{
+			desc:         "certificate covers one of the hosts",
+			hosts:        []string{"bar.other.com", "foo.example.com"},
+			secret:       "valid",
+			wantNotAfter: notAfter,
+		},
+		{
+			desc:         "TLS entry without hosts",
+			ruleHosts:    []string{"foo.example.com"},
+			secret:       "other",
+			wantNotAfter: notAfter,
+		},
+		{
+			desc:         "hosts of the rules are not checked",
+			hosts:        []string{"foo.example.com"},
+			ruleHosts:    []string{"foo.other.com"},
+			secret:       "valid",
+			wantNotAfter: notAfter,
+		},
+		{
+			desc:         "wildcard certificate",
+			hosts:        []string{"bar.example.com"},
+			secret:       "wildcard",
+			wantNotAfter: notAfter,
+		},
+		{
+			desc:         "wildcard host",
+			hosts:        []string{"*.example.com"},
+			secret:       "wildcard",
+			wantNotAfter: notAfter,
+		},
+		{
+			desc:    "wildcard host not covered by certificate",
+			hosts:   []string{"*.example.com"},
+			secret:  "valid",
+			wantErr: true,
+		},
+		{
+			desc:    "wildcard certificate does not cover subdomains",
+			hosts:   []string{"foo.bar.example.com"},
+			secret:  "wildcard",
+			wantErr: true,
+		},
+		{
+			desc:    "certificate does not cover any host",
+			hosts:   []string{"foo.example.com"},
+			secret:  "other",
+			wantErr: true,
+		},
+		{
+			desc:         "expired certificate",
+			hosts:        []string{"foo.example.com"},
+			secret:       "expired",
+			wantNotAfter: expiredNotAfter,
+		},
+		{
+			desc:    "invalid certificate",
+			hosts:   []string{"foo.example.com"},
+			secret:  "invalid",
+			wantErr: true,
+		},
+		{
+			desc:         "chain expires with the leaf certificate",
+			hosts:        []string{"foo.example.com"},
+			secret:       "chain",
+			wantNotAfter: notAfter,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ing := &v1.Ingress{
+				Spec: v1.IngressSpec{
+					TLS: []v1.IngressTLS{{SecretName: tc.secret, Hosts: tc.hosts}},
+				},
+			}
+			for _, host := range tc.ruleHosts {
+				ing.Spec.Rules = append(ing.Spec.Rules, v1.IngressRule{Host: host})
+			}
+			env := &Env{Ing: ing, SecretsMap: secretsMap}
+
+			certs, errs := ToTLSCerts(env)
+			if tc.wantErr {
+				if len(errs) != 1 || len(certs) != 0 {
+					t.Fatalf("ToTLSCerts() = %v, %v, want no certs and an error", certs, errs)
+				}
+				if _, ok := errs[0].(*InvalidCertError); !ok {
+					t.Errorf("ToTLSCerts() error = %v, want an InvalidCertError", errs[0])
+				}
+				return
+			}
+			if len(errs) != 0 || len(certs) != 1 {
+				t.Fatalf("ToTLSCerts() = %v, %v, want one cert and no errors", certs, errs)
+			}
+			if !certs[0].NotAfter.Equal(tc.wantNotAfter) {
+				t.Errorf("ToTLSCerts()[0].NotAfter = %v, want %v", certs[0].NotAfter, tc.wantNotAfter)
+			}
+		})
+	}
+
+	// An invalid certificate is left out without affecting the others.
+	ing := &v1.Ingress{
+		Spec: v1.IngressSpec{
+			TLS: []v1.IngressTLS{
+				{SecretName: "invalid", Hosts: []string{"foo.example.com"}},
+				{SecretName: "valid", Hosts: []string{"foo.example.com"}},
+			},
+		},
+	}
+	certs, errs := ToTLSCerts(&Env{Ing: ing, SecretsMap: secretsMap})
+	if len(certs) != 1 || certs[0].Name != "valid" || len(errs) != 1 {
+		t.Errorf("ToTLSCerts() = %v, %v, want the valid cert and one error", certs, errs)
+	}
+}
+
 func TestToForwardingRule(t *testing.T) {
 	proxyLink := "my-proxy"
 	description := "foo"
@@ -575,12 +1458,15 @@ func TestToCompositeTargetHttpsProxy(t *testing.T) {
 	description := "foo"
 
 	testCases := []struct {
-		desc      string
-		urlMapKey *meta.Key
-		sslCerts  []*composite.SslCertificate
-		sslPolicy *string
-		version   meta.Version
-		want      *composite.TargetHttpsProxy
+		desc            string
+		urlMapKey       *meta.Key
+		sslCerts        []*composite.SslCertificate
+		sslPolicy       *string
+		certMap         *string
+		serverTlsPolicy *string
+		quicOverride    *string
+		version         meta.Version
+		want            *composite.TargetHttpsProxy
 	}{
 		{
 			desc:      "https xlb",
@@ -628,13 +1514,90 @@ func TestToCompositeTargetHttpsProxy(t *testing.T) {
 				SslPolicy:   "global/sslPolicies/test-policy",
 			},
 		},
+		{
+			desc:      "https xlb with certificate map name",
+			urlMapKey: meta.GlobalKey("my-url-map"),
+			version:   meta.VersionGA,
+			certMap:   utils.NewStringPointer("test-map"),
+			want: &composite.TargetHttpsProxy{
+				Name:           "foo-tp",
+				Description:    description,
+				Version:        meta.VersionGA,
+				UrlMap:         "global/urlMaps/my-url-map",
+				CertificateMap: "//certificatemanager.googleapis.com/projects/test-project/locations/global/certificateMaps/test-map",
+			},
+		},
+		{
+			desc:      "https xlb with certificate map url",
+			urlMapKey: meta.GlobalKey("my-url-map"),
+			version:   meta.VersionGA,
+			certMap:   utils.NewStringPointer("//certificatemanager.googleapis.com/projects/other-project/locations/global/certificateMaps/test-map"),
+			want: &composite.TargetHttpsProxy{
+				Name:           "foo-tp",
+				Description:    description,
+				Version:        meta.VersionGA,
+				UrlMap:         "global/urlMaps/my-url-map",
+				CertificateMap: "//certificatemanager.googleapis.com/projects/other-project/locations/global/certificateMaps/test-map",
+			},
+		},
+		{
+			desc:            "https xlb with server tls policy name",
+			urlMapKey:       meta.GlobalKey("my-url-map"),
+			version:         meta.VersionGA,
+			serverTlsPolicy: utils.NewStringPointer("test-policy"),
+			want: &composite.TargetHttpsProxy{
+				Name:            "foo-tp",
+				Description:     description,
+				Version:         meta.VersionGA,
+				UrlMap:          "global/urlMaps/my-url-map",
+				ServerTlsPolicy: "//networksecurity.googleapis.com/projects/test-project/locations/global/serverTlsPolicies/test-policy",
+			},
+		},
+		{
+			desc:            "https xlb with server tls policy url",
+			urlMapKey:       meta.GlobalKey("my-url-map"),
+			version:         meta.VersionGA,
+			serverTlsPolicy: utils.NewStringPointer("projects/other-project/locations/global/serverTlsPolicies/test-policy"),
+			want: &composite.TargetHttpsProxy{
+				Name:            "foo-tp",
+				Description:     description,
+				Version:         meta.VersionGA,
+				UrlMap:          "global/urlMaps/my-url-map",
+				ServerTlsPolicy: "projects/other-project/locations/global/serverTlsPolicies/test-policy",
+			},
+		},
+		{
+			desc:         "https xlb with quic override",
+			urlMapKey:    meta.GlobalKey("my-url-map"),
+			version:      meta.VersionGA,
+			quicOverride: utils.NewStringPointer("ENABLE"),
+			want: &composite.TargetHttpsProxy{
+				Name:         "foo-tp",
+				Description:  description,
+				Version:      meta.VersionGA,
+				UrlMap:       "global/urlMaps/my-url-map",
+				QuicOverride: "ENABLE",
+			},
+		},
+		{
+			desc:            "https xlb with empty server tls policy",
+			urlMapKey:       meta.GlobalKey("my-url-map"),
+			version:         meta.VersionGA,
+			serverTlsPolicy: utils.NewStringPointer(""),
+			want: &composite.TargetHttpsProxy{
+				Name:        "foo-tp",
+				Description: description,
+				Version:     meta.VersionGA,
+				UrlMap:      "global/urlMaps/my-url-map",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
 			// isL7ILB or isL7XLBRegional doesn't affect the outcome here since the key is creating during ensure
 			tr := NewTranslator(false, false, &testNamer{"foo"})
-			env := &Env{FrontendConfig: &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{SslPolicy: tc.sslPolicy}}}
+			env := &Env{Project: "test-project", FrontendConfig: &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{SslPolicy: tc.sslPolicy, CertificateMap: tc.certMap, ServerTlsPolicy: tc.serverTlsPolicy, QuicOverride: tc.quicOverride}}}
 			got, sslPolicySet, err := tr.ToCompositeTargetHttpsProxy(env, description, tc.version, tc.urlMapKey, tc.sslCerts)
 			if err != nil {
 				t.Fatal(err)
@@ -658,6 +1621,7 @@ func TestToCompositeSSLCertificates(t *testing.T) {
 		want     []*composite.SslCertificate
 		tlsName  string
 		tlsCerts []*TLSCerts
+		managed  *frontendconfigv1beta1.ManagedCertificatesConfig
 	}{
 		{
 			desc:    "One pre-shared cert",
@@ -719,12 +1683,46 @@ func TestToCompositeSSLCertificates(t *testing.T) {
 				&composite.SslCertificate{Name: "foo-cert-hash-2", Certificate: "cert-2", PrivateKey: "key-2", SelfLink: "https://www.googleapis.com/compute/v1/projects//global/sslCertificates/foo-cert-hash-2"},
 			},
 		},
+		{
+			desc:    "Managed cert",
+			managed: &frontendconfigv1beta1.ManagedCertificatesConfig{Domains: []string{"foo.example.com", "bar.example.com"}},
+			want: []*composite.SslCertificate{
+				&composite.SslCertificate{
+					Name:     "foo-cert-" + GetCertHash("bar.example.com,foo.example.com"),
+					Type:     "MANAGED",
+					Managed:  &composite.SslCertificateManagedSslCertificate{Domains: []string{"bar.example.com", "foo.example.com"}},
+					SelfLink: "https://www.googleapis.com/compute/v1/projects//global/sslCertificates/foo-cert-" + GetCertHash("bar.example.com,foo.example.com"),
+				},
+			},
+		},
+		{
+			desc:    "One pre-shared, one tls, one managed cert",
+			tlsName: "pre-shared-1",
+			tlsCerts: []*TLSCerts{
+				&TLSCerts{Key: "key-1", Cert: "cert-1", Name: "tlscert-1", CertHash: "hash-1"},
+			},
+			managed: &frontendconfigv1beta1.ManagedCertificatesConfig{Domains: []string{"foo.example.com"}},
+			want: []*composite.SslCertificate{
+				&composite.SslCertificate{Name: "pre-shared-1", SelfLink: "https://www.googleapis.com/compute/v1/projects//global/sslCertificates/pre-shared-1"},
+				&composite.SslCertificate{Name: "foo-cert-hash-1", Certificate: "cert-1", PrivateKey: "key-1", SelfLink: "https://www.googleapis.com/compute/v1/projects//global/sslCertificates/foo-cert-hash-1"},
+				&composite.SslCertificate{
+					Name:     "foo-cert-" + GetCertHash("foo.example.com"),
+					Type:     "MANAGED",
+					Managed:  &composite.SslCertificateManagedSslCertificate{Domains: []string{"foo.example.com"}},
+					SelfLink: "https://www.googleapis.com/compute/v1/projects//global/sslCertificates/foo-cert-" + GetCertHash("foo.example.com"),
+				},
+			},
+		},
+		{
+			desc:    "Managed cert without domains",
+			managed: &frontendconfigv1beta1.ManagedCertificatesConfig{},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
 			tr := NewTranslator(false, false, &testNamer{"foo"})
-			env := &Env{Region: tc.region}
+			env := &Env{Region: tc.region, FrontendConfig: &frontendconfigv1beta1.FrontendConfig{Spec: frontendconfigv1beta1.FrontendConfigSpec{ManagedCertificates: tc.managed}}}
 			got := tr.ToCompositeSSLCertificates(env, tc.tlsName, tc.tlsCerts, meta.VersionGA)
 
 			if diff := cmp.Diff(tc.want, got); diff != "" {
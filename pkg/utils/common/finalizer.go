@@ -32,6 +32,14 @@ const (
 	// FinalizerKeyV2 is the string representing the Ingress finalizer version.
 	// Ingress with V2 finalizer uses V2 frontend naming scheme.
 	FinalizerKeyV2 = "networking.gke.io/ingress-finalizer-V2"
+	// GatewayFinalizerKey is the finalizer used by the gateway controller to ensure
+	// load balancer resources are deleted before the corresponding Gateway.
+	// Gateways use Gateway frontend naming scheme.
+	GatewayFinalizerKey = "networking.gke.io/gateway-finalizer"
+	// SharedLBGroupFinalizerKey identifies the Ingress that represents a shared
+	// load balancer group. It is never added to an Ingress object, it only
+	// selects the shared load balancer group frontend naming scheme.
+	SharedLBGroupFinalizerKey = "networking.gke.io/shared-lb-group-finalizer"
 	// TODO remove the 2 definitions once they are added in legacy-cloud-providers/gce
 	// LegacyILBFinalizer key is used to identify ILB services whose resources are managed by service controller.
 	LegacyILBFinalizer = "gke.networking.io/l4-ilb-v1"
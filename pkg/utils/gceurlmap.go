@@ -15,8 +15,10 @@ package utils
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/klog/v2"
 )
 
@@ -28,6 +30,8 @@ import (
 //  3. Adding paths for a hostname replaces existing for that host.
 type GCEURLMap struct {
 	DefaultBackend *ServicePort
+	// DefaultUrlRewrite rewrites the URL of requests sent to the DefaultBackend.
+	DefaultUrlRewrite *routeconfigv1beta1.UrlRewrite
 	// HostRules is an ordered list of hostnames, path rule tuples.
 	HostRules []HostRule
 	// hosts is a map of existing hosts.
@@ -35,15 +39,56 @@ type GCEURLMap struct {
 }
 
 // HostRule encapsulates the Hostname and its list of PathRules.
+// RouteRules are evaluated in order before any of the PathRules.
+// HeaderAction applies to all requests to the host, and CustomErrorResponsePolicy
+// to all error responses for the host.
 type HostRule struct {
-	Hostname string
-	Paths    []PathRule
+	Hostname                  string
+	Paths                     []PathRule
+	RouteRules                []RouteRule
+	HeaderAction              *routeconfigv1beta1.HeaderAction
+	CustomErrorResponsePolicy *routeconfigv1beta1.CustomErrorResponsePolicy
 }
 
 // PathRule encapsulates the information for a single path -> backend mapping.
+// HeaderAction and UrlRewrite apply to the requests matching the path, and
+// CustomErrorResponsePolicy to their error responses.
 type PathRule struct {
-	Path    string
+	Path                      string
+	Backend                   ServicePort
+	HeaderAction              *routeconfigv1beta1.HeaderAction
+	CustomErrorResponsePolicy *routeconfigv1beta1.CustomErrorResponsePolicy
+	UrlRewrite                *routeconfigv1beta1.UrlRewrite
+}
+
+// RouteRule encapsulates the information for a single match -> backend mapping.
+// A request is sent to the backend if any of the matches is satisfied.
+// If WeightedBackends is set, requests are split between them and Backend is unused.
+type RouteRule struct {
+	Matches          []routeconfigv1beta1.RouteMatch
+	Backend          ServicePort
+	WeightedBackends []WeightedBackend
+	HeaderAction     *routeconfigv1beta1.HeaderAction
+	UrlRewrite       *routeconfigv1beta1.UrlRewrite
+}
+
+// WeightedBackend is a backend that receives a share of the traffic of a RouteRule
+// proportional to its weight.
+type WeightedBackend struct {
 	Backend ServicePort
+	Weight  int64
+}
+
+// ServicePorts returns the ServicePorts the RouteRule sends traffic to.
+func (r RouteRule) ServicePorts() []ServicePort {
+	if len(r.WeightedBackends) == 0 {
+		return []ServicePort{r.Backend}
+	}
+	var svcPorts []ServicePort
+	for _, wb := range r.WeightedBackends {
+		svcPorts = append(svcPorts, wb.Backend)
+	}
+	return svcPorts
 }
 
 // NewGCEURLMap returns an empty GCEURLMap
@@ -57,7 +102,10 @@ func EqualMapping(a, b *GCEURLMap) bool {
 	if (a.DefaultBackend != nil) != (b.DefaultBackend != nil) {
 		return false
 	}
-	if a.DefaultBackend != nil && a.DefaultBackend.ID != b.DefaultBackend.ID {
+	if a.DefaultBackend != nil && (a.DefaultBackend.ID != b.DefaultBackend.ID || !equalMirrorBackend(*a.DefaultBackend, *b.DefaultBackend)) {
+		return false
+	}
+	if !reflect.DeepEqual(a.DefaultUrlRewrite, b.DefaultUrlRewrite) {
 		return false
 	}
 
@@ -70,6 +118,12 @@ func EqualMapping(a, b *GCEURLMap) bool {
 		if aRules.Hostname != bRules.Hostname {
 			return false
 		}
+		if !reflect.DeepEqual(aRules.HeaderAction, bRules.HeaderAction) {
+			return false
+		}
+		if !reflect.DeepEqual(aRules.CustomErrorResponsePolicy, bRules.CustomErrorResponsePolicy) {
+			return false
+		}
 
 		if len(aRules.Paths) != len(bRules.Paths) {
 			return false
@@ -83,18 +137,91 @@ func EqualMapping(a, b *GCEURLMap) bool {
 			if aPath.Backend.ID != bPath.Backend.ID {
 				return false
 			}
+			if !equalMirrorBackend(aPath.Backend, bPath.Backend) {
+				return false
+			}
+			if !reflect.DeepEqual(aPath.HeaderAction, bPath.HeaderAction) {
+				return false
+			}
+			if !reflect.DeepEqual(aPath.CustomErrorResponsePolicy, bPath.CustomErrorResponsePolicy) {
+				return false
+			}
+			if !reflect.DeepEqual(aPath.UrlRewrite, bPath.UrlRewrite) {
+				return false
+			}
+		}
+
+		if len(aRules.RouteRules) != len(bRules.RouteRules) {
+			return false
+		}
+
+		for i, aRoute := range aRules.RouteRules {
+			bRoute := bRules.RouteRules[i]
+			if !reflect.DeepEqual(aRoute.Matches, bRoute.Matches) {
+				return false
+			}
+			if aRoute.Backend.ID != bRoute.Backend.ID {
+				return false
+			}
+			if !equalMirrorBackend(aRoute.Backend, bRoute.Backend) {
+				return false
+			}
+			if !reflect.DeepEqual(aRoute.HeaderAction, bRoute.HeaderAction) {
+				return false
+			}
+			if !reflect.DeepEqual(aRoute.UrlRewrite, bRoute.UrlRewrite) {
+				return false
+			}
+			if len(aRoute.WeightedBackends) != len(bRoute.WeightedBackends) {
+				return false
+			}
+			for i, aBackend := range aRoute.WeightedBackends {
+				bBackend := bRoute.WeightedBackends[i]
+				if aBackend.Backend.ID != bBackend.Backend.ID || aBackend.Weight != bBackend.Weight {
+					return false
+				}
+			}
 		}
 	}
 	return true
 }
 
+// equalMirrorBackend returns true if both ServicePorts are mirrored to the
+// same ServicePortID, or neither is mirrored.
+func equalMirrorBackend(a, b ServicePort) bool {
+	if a.MirrorBackend == nil || b.MirrorBackend == nil {
+		return a.MirrorBackend == b.MirrorBackend
+	}
+	return a.MirrorBackend.ID == b.MirrorBackend.ID
+}
+
 // PutPathRulesForHost adds path rules for a single hostname.
+// If the hostname already exists, the path rules are merged into its existing
+// path rules. If two paths are equal, the one added last is the winner.
 // This function ensures the invariants of the GCEURLMap are maintained.
 // It will log if an invariant violation was found and reconciled.
 // TODO(rramkumar): Surface an error instead of logging.
 func (g *GCEURLMap) PutPathRulesForHost(hostname string, pathRules []PathRule) {
-	// Convert the path rules to a map to filter out two equal paths
-	// Note that the if two paths are equal, the one later in the list is the winner.
+	if g.hosts[hostname] {
+		for i := range g.HostRules {
+			if g.HostRules[i].Hostname == hostname {
+				klog.V(4).Infof("Merging path rules for host %v", hostname)
+				g.HostRules[i].Paths = uniquePathRules(append(g.HostRules[i].Paths, pathRules...))
+			}
+		}
+		return
+	}
+
+	g.HostRules = append(g.HostRules, HostRule{
+		Hostname: hostname,
+		Paths:    uniquePathRules(pathRules),
+	})
+	g.hosts[hostname] = true
+}
+
+// uniquePathRules filters out equal paths. Note that if two paths are equal,
+// the one later in the list is the winner.
+func uniquePathRules(pathRules []PathRule) []PathRule {
 	seen := make(map[string]bool)
 	var uniquePathRules []PathRule
 	for x := len(pathRules) - 1; x >= 0; x-- {
@@ -109,36 +236,103 @@ func (g *GCEURLMap) PutPathRulesForHost(hostname string, pathRules []PathRule) {
 
 		uniquePathRules = append([]PathRule{pathRule}, uniquePathRules...)
 	}
-	hr := HostRule{
-		Hostname: hostname,
-		Paths:    uniquePathRules,
+	return uniquePathRules
+}
+
+// PutRouteRulesForHost sets the route rules for a single hostname, replacing
+// any existing route rules for it. Path rules of the host are kept. The host
+// is added to the GCEURLMap if it does not exist yet.
+func (g *GCEURLMap) PutRouteRulesForHost(hostname string, routeRules []RouteRule) {
+	if g.hosts[hostname] {
+		for i := range g.HostRules {
+			if g.HostRules[i].Hostname == hostname {
+				g.HostRules[i].RouteRules = routeRules
+			}
+		}
+		return
 	}
 
-	_, exists := g.hosts[hostname]
-	if exists {
-		klog.V(4).Infof("Overwriting path rules for host %v", hostname)
-		g.deleteHost(hostname)
+	g.HostRules = append(g.HostRules, HostRule{
+		Hostname:   hostname,
+		RouteRules: routeRules,
+	})
+	g.hosts[hostname] = true
+}
+
+// PutHeaderActionForHost sets the header action applied to all requests to a
+// single hostname. The host is added to the GCEURLMap if it does not exist yet.
+func (g *GCEURLMap) PutHeaderActionForHost(hostname string, headerAction *routeconfigv1beta1.HeaderAction) {
+	if g.hosts[hostname] {
+		for i := range g.HostRules {
+			if g.HostRules[i].Hostname == hostname {
+				g.HostRules[i].HeaderAction = headerAction
+			}
+		}
+		return
 	}
 
-	g.HostRules = append(g.HostRules, hr)
+	g.HostRules = append(g.HostRules, HostRule{
+		Hostname:     hostname,
+		HeaderAction: headerAction,
+	})
 	g.hosts[hostname] = true
-	return
+}
+
+// PutCustomErrorResponsePolicyForHost sets the custom error response policy
+// applied to all error responses for a single hostname. The host is added to
+// the GCEURLMap if it does not exist yet.
+func (g *GCEURLMap) PutCustomErrorResponsePolicyForHost(hostname string, policy *routeconfigv1beta1.CustomErrorResponsePolicy) {
+	if g.hosts[hostname] {
+		for i := range g.HostRules {
+			if g.HostRules[i].Hostname == hostname {
+				g.HostRules[i].CustomErrorResponsePolicy = policy
+			}
+		}
+		return
+	}
+
+	g.HostRules = append(g.HostRules, HostRule{
+		Hostname:                  hostname,
+		CustomErrorResponsePolicy: policy,
+	})
+	g.hosts[hostname] = true
+}
+
+// HasPathHeaderActions returns true if any path of the host rule has a header action.
+func (h HostRule) HasPathHeaderActions() bool {
+	for _, p := range h.Paths {
+		if p.HeaderAction != nil {
+			return true
+		}
+	}
+	return false
 }
 
 // AllServicePorts return a list of all ServicePorts contained in the GCEURLMap.
 func (g *GCEURLMap) AllServicePorts() (svcPorts []ServicePort) {
 
 	uniqueServerPorts := make(map[ServicePortID]bool)
+
+	// Shadow Services of mirrored backends are included, so that their
+	// backends are synced and garbage collected like any other backend.
+	add := func(sp ServicePort) {
+		for _, svcPort := range []*ServicePort{&sp, sp.MirrorBackend} {
+			if svcPort != nil && !uniqueServerPorts[svcPort.ID] {
+				svcPorts = append(svcPorts, *svcPort)
+				uniqueServerPorts[svcPort.ID] = true
+			}
+		}
+	}
 	if g.DefaultBackend != nil {
-		svcPorts = append(svcPorts, *g.DefaultBackend)
-		uniqueServerPorts[*&g.DefaultBackend.ID] = true
+		add(*g.DefaultBackend)
 	}
-
 	for _, rules := range g.HostRules {
 		for _, rule := range rules.Paths {
-			if !uniqueServerPorts[rule.Backend.ID] {
-				svcPorts = append(svcPorts, rule.Backend)
-				uniqueServerPorts[rule.Backend.ID] = true
+			add(rule.Backend)
+		}
+		for _, rule := range rules.RouteRules {
+			for _, backend := range rule.ServicePorts() {
+				add(backend)
 			}
 		}
 	}
@@ -192,6 +386,14 @@ func (g *GCEURLMap) String() string {
 			b.WriteString(fmt.Sprintf("\t%v: ", rule.Path))
 			b.WriteString(fmt.Sprintf("%+v\n", rule.Backend))
 		}
+		for _, rule := range hostRule.RouteRules {
+			b.WriteString(fmt.Sprintf("\t%+v: ", rule.Matches))
+			if len(rule.WeightedBackends) > 0 {
+				b.WriteString(fmt.Sprintf("%+v\n", rule.WeightedBackends))
+				continue
+			}
+			b.WriteString(fmt.Sprintf("%+v\n", rule.Backend))
+		}
 	}
 	b.WriteString(fmt.Sprintf("Default Backend: %+v", g.DefaultBackend))
 	return b.String()
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	v1 "k8s.io/api/networking/v1"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 )
 
 func TestGCEURLMap(t *testing.T) {
@@ -52,20 +53,24 @@ func TestGCEURLMap(t *testing.T) {
 		t.Errorf("Expected path /test2 for hostname example.com to exist in %+v", urlMap)
 	}
 
-	// Add some path rules for the same host. Ensure this results in an overwrite.
+	// Add some path rules for the same host. Ensure this results in a merge.
 	rules = []PathRule{
 		PathRule{Path: "/test3", Backend: ServicePort{NodePort: 30002}},
+		PathRule{Path: "/test2", Backend: ServicePort{NodePort: 30006}},
 	}
 	urlMap.PutPathRulesForHost("example.com", rules)
-	if _, ok := urlMap.PathExists("example.com", "/test1"); ok {
-		t.Errorf("Expected path /test1 for hostname example.com not to exist in %+v", urlMap)
+	if _, ok := urlMap.PathExists("example.com", "/test1"); !ok {
+		t.Errorf("Expected path /test1 for hostname example.com to exist in %+v", urlMap)
 	}
-	if _, ok := urlMap.PathExists("example.com", "/test2"); ok {
-		t.Errorf("Expected path /test2 for hostname example.com not to exist in %+v", urlMap)
+	if backend, ok := urlMap.PathExists("example.com", "/test2"); !ok || backend.NodePort != 30006 {
+		t.Errorf("Expected path /test2 for hostname example.com to point to backend with NodePort 30006 in %+v", urlMap)
 	}
 	if _, ok := urlMap.PathExists("example.com", "/test3"); !ok {
 		t.Errorf("Expected path /test3 for hostname example.com to exist in %+v", urlMap)
 	}
+	if len(urlMap.HostRules) != 2 {
+		t.Errorf("Expected 2 host rules, got %+v", urlMap.HostRules)
+	}
 
 	// Add some path rules with equal paths. Ensure the last one is taken.
 	rules = []PathRule{
@@ -157,6 +162,179 @@ func TestGCEURLMapEquals(t *testing.T) {
 	if EqualMapping(someMap, diffPaths) {
 		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, diffPaths)
 	}
+
+	// Test check of RouteRules.
+	withRoutes := newTestMap()
+	withRoutes.PutRouteRulesForHost("example.com", newTestRouteRules())
+	if EqualMapping(someMap, withRoutes) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, withRoutes)
+	}
+	equalRoutes := newTestMap()
+	equalRoutes.PutRouteRulesForHost("example.com", newTestRouteRules())
+	if !EqualMapping(withRoutes, equalRoutes) {
+		t.Errorf("EqualMapping(%+v, %+v) = false, want true", withRoutes, equalRoutes)
+	}
+	// Change a RouteRule's match.
+	diffRoutes := newTestMap()
+	diffRoutes.PutRouteRulesForHost("example.com", newTestRouteRules())
+	diffRoutes.HostRules[0].RouteRules[0].Matches[0].Headers[0].ExactMatch = "false"
+	if EqualMapping(withRoutes, diffRoutes) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", withRoutes, diffRoutes)
+	}
+	// Change a RouteRule's backend.
+	diffRoutes = newTestMap()
+	diffRoutes.PutRouteRulesForHost("example.com", newTestRouteRules())
+	diffRoutes.HostRules[0].RouteRules[0].Backend = newServicePortWithID("svc-M", "ns", v1.ServiceBackendPort{Number: 80})
+	if EqualMapping(withRoutes, diffRoutes) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", withRoutes, diffRoutes)
+	}
+	// Split a RouteRule between weighted backends.
+	weighted := newTestMap()
+	weighted.PutRouteRulesForHost("example.com", newTestRouteRules())
+	weighted.HostRules[0].RouteRules[0].WeightedBackends = []WeightedBackend{
+		{Backend: newServicePortWithID("svc-canary", "ns", v1.ServiceBackendPort{Number: 80}), Weight: 10},
+		{Backend: newServicePortWithID("svc-A", "ns", v1.ServiceBackendPort{Number: 80}), Weight: 90},
+	}
+	if EqualMapping(withRoutes, weighted) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", withRoutes, weighted)
+	}
+	// Change a weight.
+	diffWeights := newTestMap()
+	diffWeights.PutRouteRulesForHost("example.com", newTestRouteRules())
+	diffWeights.HostRules[0].RouteRules[0].WeightedBackends = []WeightedBackend{
+		{Backend: newServicePortWithID("svc-canary", "ns", v1.ServiceBackendPort{Number: 80}), Weight: 50},
+		{Backend: newServicePortWithID("svc-A", "ns", v1.ServiceBackendPort{Number: 80}), Weight: 50},
+	}
+	if EqualMapping(weighted, diffWeights) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", weighted, diffWeights)
+	}
+
+	// Test check of mirror backends.
+	shadow := newServicePortWithID("svc-shadow", "ns", v1.ServiceBackendPort{Number: 80})
+	mirrored := newTestMap()
+	mirrored.HostRules[0].Paths[0].Backend.MirrorBackend = &shadow
+	if EqualMapping(someMap, mirrored) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, mirrored)
+	}
+	mirroredDefault := newTestMap()
+	mirroredDefaultBackend := *mirroredDefault.DefaultBackend
+	mirroredDefaultBackend.MirrorBackend = &shadow
+	mirroredDefault.DefaultBackend = &mirroredDefaultBackend
+	if EqualMapping(someMap, mirroredDefault) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, mirroredDefault)
+	}
+
+	// Test check of HeaderActions.
+	headerAction := &routeconfigv1beta1.HeaderAction{ResponseHeadersToRemove: []string{"server"}}
+	hostHeaders := newTestMap()
+	hostHeaders.PutHeaderActionForHost("example.com", headerAction)
+	if EqualMapping(someMap, hostHeaders) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, hostHeaders)
+	}
+	pathHeaders := newTestMap()
+	pathHeaders.HostRules[0].Paths[0].HeaderAction = headerAction
+	if EqualMapping(someMap, pathHeaders) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, pathHeaders)
+	}
+	routeHeaders := newTestMap()
+	routeHeaders.PutRouteRulesForHost("example.com", newTestRouteRules())
+	routeHeaders.HostRules[0].RouteRules[0].HeaderAction = headerAction
+	if EqualMapping(withRoutes, routeHeaders) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", withRoutes, routeHeaders)
+	}
+
+	// Test check of CustomErrorResponsePolicies.
+	policy := &routeconfigv1beta1.CustomErrorResponsePolicy{
+		ErrorService:       "error-pages",
+		ErrorResponseRules: []routeconfigv1beta1.CustomErrorResponseRule{{MatchResponseCodes: []string{"5xx"}, Path: "/error.html"}},
+	}
+	hostPolicy := newTestMap()
+	hostPolicy.PutCustomErrorResponsePolicyForHost("example.com", policy)
+	if EqualMapping(someMap, hostPolicy) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, hostPolicy)
+	}
+	pathPolicy := newTestMap()
+	pathPolicy.HostRules[0].Paths[0].CustomErrorResponsePolicy = policy
+	if EqualMapping(someMap, pathPolicy) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, pathPolicy)
+	}
+
+	// Test check of UrlRewrites.
+	urlRewrite := &routeconfigv1beta1.UrlRewrite{PathPrefixRewrite: "/"}
+	defaultRewrite := newTestMap()
+	defaultRewrite.DefaultUrlRewrite = urlRewrite
+	if EqualMapping(someMap, defaultRewrite) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, defaultRewrite)
+	}
+	pathRewrite := newTestMap()
+	pathRewrite.HostRules[0].Paths[0].UrlRewrite = urlRewrite
+	if EqualMapping(someMap, pathRewrite) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", someMap, pathRewrite)
+	}
+	routeRewrite := newTestMap()
+	routeRewrite.PutRouteRulesForHost("example.com", newTestRouteRules())
+	routeRewrite.HostRules[0].RouteRules[0].UrlRewrite = urlRewrite
+	if EqualMapping(withRoutes, routeRewrite) {
+		t.Errorf("EqualMapping(%+v, %+v) = true, want false", withRoutes, routeRewrite)
+	}
+}
+
+func TestGCEURLMapPutHeaderAction(t *testing.T) {
+	t.Parallel()
+	urlMap := newTestMap()
+	headerAction := &routeconfigv1beta1.HeaderAction{ResponseHeadersToRemove: []string{"server"}}
+
+	// A header action for an existing host keeps its path rules.
+	urlMap.PutHeaderActionForHost("example.com", headerAction)
+	if _, ok := urlMap.PathExists("example.com", "/ex1"); !ok {
+		t.Errorf("Expected path /ex1 for hostname example.com to exist in %+v", urlMap)
+	}
+	if urlMap.HostRules[0].HeaderAction != headerAction {
+		t.Errorf("HeaderAction = %+v, want %+v", urlMap.HostRules[0].HeaderAction, headerAction)
+	}
+
+	// A header action for a new host adds the host.
+	urlMap.PutHeaderActionForHost("headers.com", headerAction)
+	if !urlMap.HostExists("headers.com") {
+		t.Errorf("Expected hostname headers.com to exist in %+v", urlMap)
+	}
+
+	// Putting path rules for the host merges them and keeps the header action.
+	urlMap.PutPathRulesForHost("example.com", []PathRule{{Path: "/ex1", Backend: newServicePortWithID("svc-A", "ns", v1.ServiceBackendPort{Number: 80})}})
+	for _, hostRule := range urlMap.HostRules {
+		if hostRule.Hostname == "example.com" && hostRule.HeaderAction != headerAction {
+			t.Errorf("HeaderAction = %+v, want %+v", hostRule.HeaderAction, headerAction)
+		}
+	}
+}
+
+func TestGCEURLMapPutRouteRules(t *testing.T) {
+	t.Parallel()
+	urlMap := newTestMap()
+
+	// Route rules for an existing host keep its path rules.
+	urlMap.PutRouteRulesForHost("example.com", newTestRouteRules())
+	if _, ok := urlMap.PathExists("example.com", "/ex1"); !ok {
+		t.Errorf("Expected path /ex1 for hostname example.com to exist in %+v", urlMap)
+	}
+	if got := len(urlMap.HostRules[0].RouteRules); got != 1 {
+		t.Errorf("len(RouteRules) = %d, want 1", got)
+	}
+
+	// Route rules for a new host add the host.
+	urlMap.PutRouteRulesForHost("canary.com", newTestRouteRules())
+	if !urlMap.HostExists("canary.com") {
+		t.Errorf("Expected hostname canary.com to exist in %+v", urlMap)
+	}
+	if got := len(urlMap.HostRules); got != 3 {
+		t.Errorf("len(HostRules) = %d, want 3", got)
+	}
+
+	// Putting route rules again replaces them.
+	urlMap.PutRouteRulesForHost("canary.com", nil)
+	if got := len(urlMap.HostRules[2].RouteRules); got != 0 {
+		t.Errorf("len(RouteRules) = %d, want 0", got)
+	}
 }
 
 func TestAllServicePorts(t *testing.T) {
@@ -178,6 +356,92 @@ func TestAllServicePorts(t *testing.T) {
 
 }
 
+func TestAllServicePortsWithRouteRules(t *testing.T) {
+	t.Parallel()
+	m := newTestMap()
+	m.PutRouteRulesForHost("example.com", newTestRouteRules())
+	wantPorts := []ServicePort{
+		newServicePortWithID("svc-X", "ns", v1.ServiceBackendPort{Number: 80}),
+		newServicePortWithID("svc-A", "ns", v1.ServiceBackendPort{Number: 80}),
+		newServicePortWithID("svc-B", "ns", v1.ServiceBackendPort{Number: 80}),
+		newServicePortWithID("svc-canary", "ns", v1.ServiceBackendPort{Number: 80}),
+		newServicePortWithID("svc-C", "ns", v1.ServiceBackendPort{Number: 80}),
+		newServicePortWithID("svc-D", "ns", v1.ServiceBackendPort{Number: 80}),
+	}
+
+	gotPorts := m.AllServicePorts()
+	if !reflect.DeepEqual(gotPorts, wantPorts) {
+		t.Errorf("AllServicePorts(%+v) = \n%+v\nwant\n%+v", m, gotPorts, wantPorts)
+	}
+}
+
+func TestAllServicePortsWithWeightedBackends(t *testing.T) {
+	t.Parallel()
+	m := NewGCEURLMap()
+	b := newServicePortWithID("svc-X", "ns", v1.ServiceBackendPort{Number: 80})
+	m.DefaultBackend = &b
+	m.PutRouteRulesForHost("example.com", []RouteRule{
+		{
+			Matches: []routeconfigv1beta1.RouteMatch{{PrefixMatch: "/"}},
+			WeightedBackends: []WeightedBackend{
+				{Backend: newServicePortWithID("svc-blue", "ns", v1.ServiceBackendPort{Number: 80}), Weight: 90},
+				{Backend: newServicePortWithID("svc-green", "ns", v1.ServiceBackendPort{Number: 80}), Weight: 10},
+				{Backend: newServicePortWithID("svc-X", "ns", v1.ServiceBackendPort{Number: 80}), Weight: 0},
+			},
+		},
+	})
+	wantPorts := []ServicePort{
+		newServicePortWithID("svc-X", "ns", v1.ServiceBackendPort{Number: 80}),
+		newServicePortWithID("svc-blue", "ns", v1.ServiceBackendPort{Number: 80}),
+		newServicePortWithID("svc-green", "ns", v1.ServiceBackendPort{Number: 80}),
+	}
+
+	gotPorts := m.AllServicePorts()
+	if !reflect.DeepEqual(gotPorts, wantPorts) {
+		t.Errorf("AllServicePorts(%+v) = \n%+v\nwant\n%+v", m, gotPorts, wantPorts)
+	}
+}
+
+func TestAllServicePortsWithMirrorBackends(t *testing.T) {
+	t.Parallel()
+	m := NewGCEURLMap()
+	b := newServicePortWithID("svc-X", "ns", v1.ServiceBackendPort{Number: 80})
+	m.DefaultBackend = &b
+	shadow := newServicePortWithID("svc-shadow", "ns", v1.ServiceBackendPort{Number: 80})
+	mirrored := newServicePortWithID("svc-A", "ns", v1.ServiceBackendPort{Number: 80})
+	mirrored.MirrorBackend = &shadow
+	m.PutPathRulesForHost("example.com", []PathRule{
+		{Path: "/a", Backend: mirrored},
+		{Path: "/b", Backend: newServicePortWithID("svc-B", "ns", v1.ServiceBackendPort{Number: 80})},
+	})
+	wantPorts := []ServicePort{
+		newServicePortWithID("svc-X", "ns", v1.ServiceBackendPort{Number: 80}),
+		mirrored,
+		shadow,
+		newServicePortWithID("svc-B", "ns", v1.ServiceBackendPort{Number: 80}),
+	}
+
+	gotPorts := m.AllServicePorts()
+	if !reflect.DeepEqual(gotPorts, wantPorts) {
+		t.Errorf("AllServicePorts(%+v) = \n%+v\nwant\n%+v", m, gotPorts, wantPorts)
+	}
+}
+
+func TestAllServicePortsWithMirroredDefaultBackend(t *testing.T) {
+	t.Parallel()
+	m := NewGCEURLMap()
+	shadow := newServicePortWithID("svc-shadow", "ns", v1.ServiceBackendPort{Number: 80})
+	b := newServicePortWithID("svc-X", "ns", v1.ServiceBackendPort{Number: 80})
+	b.MirrorBackend = &shadow
+	m.DefaultBackend = &b
+	wantPorts := []ServicePort{b, shadow}
+
+	gotPorts := m.AllServicePorts()
+	if !reflect.DeepEqual(gotPorts, wantPorts) {
+		t.Errorf("AllServicePorts(%+v) = \n%+v\nwant\n%+v", m, gotPorts, wantPorts)
+	}
+}
+
 func TestAllServicePortsDistinct(t *testing.T) {
 	t.Parallel()
 	m := NewGCEURLMap()
@@ -217,3 +481,17 @@ func newTestMap() *GCEURLMap {
 	m.PutPathRulesForHost("foo.bar.com", rules)
 	return m
 }
+
+func newTestRouteRules() []RouteRule {
+	return []RouteRule{
+		{
+			Matches: []routeconfigv1beta1.RouteMatch{
+				{
+					PrefixMatch: "/",
+					Headers:     []routeconfigv1beta1.HeaderMatch{{Name: "x-canary", ExactMatch: "true"}},
+				},
+			},
+			Backend: newServicePortWithID("svc-canary", "ns", v1.ServiceBackendPort{Number: 80}),
+		},
+	}
+}
@@ -28,6 +28,14 @@ const (
 	V1NamingScheme = Scheme("v1")
 	// V2NamingScheme is v2 frontend naming scheme.
 	V2NamingScheme = Scheme("v2")
+	// GatewayNamingScheme is the frontend naming scheme of Gateways. It is the
+	// v2 naming scheme with a suffix that differs from the one of an Ingress
+	// with the same namespace and name.
+	GatewayNamingScheme = Scheme("gateway")
+	// SharedLBGroupNamingScheme is the frontend naming scheme of shared load
+	// balancer groups. It is the v2 naming scheme, keyed by the namespace and
+	// the name of the group instead of an Ingress.
+	SharedLBGroupNamingScheme = Scheme("shared-lb-group")
 	// schemaVersionV2 is suffix to be appended to resource prefix for v2 naming scheme.
 	schemaVersionV2 = "2"
 	// maximumAllowedCombinedLength is the maximum combined length of namespace and
@@ -52,6 +60,11 @@ const (
 	sslCertPrefixV2 = "cr"
 	// clusterUIDLength is length of cluster UID to be included in resource names.
 	clusterUIDLength = 8
+	// gatewayKind is included in the suffix of the load balancer name of Gateways.
+	gatewayKind = "Gateway"
+	// sharedLBGroupKind is included in the suffix of the load balancer name of
+	// shared load balancer groups.
+	sharedLBGroupKind = "SharedLBGroup"
 )
 
 // Scheme is ingress frontend name scheme.
@@ -146,13 +159,35 @@ type V2IngressFrontendNamer struct {
 // URL Map               : k8s2-um-uid01234-namespace-ingress-cysix1wq
 // SSL Certificate       : k8s2-cr-uid01234-<lb-hash>-<secret-hash>
 func newV2IngressFrontendNamer(ing *v1.Ingress, kubeSystemUID string, prefix string) IngressFrontendNamer {
+	return newV2FrontendNamer(ing, kubeSystemUID, prefix, kubeSystemUID, ing.Namespace, ing.Name)
+}
+
+// newV2GatewayFrontendNamer returns a v2 frontend namer for the Ingress that represents
+// a Gateway. The resource names have the same format as the ones of an Ingress, the
+// Gateway kind is included in the hash suffix so that a Gateway and an Ingress with
+// the same namespace and name do not share resources.
+func newV2GatewayFrontendNamer(ing *v1.Ingress, kubeSystemUID string, prefix string) IngressFrontendNamer {
+	return newV2FrontendNamer(ing, kubeSystemUID, prefix, kubeSystemUID, ing.Namespace, ing.Name, gatewayKind)
+}
+
+// newV2SharedLBGroupFrontendNamer returns a v2 frontend namer for the Ingress that
+// represents a shared load balancer group, whose name is the name of the group.
+// The group kind is included in the hash suffix so that a group and an Ingress
+// with the same namespace and name do not share resources.
+func newV2SharedLBGroupFrontendNamer(ing *v1.Ingress, kubeSystemUID string, prefix string) IngressFrontendNamer {
+	return newV2FrontendNamer(ing, kubeSystemUID, prefix, kubeSystemUID, ing.Namespace, ing.Name, sharedLBGroupKind)
+}
+
+// newV2FrontendNamer returns a v2 frontend namer whose load balancer name ends with
+// the hash of the given suffix fields.
+func newV2FrontendNamer(ing *v1.Ingress, kubeSystemUID string, prefix string, suffixFields ...string) IngressFrontendNamer {
 	clusterUID := common.ContentHash(kubeSystemUID, clusterUIDLength)
 	namer := &V2IngressFrontendNamer{ing: ing, prefix: prefix, clusterUID: clusterUID}
 	// Initialize lbName.
 	truncFields := TrimFieldsEvenly(maximumAllowedCombinedLength, ing.Namespace, ing.Name)
 	truncNamespace := truncFields[0]
 	truncName := truncFields[1]
-	suffix := namer.suffix(kubeSystemUID, ing.Namespace, ing.Name)
+	suffix := namer.suffix(suffixFields...)
 	namer.lbName = LoadBalancerName(fmt.Sprintf("%s-%s-%s-%s", clusterUID, truncNamespace, truncName, suffix))
 	return namer
 }
@@ -223,9 +258,10 @@ func (vn *V2IngressFrontendNamer) IsValidLoadBalancer() bool {
 }
 
 // suffix returns hash string of length 8 of a concatenated string generated from
-// uid, namespace and name. These fields in combination define an ingress/load-balancer uniquely.
-func (vn *V2IngressFrontendNamer) suffix(uid, namespace, name string) string {
-	lbString := strings.Join([]string{uid, namespace, name}, ";")
+// uid, namespace and name, and the kind for Gateways and shared load balancer
+// groups. These fields in combination define an ingress/load-balancer uniquely.
+func (vn *V2IngressFrontendNamer) suffix(fields ...string) string {
+	lbString := strings.Join(fields, ";")
 	return common.ContentHash(lbString, 8)
 }
 
@@ -255,6 +291,10 @@ func (rn *FrontendNamerFactory) Namer(ing *v1.Ingress) IngressFrontendNamer {
 		return newV1IngressFrontendNamer(ing, rn.namer)
 	case V2NamingScheme:
 		return newV2IngressFrontendNamer(ing, rn.kubeSystemUID, rn.namer.prefix)
+	case GatewayNamingScheme:
+		return newV2GatewayFrontendNamer(ing, rn.kubeSystemUID, rn.namer.prefix)
+	case SharedLBGroupNamingScheme:
+		return newV2SharedLBGroupFrontendNamer(ing, rn.kubeSystemUID, rn.namer.prefix)
 	default:
 		klog.Errorf("Unexpected frontend naming scheme %s", namingScheme)
 		return newV1IngressFrontendNamer(ing, rn.namer)
@@ -24,6 +24,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	v1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/ingress-gce/pkg/utils/common"
 )
 
 const (
@@ -440,3 +441,56 @@ func TestV2IngressFrontendNamer(t *testing.T) {
 		}
 	}
 }
+
+// TestV2GatewayFrontendNamer asserts that the resources of a Gateway follow the v2
+// naming scheme and are distinct from the ones of an Ingress with the same name.
+func TestV2GatewayFrontendNamer(t *testing.T) {
+	ing := newIngress("namespace", "name")
+	ingNamer := newV2IngressFrontendNamer(ing, kubeSystemUID, "k8s")
+	gwNamer := newV2GatewayFrontendNamer(ing, kubeSystemUID, "k8s")
+
+	if ingNamer.LoadBalancer() == gwNamer.LoadBalancer() {
+		t.Errorf("Gateway and Ingress load balancer names are equal: %q", gwNamer.LoadBalancer())
+	}
+	wantPrefix := "7kpbhpki-namespace-name-"
+	if got := gwNamer.LoadBalancer().String(); len(got) != len(wantPrefix)+8 || got[:len(wantPrefix)] != wantPrefix {
+		t.Errorf("gwNamer.LoadBalancer() = %q, want %q followed by an 8 character hash", got, wantPrefix)
+	}
+	if got, want := gwNamer.UrlMap(), fmt.Sprintf("k8s2-um-%s", gwNamer.LoadBalancer()); got != want {
+		t.Errorf("gwNamer.UrlMap() = %q, want %q", got, want)
+	}
+	if !gwNamer.IsValidLoadBalancer() {
+		t.Errorf("gwNamer.IsValidLoadBalancer() = false, want true")
+	}
+
+	ing.Finalizers = []string{common.GatewayFinalizerKey}
+	factory := NewFrontendNamerFactory(NewNamer(clusterUID, ""), kubeSystemUID)
+	if got := factory.Namer(ing).LoadBalancer(); got != gwNamer.LoadBalancer() {
+		t.Errorf("factory.Namer(ing).LoadBalancer() = %q, want %q", got, gwNamer.LoadBalancer())
+	}
+}
+
+// TestV2SharedLBGroupFrontendNamer asserts that the resources of a shared load
+// balancer group are distinct from the ones of an Ingress or a Gateway with the
+// same name.
+func TestV2SharedLBGroupFrontendNamer(t *testing.T) {
+	ing := newIngress("namespace", "name")
+	ingNamer := newV2IngressFrontendNamer(ing, kubeSystemUID, "k8s")
+	gwNamer := newV2GatewayFrontendNamer(ing, kubeSystemUID, "k8s")
+	groupNamer := newV2SharedLBGroupFrontendNamer(ing, kubeSystemUID, "k8s")
+
+	for _, other := range []IngressFrontendNamer{ingNamer, gwNamer} {
+		if other.LoadBalancer() == groupNamer.LoadBalancer() {
+			t.Errorf("Shared load balancer group name equals %q", other.LoadBalancer())
+		}
+	}
+	if !groupNamer.IsValidLoadBalancer() {
+		t.Errorf("groupNamer.IsValidLoadBalancer() = false, want true")
+	}
+
+	ing.Finalizers = []string{common.SharedLBGroupFinalizerKey}
+	factory := NewFrontendNamerFactory(NewNamer(clusterUID, ""), kubeSystemUID)
+	if got := factory.Namer(ing).LoadBalancer(); got != groupNamer.LoadBalancer() {
+		t.Errorf("factory.Namer(ing).LoadBalancer() = %q, want %q", got, groupNamer.LoadBalancer())
+	}
+}
@@ -66,6 +66,10 @@ func FrontendNamingScheme(ing *v1.Ingress) Scheme {
 	switch {
 	case common.HasGivenFinalizer(ing.ObjectMeta, common.FinalizerKeyV2):
 		return V2NamingScheme
+	case common.HasGivenFinalizer(ing.ObjectMeta, common.GatewayFinalizerKey):
+		return GatewayNamingScheme
+	case common.HasGivenFinalizer(ing.ObjectMeta, common.SharedLBGroupFinalizerKey):
+		return SharedLBGroupNamingScheme
 	case common.HasGivenFinalizer(ing.ObjectMeta, common.FinalizerKey):
 		return V1NamingScheme
 	default:
@@ -81,6 +85,10 @@ func FinalizerForNamingScheme(scheme Scheme) (string, error) {
 		return common.FinalizerKey, nil
 	case V2NamingScheme:
 		return common.FinalizerKeyV2, nil
+	case GatewayNamingScheme:
+		return common.GatewayFinalizerKey, nil
+	case SharedLBGroupNamingScheme:
+		return common.SharedLBGroupFinalizerKey, nil
 	default:
 		return "", fmt.Errorf("unexpected naming scheme: %s", scheme)
 	}
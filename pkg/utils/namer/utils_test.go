@@ -103,6 +103,8 @@ func TestFrontendNamingScheme(t *testing.T) {
 		{"", V1NamingScheme},
 		{common.FinalizerKey, V1NamingScheme},
 		{common.FinalizerKeyV2, V2NamingScheme},
+		{common.GatewayFinalizerKey, GatewayNamingScheme},
+		{common.SharedLBGroupFinalizerKey, SharedLBGroupNamingScheme},
 	}
 	for _, tc := range testCases {
 		desc := fmt.Sprintf("Finalizer %q", tc.finalizer)
@@ -73,6 +73,9 @@ type ServicePort struct {
 	// Traffic policy fields that apply if non-nil.
 	MaxRatePerEndpoint *float64
 	CapacityScaler     *float64
+	// MirrorBackend is the ServicePort of the shadow Service that requests
+	// sent to this ServicePort are mirrored to, if any.
+	MirrorBackend *ServicePort
 }
 
 // GetDescription returns a Description for this ServicePort.
@@ -17,6 +17,8 @@ limitations under the License.
 package utils
 
 import (
+	"time"
+
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -30,6 +32,7 @@ var (
 type TaskQueue interface {
 	Run()
 	Enqueue(objs ...interface{})
+	EnqueueAfter(obj interface{}, after time.Duration)
 	Shutdown()
 	Len() int
 	NumRequeues(obj interface{}) int
@@ -105,6 +108,18 @@ func (t *PeriodicTaskQueueWithMultipleWorkers) Enqueue(objs ...interface{}) {
 	}
 }
 
+// EnqueueAfter adds the key of the object to the work queue once the given
+// duration passed.
+func (t *PeriodicTaskQueueWithMultipleWorkers) EnqueueAfter(obj interface{}, after time.Duration) {
+	key, err := t.keyFunc(obj)
+	if err != nil {
+		klog.Errorf("Couldn't get key for object %+v (type %T): %v", obj, obj, err)
+		return
+	}
+	klog.V(4).Infof("Enqueue key=%q after %v (%v)", key, after, t.resource)
+	t.queue.AddAfter(key, after)
+}
+
 // Shutdown shuts down the work queue and waits for all the workers to ACK
 func (t *PeriodicTaskQueueWithMultipleWorkers) Shutdown() {
 	klog.V(2).Infof("Shutting down task queue for resource %s", t.resource)
@@ -200,6 +215,18 @@ func (t *PeriodicTaskQueue) Enqueue(objs ...interface{}) {
 	}
 }
 
+// EnqueueAfter adds the key of the object to the work queue once the given
+// duration passed.
+func (t *PeriodicTaskQueue) EnqueueAfter(obj interface{}, after time.Duration) {
+	key, err := t.keyFunc(obj)
+	if err != nil {
+		klog.Errorf("Couldn't get key for object %+v (type %T): %v", obj, obj, err)
+		return
+	}
+	klog.V(4).Infof("Enqueue key=%q after %v (%v)", key, after, t.resource)
+	t.queue.AddAfter(key, after)
+}
+
 // Shutdown shuts down the work queue and waits for the worker to ACK
 func (t *PeriodicTaskQueue) Shutdown() {
 	klog.V(2).Infof("Shutdown")
@@ -40,6 +40,7 @@ import (
 	cloudprovider "k8s.io/cloud-provider"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/annotations"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/utils/common"
 	"k8s.io/ingress-gce/pkg/utils/slice"
@@ -594,15 +595,25 @@ func JoinErrs(errs []error) error {
 	return errors.New(strings.Join(errStrs, "; "))
 }
 
-// TraverseIngressBackends traverse thru all backends specified in the input ingress and call process
+// TraverseIngressBackends traverse thru all backends specified in the input ingress and
+// in the RouteConfig it references, if routeConfig is not nil, and call process.
+// The shadow services that these backends are mirrored to are included.
 // If process return true, then return and stop traversing the backends
-func TraverseIngressBackends(ing *networkingv1.Ingress, process func(id ServicePortID) bool) {
+func TraverseIngressBackends(ing *networkingv1.Ingress, routeConfig *routeconfigv1beta1.RouteConfig, process func(id ServicePortID) bool) {
 	if ing == nil {
 		return
 	}
+	// mirrored holds the backend services in the order they are visited,
+	// the translator mirrors the requests of each of them.
+	var mirrored []types.NamespacedName
+	visit := func(id ServicePortID) bool {
+		mirrored = append(mirrored, id.Service)
+		return process(id)
+	}
+
 	// Check service of default backend
 	if ing.Spec.DefaultBackend != nil && ing.Spec.DefaultBackend.Service != nil {
-		if process(ServicePortID{Service: types.NamespacedName{Namespace: ing.Namespace, Name: ing.Spec.DefaultBackend.Service.Name}, Port: ing.Spec.DefaultBackend.Service.Port}) {
+		if visit(ServicePortID{Service: types.NamespacedName{Namespace: ing.Namespace, Name: ing.Spec.DefaultBackend.Service.Name}, Port: ing.Spec.DefaultBackend.Service.Port}) {
 			return
 		}
 	}
@@ -614,12 +625,50 @@ func TraverseIngressBackends(ing *networkingv1.Ingress, process func(id ServiceP
 		}
 		for _, p := range rule.IngressRuleValue.HTTP.Paths {
 			if p.Backend.Service != nil {
-				if process(ServicePortID{Service: types.NamespacedName{Namespace: ing.Namespace, Name: p.Backend.Service.Name}, Port: p.Backend.Service.Port}) {
+				if visit(ServicePortID{Service: types.NamespacedName{Namespace: ing.Namespace, Name: p.Backend.Service.Name}, Port: p.Backend.Service.Port}) {
+					return
+				}
+			}
+		}
+	}
+
+	// Check the target services of each RouteConfig rule
+	if routeConfig != nil {
+		for _, rule := range routeConfig.Spec.Rules {
+			var backends []routeconfigv1beta1.ServiceBackend
+			if len(rule.WeightedBackends) == 0 {
+				backends = append(backends, rule.Backend)
+			}
+			for _, backend := range rule.WeightedBackends {
+				backends = append(backends, backend.ServiceBackend)
+			}
+			for _, backend := range backends {
+				if backend.Name == "" {
+					continue
+				}
+				if visit(ServicePortID{Service: types.NamespacedName{Namespace: routeConfig.Namespace, Name: backend.Name}, Port: networkingv1.ServiceBackendPort{Name: backend.Port.Name, Number: backend.Port.Number}}) {
 					return
 				}
 			}
 		}
 	}
+
+	// Check the shadow service of each mirrored backend service
+	mirrors, err := annotations.FromIngress(ing).RequestMirrors()
+	if err != nil {
+		return
+	}
+	seen := make(map[types.NamespacedName]bool)
+	for _, svc := range mirrored {
+		mirror, ok := mirrors[svc.Name]
+		if !ok || seen[svc] {
+			continue
+		}
+		seen[svc] = true
+		if process(ServicePortID{Service: types.NamespacedName{Namespace: svc.Namespace, Name: mirror.Name}, Port: mirror.Port}) {
+			return
+		}
+	}
 	return
 }
 
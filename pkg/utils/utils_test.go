@@ -38,6 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/cloud-provider-gcp/providers/gce"
 	"k8s.io/ingress-gce/pkg/annotations"
+	routeconfigv1beta1 "k8s.io/ingress-gce/pkg/apis/routeconfig/v1beta1"
 	"k8s.io/ingress-gce/pkg/flags"
 	"k8s.io/ingress-gce/pkg/utils/common"
 )
@@ -482,17 +483,153 @@ func TestTraverseIngressBackends(t *testing.T) {
 				},
 			},
 		},
+		{
+			"mirrored path rule backend",
+			&networkingv1.Ingress{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: map[string]string{
+						annotations.RequestMirrorKey: `{"web": {"name": "web-shadow", "port": {"number": 8080}}, "unused": {"name": "unused-shadow", "port": {"number": 80}}}`,
+					},
+				},
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{
+						{
+							IngressRuleValue: networkingv1.IngressRuleValue{
+								HTTP: &networkingv1.HTTPIngressRuleValue{
+									Paths: []networkingv1.HTTPIngressPath{
+										{
+											Path: "/web",
+											Backend: networkingv1.IngressBackend{
+												Service: &networkingv1.IngressServiceBackend{
+													Name: "web",
+													Port: networkingv1.ServiceBackendPort{Number: 80},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			[]networkingv1.IngressBackend{
+				{
+					Service: &networkingv1.IngressServiceBackend{
+						Name: "web",
+						Port: networkingv1.ServiceBackendPort{Number: 80},
+					},
+				},
+				{
+					Service: &networkingv1.IngressServiceBackend{
+						Name: "web-shadow",
+						Port: networkingv1.ServiceBackendPort{Number: 8080},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		counter := 0
-		TraverseIngressBackends(tc.ing, func(id ServicePortID) bool {
+		TraverseIngressBackends(tc.ing, nil, func(id ServicePortID) bool {
 			if tc.expectBackends[counter].Service.Name != id.Service.Name || tc.expectBackends[counter].Service.Port != id.Port {
 				t.Errorf("Test case %q, for backend %v, expecting service name %q and service port %+v, but got %q, %q", tc.desc, counter, tc.expectBackends[counter].Service.Name, tc.expectBackends[counter].Service.Port, id.Service.Name, id.Port.String())
 			}
 			counter += 1
 			return false
 		})
+		if counter != len(tc.expectBackends) {
+			t.Errorf("Test case %q, got %d backends, want %d", tc.desc, counter, len(tc.expectBackends))
+		}
+	}
+}
+
+func TestTraverseIngressBackendsWithRouteConfig(t *testing.T) {
+	t.Parallel()
+	ing := &networkingv1.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: "ns",
+			Annotations: map[string]string{
+				annotations.RouteConfigKey: "route-config",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			DefaultBackend: &networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: "default",
+					Port: networkingv1.ServiceBackendPort{Number: 80},
+				},
+			},
+		},
+	}
+	routeConfig := &routeconfigv1beta1.RouteConfig{
+		ObjectMeta: v1.ObjectMeta{Namespace: "ns", Name: "route-config"},
+		Spec: routeconfigv1beta1.RouteConfigSpec{
+			Rules: []routeconfigv1beta1.RouteRule{
+				{
+					Backend: routeconfigv1beta1.ServiceBackend{Name: "canary", Port: routeconfigv1beta1.ServiceBackendPort{Name: "http"}},
+				},
+				{
+					WeightedBackends: []routeconfigv1beta1.WeightedServiceBackend{
+						{ServiceBackend: routeconfigv1beta1.ServiceBackend{Name: "blue", Port: routeconfigv1beta1.ServiceBackendPort{Number: 8080}}, Weight: 90},
+						{ServiceBackend: routeconfigv1beta1.ServiceBackend{Name: "green", Port: routeconfigv1beta1.ServiceBackendPort{Number: 8080}}, Weight: 10},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		routeConfig *routeconfigv1beta1.RouteConfig
+		mirrors     string
+		want        []ServicePortID
+	}{
+		{
+			desc: "no RouteConfig",
+			want: []ServicePortID{
+				{Service: types.NamespacedName{Namespace: "ns", Name: "default"}, Port: networkingv1.ServiceBackendPort{Number: 80}},
+			},
+		},
+		{
+			desc:        "RouteConfig with weighted backends",
+			routeConfig: routeConfig,
+			want: []ServicePortID{
+				{Service: types.NamespacedName{Namespace: "ns", Name: "default"}, Port: networkingv1.ServiceBackendPort{Number: 80}},
+				{Service: types.NamespacedName{Namespace: "ns", Name: "canary"}, Port: networkingv1.ServiceBackendPort{Name: "http"}},
+				{Service: types.NamespacedName{Namespace: "ns", Name: "blue"}, Port: networkingv1.ServiceBackendPort{Number: 8080}},
+				{Service: types.NamespacedName{Namespace: "ns", Name: "green"}, Port: networkingv1.ServiceBackendPort{Number: 8080}},
+			},
+		},
+		{
+			desc:        "mirrored default and RouteConfig backends",
+			routeConfig: routeConfig,
+			mirrors:     `{"default": {"name": "default-shadow", "port": {"number": 80}}, "blue": {"name": "blue-shadow", "port": {"number": 8080}}}`,
+			want: []ServicePortID{
+				{Service: types.NamespacedName{Namespace: "ns", Name: "default"}, Port: networkingv1.ServiceBackendPort{Number: 80}},
+				{Service: types.NamespacedName{Namespace: "ns", Name: "canary"}, Port: networkingv1.ServiceBackendPort{Name: "http"}},
+				{Service: types.NamespacedName{Namespace: "ns", Name: "blue"}, Port: networkingv1.ServiceBackendPort{Number: 8080}},
+				{Service: types.NamespacedName{Namespace: "ns", Name: "green"}, Port: networkingv1.ServiceBackendPort{Number: 8080}},
+				{Service: types.NamespacedName{Namespace: "ns", Name: "default-shadow"}, Port: networkingv1.ServiceBackendPort{Number: 80}},
+				{Service: types.NamespacedName{Namespace: "ns", Name: "blue-shadow"}, Port: networkingv1.ServiceBackendPort{Number: 8080}},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ing := ing.DeepCopy()
+			if tc.mirrors != "" {
+				ing.Annotations[annotations.RequestMirrorKey] = tc.mirrors
+			}
+			var got []ServicePortID
+			TraverseIngressBackends(ing, tc.routeConfig, func(id ServicePortID) bool {
+				got = append(got, id)
+				return false
+			})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("TraverseIngressBackends() returned diff (-want +got):\n%s", diff)
+			}
+		})
 	}
 }
 